@@ -0,0 +1,178 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+)
+
+// Time buckets of activity statistics
+const (
+	BucketDay   = "day"
+	BucketWeek  = "week"
+	BucketMonth = "month"
+)
+
+// ActivityBuckets lists time buckets of activity statistics.
+var ActivityBuckets = []string{BucketDay, BucketWeek, BucketMonth}
+
+// Activity contains statistics of commits in a time bucket
+type Activity struct {
+	Repo  string    `json:"repo"`  // repository ID
+	Start time.Time `json:"start"` // start of the bucket (in UTC)
+
+	NumCommits int `json:"commits"` // number of commits
+	NumMerges  int `json:"merges"`  // number of merge commits
+	NumAuthors int `json:"authors"` // number of distinct authors of commits
+	NumFiles   int `json:"files"`   // number of distinct paths touched by commits
+}
+
+// bucketStart returns the start of a bucket containing t. Weeks start on Monday.
+func bucketStart(t time.Time, bucket string) time.Time {
+	y, m, d := t.UTC().Date()
+	switch bucket {
+	case BucketWeek:
+		day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case BucketMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// nextBucket returns the start of the bucket following the one starting at t.
+func nextBucket(t time.Time, bucket string) time.Time {
+	switch bucket {
+	case BucketWeek:
+		return t.AddDate(0, 0, 7)
+	case BucketMonth:
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 1)
+}
+
+// Activity returns statistics of commits of all repositories (or of opts.Repo) per day, week or month (by commit time).
+// Buckets of each repository go from the first commit to the last one without gaps, and opts.Limit limits them to the most recent ones.
+// Commits without a time are skipped.
+func (g *Graph) Activity(ctx context.Context, bucket string, opts *StatsOptions) ([]*Activity, error) {
+	switch bucket {
+	case "":
+		bucket = BucketDay
+	case BucketDay, BucketWeek, BucketMonth:
+	default:
+		return nil, fmt.Errorf("invalid bucket: %q", bucket)
+	}
+	if opts == nil {
+		opts = &StatsOptions{}
+	}
+	repos, err := g.selectRepos(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*Activity, 0)
+	for _, repo := range repos {
+		out = append(out, h.activity(repo, bucket, opts)...)
+	}
+	return out, nil
+}
+
+// activity returns statistics of commits of a repository per time bucket.
+func (h *history) activity(repo quad.Value, bucket string, opts *StatsOptions) []*Activity {
+	var (
+		out     []*Activity
+		cur     *Activity
+		authors map[quad.Value]struct{}
+		paths   map[string]struct{}
+	)
+	done := func() {
+		if cur != nil {
+			cur.NumAuthors, cur.NumFiles = len(authors), len(paths)
+		}
+	}
+	// commits are sorted by time
+	for _, c := range h.commits(repo, opts) {
+		cs := h.commitStats(c)
+		if cs.CommittedAt.IsZero() {
+			continue
+		}
+		start := bucketStart(cs.CommittedAt, bucket)
+		if cur == nil || !cur.Start.Equal(start) {
+			done()
+			next := start
+			if cur != nil {
+				next = nextBucket(cur.Start, bucket)
+			}
+			// empty buckets
+			for ; next.Before(start); next = nextBucket(next, bucket) {
+				out = append(out, &Activity{Repo: iriString(repo), Start: next})
+			}
+			cur = &Activity{Repo: iriString(repo), Start: start}
+			out = append(out, cur)
+			authors = make(map[quad.Value]struct{})
+			paths = make(map[string]struct{})
+		}
+		cur.NumCommits++
+		if cs.NumParents > 1 {
+			cur.NumMerges++
+		}
+		if a, ok := h.authors[c]; ok {
+			authors[a] = struct{}{}
+		}
+		for _, ch := range h.changes[c] {
+			paths[ch.Path] = struct{}{}
+		}
+	}
+	done()
+
+	if opts.Limit > 0 && len(out) > opts.Limit {
+		out = out[len(out)-opts.Limit:]
+	}
+	return out
+}
+
+// PrintActivity writes activity statistics to w in a given format: text (default), json or csv.
+func (g *Graph) PrintActivity(ctx context.Context, w io.Writer, format, bucket string, opts *StatsOptions) error {
+	res, err := g.Activity(ctx, bucket, opts)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"repo", "start", "commits", "merges", "authors", "files"}
+	return writeReport(w, format, res, header, func() [][]string {
+		return activityRows(res)
+	}, func(w io.Writer) {
+		printActivity(w, res)
+	})
+}
+
+func printActivity(w io.Writer, res []*Activity) {
+	repo := ""
+	for _, a := range res {
+		if a.Repo != repo {
+			repo = a.Repo
+			fmt.Fprintf(w, "\n%s\n--\n", quad.IRI(repo).String())
+		}
+		fmt.Fprintf(w, "%s: %d commits, %d merges, %d authors, %d files\n", formatDate(a.Start), a.NumCommits, a.NumMerges, a.NumAuthors, a.NumFiles)
+	}
+}
+
+func activityRows(res []*Activity) [][]string {
+	rows := make([][]string, 0, len(res))
+	for _, a := range res {
+		rows = append(rows, []string{
+			a.Repo, formatDate(a.Start),
+			strconv.Itoa(a.NumCommits), strconv.Itoa(a.NumMerges),
+			strconv.Itoa(a.NumAuthors), strconv.Itoa(a.NumFiles),
+		})
+	}
+	return rows
+}
@@ -0,0 +1,230 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+type (
+	// AuthorStats contains contribution statistics of an author (a git:Author node)
+	AuthorStats struct {
+		ID    string `json:"id"` // author node ID
+		Name  string `json:"name"`
+		Email string `json:"email"`
+
+		NumAuthored  int `json:"authored"`  // number of commits authored
+		NumCommitted int `json:"committed"` // number of commits committed
+
+		NumFiles    int `json:"files"`    // number of distinct paths touched by authored commits
+		NumAdded    int `json:"added"`    // number of files added by authored commits
+		NumRemoved  int `json:"removed"`  // number of files removed by authored commits
+		NumModified int `json:"modified"` // number of files modified by authored commits
+
+		FirstActivity time.Time `json:"first_activity"` // the earliest time the author authored or committed a commit
+		LastActivity  time.Time `json:"last_activity"`  // the latest time the author authored or committed a commit
+
+		Repos []string `json:"repos"` // repositories contributed to
+	}
+
+	// AuthorSortBy is a function to sort author statistics
+	AuthorSortBy func(as1, as2 *AuthorStats) bool
+)
+
+// Touched returns the number of files added, removed or modified by commits of the author.
+func (as *AuthorStats) Touched() int {
+	return as.NumAdded + as.NumRemoved + as.NumModified
+}
+
+// AuthorSortByName returns a function to sort author statistics by a given field:
+// commit (authored commits), add, remove, modify, touch or file.
+func AuthorSortByName(name string) (AuthorSortBy, error) {
+	switch strings.ToLower(name) {
+	case "commit":
+		return func(as1, as2 *AuthorStats) bool {
+			return as1.NumAuthored > as2.NumAuthored
+		}, nil
+
+	case "add":
+		return func(as1, as2 *AuthorStats) bool {
+			return as1.NumAdded > as2.NumAdded
+		}, nil
+
+	case "remove":
+		return func(as1, as2 *AuthorStats) bool {
+			return as1.NumRemoved > as2.NumRemoved
+		}, nil
+
+	case "modify":
+		return func(as1, as2 *AuthorStats) bool {
+			return as1.NumModified > as2.NumModified
+		}, nil
+
+	case "file":
+		return func(as1, as2 *AuthorStats) bool {
+			return as1.NumFiles > as2.NumFiles
+		}, nil
+
+	case "touch":
+		return func(as1, as2 *AuthorStats) bool {
+			return as1.Touched() > as2.Touched()
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid sort: %q", name)
+}
+
+// AuthorStats returns contribution statistics of authors of commits in all repositories (or in opts.Repo).
+// Merge commits and commits outside of the time range are skipped according to the options,
+// and opts.Limit limits the number of authors returned.
+func (g *Graph) AuthorStats(ctx context.Context, by AuthorSortBy, opts *StatsOptions) ([]*AuthorStats, error) {
+	if opts == nil {
+		opts = &StatsOptions{}
+	}
+	repo, err := g.repoFilter(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	type authorInfo struct {
+		*AuthorStats
+		paths map[string]struct{}
+		repos map[quad.Value]struct{}
+	}
+	authors := make(map[quad.Value]*authorInfo)
+	get := func(a quad.Value) *authorInfo {
+		ai, ok := authors[a]
+		if !ok {
+			ai = &authorInfo{
+				AuthorStats: &AuthorStats{
+					ID:    iriString(a),
+					Name:  outString(ctx, g.store, a, git.PredName),
+					Email: iriString(outValue(ctx, g.store, a, git.PredEmail)),
+				},
+				paths: make(map[string]struct{}),
+				repos: make(map[quad.Value]struct{}),
+			}
+			authors[a] = ai
+		}
+		return ai
+	}
+	activity := func(ai *authorInfo, c quad.Value, t time.Time) {
+		for _, r := range h.repos[c] {
+			if repo == nil || r == repo {
+				ai.repos[r] = struct{}{}
+			}
+		}
+		if t.IsZero() {
+			return
+		}
+		if ai.FirstActivity.IsZero() || t.Before(ai.FirstActivity) {
+			ai.FirstActivity = t
+		}
+		if t.After(ai.LastActivity) {
+			ai.LastActivity = t
+		}
+	}
+
+	for _, c := range h.commits(repo, opts) {
+		cs := h.commitStats(c)
+		if a, ok := h.authors[c]; ok {
+			ai := get(a)
+			ai.NumAuthored++
+			ai.NumAdded += cs.NumAdded
+			ai.NumRemoved += cs.NumRemoved
+			ai.NumModified += cs.NumModified
+			for _, ch := range h.changes[c] {
+				ai.paths[ch.Path] = struct{}{}
+			}
+			activity(ai, c, cs.AuthoredAt)
+		}
+		if a, ok := h.committers[c]; ok {
+			ai := get(a)
+			ai.NumCommitted++
+			activity(ai, c, cs.CommittedAt)
+		}
+	}
+
+	out := make([]*AuthorStats, 0, len(authors))
+	for _, ai := range authors {
+		ai.NumFiles = len(ai.paths)
+		ai.Repos = make([]string, 0, len(ai.repos))
+		for r := range ai.repos {
+			ai.Repos = append(ai.Repos, iriString(r))
+		}
+		sort.Strings(ai.Repos)
+		out = append(out, ai.AuthorStats)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].ID < out[j].ID
+	})
+	if by != nil {
+		sort.SliceStable(out, func(i, j int) bool {
+			return by(out[i], out[j])
+		})
+	}
+	if opts.Limit > 0 && len(out) > opts.Limit {
+		out = out[:opts.Limit]
+	}
+	return out, nil
+}
+
+// PrintAuthorStats writes author statistics to w in a given format: text (default), json or csv.
+func (g *Graph) PrintAuthorStats(ctx context.Context, w io.Writer, format string, by AuthorSortBy, opts *StatsOptions) error {
+	stats, err := g.AuthorStats(ctx, by, opts)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"id", "name", "email", "authored", "committed", "files", "touched", "added", "removed", "modified", "first_activity", "last_activity", "repos"}
+	return writeReport(w, format, stats, header, func() [][]string {
+		return authorStatsRows(stats)
+	}, func(w io.Writer) {
+		printAuthorStats(w, stats)
+	})
+}
+
+func printAuthorStats(w io.Writer, stats []*AuthorStats) {
+	for _, s := range stats {
+		fmt.Fprintf(w, "--\nauthor: %s <%s>\n", s.Name, s.Email)
+		fmt.Fprintf(w, "%d authored, %d committed, active %s - %s\n", s.NumAuthored, s.NumCommitted, formatDate(s.FirstActivity), formatDate(s.LastActivity))
+		fmt.Fprintf(w, "%d files, %d touched (+, -, #), %d added(+), %d removed(-), %d modified(#)\n", s.NumFiles, s.Touched(), s.NumAdded, s.NumRemoved, s.NumModified)
+		fmt.Fprintf(w, "repos: %s\n", strings.Join(s.Repos, ", "))
+	}
+}
+
+func authorStatsRows(stats []*AuthorStats) [][]string {
+	rows := make([][]string, 0, len(stats))
+	for _, s := range stats {
+		rows = append(rows, []string{
+			s.ID, s.Name, s.Email,
+			strconv.Itoa(s.NumAuthored), strconv.Itoa(s.NumCommitted),
+			strconv.Itoa(s.NumFiles), strconv.Itoa(s.Touched()),
+			strconv.Itoa(s.NumAdded), strconv.Itoa(s.NumRemoved), strconv.Itoa(s.NumModified),
+			formatTime(s.FirstActivity), formatTime(s.LastActivity),
+			strings.Join(s.Repos, " "),
+		})
+	}
+	return rows
+}
+
+// formatDate formats a time as a date (YYYY-MM-DD), or returns "?" for a zero time.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "?"
+	}
+	return t.Format("2006-01-02")
+}
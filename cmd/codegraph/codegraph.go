@@ -6,20 +6,141 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/cayleygraph/cayley/quad"
 	"github.com/cayleygraph/cayley/quad/nquads"
 	"github.com/mloncode/codegraph"
+	"github.com/mloncode/codegraph/git"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
+// registerDBFlags registers flags for opening the graph database.
+// The returned function opens the database and must be called after parsing the flags.
+func registerDBFlags(f *pflag.FlagSet) func() (*codegraph.Graph, error) {
+	db := f.StringP("db", "a", "./", "database directory (or connection string for SQL backends)")
+	backend := f.String("backend", "bolt", "storage backend ["+strings.Join(codegraph.Backends, ", ")+"]")
+	backendOpts := f.StringToString("backend-opt", nil, "backend-specific settings (key=value), e.g. nosync=true for bolt and leveldb")
+	return func() (*codegraph.Graph, error) {
+		settings, err := codegraph.ParseSettings(*backend, *backendOpts)
+		if err != nil {
+			return nil, err
+		}
+		return codegraph.Open(*db, &codegraph.Options{
+			Backend:  *backend,
+			Settings: settings,
+		})
+	}
+}
+
 func registerOutQuadFlag(f *pflag.FlagSet) *string {
 	return f.StringP("out", "o", "-", "write output to a file")
 }
 
-func newQuadOutput(out string) (quad.WriteCloser, error) {
+func registerCacheFlag(f *pflag.FlagSet) *string {
+	return f.String("cache", "", "directory for clones of remote repositories (clone in memory if empty)")
+}
+
+func registerRepoIDFlag(f *pflag.FlagSet) *string {
+	return f.String("repo-id", "", "use a given repository ID instead of the normalized URL (single repository only)")
+}
+
+var errRepoIDArgs = errors.New("--repo-id can only be used with a single repository")
+
+// registerFilterFlags registers flags for selecting a subset of the git history.
+// The returned function must be called after parsing the flags.
+func registerFilterFlags(f *pflag.FlagSet) func() (git.Filter, error) {
+	since := f.String("since", "", "import commits more recent than a date (YYYY-MM-DD or RFC3339)")
+	until := f.String("until", "", "import commits older than a date, inclusive (YYYY-MM-DD or RFC3339)")
+	author := f.String("author", "", "import commits with author matching a regexp")
+	paths := f.StringSlice("path", nil, "import commits that change a given path (file, directory or glob)")
+	maxCount := f.Int("max-count", 0, "import at most a given number of commits (0 means no limit)")
+	return func() (git.Filter, error) {
+		var (
+			filter = git.Filter{Author: *author, Paths: *paths, MaxCount: *maxCount}
+			err    error
+		)
+		if filter.Since, err = codegraph.ParseTime(*since); err != nil {
+			return filter, err
+		}
+		if filter.Until, err = codegraph.ParseUntil(*until); err != nil {
+			return filter, err
+		}
+		return filter, nil
+	}
+}
+
+// registerStatsFlags registers flags for selecting commits for statistics (--nomerge, --since and --until)
+// and a limit flag, unless limitUsage is empty.
+// The returned function must be called after parsing the flags, with positional arguments of the command ([<repo>]).
+func registerStatsFlags(f *pflag.FlagSet, limitUsage string) func(args []string) (*codegraph.StatsOptions, error) {
+	limit := new(int)
+	if limitUsage != "" {
+		limit = f.IntP("limit", "n", 0, limitUsage)
+	}
+	noMerge := f.Bool("nomerge", false, "do not show merge commits")
+	since := f.String("since", "", "show commits more recent than a date (YYYY-MM-DD or RFC3339)")
+	until := f.String("until", "", "show commits older than a date, inclusive (YYYY-MM-DD or RFC3339)")
+	return func(args []string) (*codegraph.StatsOptions, error) {
+		if len(args) > 1 {
+			return nil, errors.New("expected at most one argument")
+		}
+		if *limit < 0 {
+			*limit = 0
+		}
+		var err error
+		opts := &codegraph.StatsOptions{Limit: *limit, NoMerge: *noMerge}
+		if len(args) == 1 {
+			opts.Repo = args[0]
+		}
+		if opts.Since, err = codegraph.ParseTime(*since); err != nil {
+			return nil, err
+		}
+		if opts.Until, err = codegraph.ParseUntil(*until); err != nil {
+			return nil, err
+		}
+		return opts, nil
+	}
+}
+
+// registerStatsFormatFlag registers a flag for the output format of statistics.
+func registerStatsFormatFlag(f *pflag.FlagSet) *string {
+	return f.StringP("format", "f", codegraph.FormatText, "output format ["+strings.Join(codegraph.StatsFormats, ", ")+"]")
+}
+
+// outputFormats returns names of all output formats, including CSV tables.
+func outputFormats() []string {
+	formats := append(codegraph.ExportFormats(), codegraph.FormatCSV)
+	sort.Strings(formats)
+	return formats
+}
+
+func registerFormatFlag(f *pflag.FlagSet) *string {
+	return f.StringP("format", "f", "", "output format ["+strings.Join(outputFormats(), ", ")+"]; detected from the file extension if empty")
+}
+
+// outputFormat returns the format name given by the flag, or detects it from the output file extension.
+func outputFormat(out, format string) (string, error) {
+	if format == "" {
+		ext := filepath.Ext(strings.TrimSuffix(out, ".gz"))
+		if format = codegraph.FormatByExt(ext); format == "" {
+			format = codegraph.FormatNQuads
+		}
+	}
+	for _, name := range outputFormats() {
+		if name == format {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported format: %q", format)
+}
+
+// newOutput creates an output file, or returns stdout if out is "-".
+// Files with ".gz" extension are compressed.
+func newOutput(out string) (io.WriteCloser, error) {
 	var (
 		w io.Writer = os.Stdout
 		c []io.Closer
@@ -37,39 +158,59 @@ func newQuadOutput(out string) (quad.WriteCloser, error) {
 		w = zw
 		c = append(c, zw)
 	}
-	qw := nquads.NewWriter(w)
-	if len(c) == 0 {
-		return qw, nil
+	return &writeCloser{Writer: w, c: c}, nil
+}
+
+type writeCloser struct {
+	io.Writer
+	c []io.Closer
+}
+
+func (w *writeCloser) Close() error {
+	return closeAll(w.c)
+}
+
+func newQuadOutput(out string) (quad.WriteCloser, error) {
+	w, err := newOutput(out)
+	if err != nil {
+		return nil, err
 	}
-	c = append(c, qw)
-	return &quadWriteCloser{Writer: qw, c: c}, nil
+	return &quadWriteCloser{WriteCloser: nquads.NewWriter(w), c: w}, nil
 }
 
 type quadWriteCloser struct {
-	quad.Writer
-	c []io.Closer
+	quad.WriteCloser
+	c io.Closer
 }
 
 func (w *quadWriteCloser) Close() error {
-	for i := len(w.c) - 1; i >= 0; i-- {
-		if err := w.c[i].Close(); err != nil {
-			return err
-		}
+	err := w.WriteCloser.Close()
+	if cerr := w.c.Close(); err == nil {
+		err = cerr
 	}
-	return nil
+	return err
 }
 
 func init() {
 	cmdQuads := &cobra.Command{
-		Use:   "quads <repo> [<repo>...]",
+		Use:   "quads <repo or url> [<repo or url>...]",
 		Short: "Convert Git repository to quads",
 	}
 	fout := registerOutQuadFlag(cmdQuads.Flags())
 	fuast := cmdQuads.Flags().Bool("uast", true, "export UAST of files in Git")
 	fbblfsh := cmdQuads.Flags().String("bblfsh", "localhost:9432", "address of Babelfish server for parsing")
+	ffilter := registerFilterFlags(cmdQuads.Flags())
+	fcache := registerCacheFlag(cmdQuads.Flags())
+	frepoID := registerRepoIDFlag(cmdQuads.Flags())
 	cmdQuads.RunE = func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
 			return errors.New("expected at least one argument")
+		} else if *frepoID != "" && len(args) > 1 {
+			return errRepoIDArgs
+		}
+		filter, err := ffilter()
+		if err != nil {
+			return err
 		}
 		qw, err := newQuadOutput(*fout)
 		if err != nil {
@@ -77,6 +218,7 @@ func init() {
 		}
 		exp, err := codegraph.NewExporter(qw, &codegraph.ExportOptions{
 			UASTs: *fuast, BblfshAddr: *fbblfsh,
+			Filter: filter, CacheDir: *fcache, RepoID: *frepoID,
 		})
 		if err != nil {
 			_ = qw.Close()
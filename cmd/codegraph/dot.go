@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/mloncode/codegraph"
+	"github.com/spf13/cobra"
+)
+
+// parseRange parses a "[<from>..]<to>" range of refs.
+func parseRange(s string) (from, to string) {
+	if i := strings.Index(s, ".."); i >= 0 {
+		return s[:i], s[i+2:]
+	}
+	return "", s
+}
+
+func init() {
+	cmdDot := &cobra.Command{
+		Use:   "dot",
+		Short: "render graphs in Graphviz DOT format",
+	}
+	openDB := registerDBFlags(cmdDot.PersistentFlags())
+	fout := registerOutQuadFlag(cmdDot.PersistentFlags())
+	fdepth := cmdDot.PersistentFlags().Int("depth", 0, "maximal depth of the graph (0 means no limit)")
+
+	writeDot := func(cmd *cobra.Command, fnc func(g *codegraph.Graph, w io.Writer, opts *codegraph.DotOptions) error) error {
+		g, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer g.Close()
+
+		cmd.SilenceUsage = true
+		w, err := newOutput(*fout)
+		if err != nil {
+			return err
+		}
+		err = fnc(g, w, &codegraph.DotOptions{MaxDepth: *fdepth})
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+		return err
+	}
+
+	cmdCommits := &cobra.Command{
+		Use:   "commits <repo> [<from>..]<to>",
+		Short: "render commits reachable from <to>, but not from <from>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("expected two arguments")
+			}
+			from, to := parseRange(args[1])
+			if to == "" {
+				return errors.New("expected a ref to render commits from")
+			}
+			return writeDot(cmd, func(g *codegraph.Graph, w io.Writer, opts *codegraph.DotOptions) error {
+				return g.CommitsDOT(context.TODO(), w, args[0], from, to, opts)
+			})
+		},
+	}
+	cmdDot.AddCommand(cmdCommits)
+
+	cmdUAST := &cobra.Command{
+		Use:   "uast <file>",
+		Short: "render the UAST of a file (file ID or blob hash)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("expected one argument")
+			}
+			return writeDot(cmd, func(g *codegraph.Graph, w io.Writer, opts *codegraph.DotOptions) error {
+				return g.UASTDOT(context.TODO(), w, args[0], opts)
+			})
+		},
+	}
+	cmdDot.AddCommand(cmdUAST)
+
+	root.AddCommand(cmdDot)
+}
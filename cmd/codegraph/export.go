@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mloncode/codegraph"
+	"github.com/spf13/cobra"
+)
+
+// exportGraph writes the whole graph to a file (or stdout) in a given format.
+// CSV tables are written to a directory.
+func exportGraph(g *codegraph.Graph, out, format string) error {
+	format, err := outputFormat(out, format)
+	if err != nil {
+		return err
+	}
+	if format == codegraph.FormatCSV {
+		if out == "" || out == "-" {
+			return errors.New("csv tables can only be exported to a directory")
+		}
+		n, err := g.ExportTables(context.TODO(), out)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported: %d quads\n", n)
+		return nil
+	}
+	w, err := newOutput(out)
+	if err != nil {
+		return err
+	}
+	n, err := g.ExportFormat(context.TODO(), w, format)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported: %d quads\n", n)
+	return nil
+}
+
+func init() {
+	cmdExport := &cobra.Command{
+		Use:   "export",
+		Short: "export the database to a file (e.g. GEXF or GraphML for Gephi, yEd or NetworkX) or CSV tables (Parquet is not supported)",
+	}
+	openDB := registerDBFlags(cmdExport.Flags())
+	fout := registerOutQuadFlag(cmdExport.Flags())
+	fformat := registerFormatFlag(cmdExport.Flags())
+	cmdExport.RunE = func(cmd *cobra.Command, args []string) error {
+		g, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer g.Close()
+		cmd.SilenceUsage = true
+		return exportGraph(g, *fout, *fformat)
+	}
+	root.AddCommand(cmdExport)
+}
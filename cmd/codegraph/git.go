@@ -22,10 +22,10 @@ func init() {
 		Use:   "git <command>",
 		Short: "Git-related commands",
 	}
-	db := cmdGit.PersistentFlags().StringP("db", "a", "./", "database directory")
+	openDB := registerDBFlags(cmdGit.PersistentFlags())
 	cmdGit.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		var err error
-		g, err = codegraph.Open(*db)
+		g, err = openDB()
 		return err
 	}
 	cmdGit.PersistentPostRunE = func(cmd *cobra.Command, args []string) error {
@@ -37,13 +37,22 @@ func init() {
 	root.AddCommand(cmdGit)
 
 	cmdQuads := &cobra.Command{
-		Use:   "quads <repo> [<repos>...]",
+		Use:   "quads <repo or url> [<repos or urls>...]",
 		Short: "dump a git repository as quads",
 	}
 	fout := cmdQuads.Flags().StringP("out", "o", "-", "write output to a file")
+	fquadsFilter := registerFilterFlags(cmdQuads.Flags())
+	fquadsCache := registerCacheFlag(cmdQuads.Flags())
+	fquadsRepoID := registerRepoIDFlag(cmdQuads.Flags())
 	cmdQuads.RunE = func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
 			return errors.New("expected at least one argument")
+		} else if *fquadsRepoID != "" && len(args) > 1 {
+			return errRepoIDArgs
+		}
+		filter, err := fquadsFilter()
+		if err != nil {
+			return err
 		}
 		var (
 			w io.Writer = os.Stdout
@@ -70,6 +79,9 @@ func init() {
 		if err != nil {
 			return err
 		}
+		exp.Filter = filter
+		exp.CacheDir = *fquadsCache
+		exp.RepoID = *fquadsRepoID
 		for _, path := range args {
 			fmt.Fprintln(os.Stderr, path)
 			if err := exp.ExportPath(path); err != nil {
@@ -88,96 +100,290 @@ func init() {
 	cmdExport := &cobra.Command{
 		Use:   "export",
 		Short: "export the database as quads",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			n, err := g.Export(context.TODO(), os.Stdout)
-			if err != nil {
-				return err
-			}
-			fmt.Fprintf(os.Stderr, "Exported: %d quads\n", n)
-			return nil
-		},
+	}
+	fexportOut := registerOutQuadFlag(cmdExport.Flags())
+	fexportFormat := registerFormatFlag(cmdExport.Flags())
+	cmdExport.RunE = func(cmd *cobra.Command, args []string) error {
+		return exportGraph(g, *fexportOut, *fexportFormat)
 	}
 	cmdGit.AddCommand(cmdExport)
 
 	cmdImport := &cobra.Command{
-		Use:   "import <repo> [<repos>...]",
+		Use:   "import <repo or url> [<repos or urls>...]",
 		Short: "import git repositories to the graph",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
-				return errors.New("expected at least one argument")
-			}
-			for _, path := range args {
-				err := g.Import(context.TODO(), path, nil)
-				if err != nil {
-					return err
-				}
+	}
+	fimportFilter := registerFilterFlags(cmdImport.Flags())
+	fimportCache := registerCacheFlag(cmdImport.Flags())
+	fimportRepoID := registerRepoIDFlag(cmdImport.Flags())
+	cmdImport.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("expected at least one argument")
+		} else if *fimportRepoID != "" && len(args) > 1 {
+			return errRepoIDArgs
+		}
+		filter, err := fimportFilter()
+		if err != nil {
+			return err
+		}
+		for _, path := range args {
+			err := g.Import(context.TODO(), path, &codegraph.ExportOptions{
+				Filter: filter, CacheDir: *fimportCache, RepoID: *fimportRepoID,
+			})
+			if err != nil {
+				return err
 			}
-			return nil
-		},
+		}
+		return nil
 	}
 	cmdGit.AddCommand(cmdImport)
 
-	cmdStats := &cobra.Command{
-		Use:   "stats",
-		Short: "print commit stats",
+	cmdRemove := &cobra.Command{
+		Use:   "remove <repo> [<repos>...]",
+		Short: "remove git repositories from the graph",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return errors.New("expected at least one argument")
 			}
-			for _, path := range args {
-				err := g.Import(context.TODO(), path, nil)
+			cmd.SilenceUsage = true
+			for _, repo := range args {
+				n, err := g.RemoveRepo(context.TODO(), repo)
 				if err != nil {
 					return err
 				}
+				fmt.Fprintf(os.Stderr, "Removed: %d quads\n", n)
 			}
 			return nil
 		},
 	}
-	limit := cmdStats.Flags().IntP("limit", "n", 0, "top commits per git repository (0 means no limit)")
+	cmdGit.AddCommand(cmdRemove)
+
+	cmdLsTree := &cobra.Command{
+		Use:   "ls-tree <repo> <ref>",
+		Short: "list files of a repository at a branch, a tag or a commit",
+	}
+	lsTreeFormat := registerStatsFormatFlag(cmdLsTree.Flags())
+	cmdLsTree.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return errors.New("expected a repository and a ref")
+		}
+		return g.PrintFilesAt(context.TODO(), os.Stdout, *lsTreeFormat, args[0], args[1])
+	}
+	cmdGit.AddCommand(cmdLsTree)
+
+	cmdLog := &cobra.Command{
+		Use:   "log <repo> <path>",
+		Short: "print commits which added, modified or removed a path (a file or a directory), most recent first",
+	}
+	logLimit := cmdLog.Flags().IntP("limit", "n", 0, "number of commits (0 means no limit)")
+	logFormat := registerStatsFormatFlag(cmdLog.Flags())
+	cmdLog.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return errors.New("expected a repository and a path")
+		}
+		return g.PrintPathHistory(context.TODO(), os.Stdout, *logFormat, args[0], args[1], *logLimit)
+	}
+	cmdGit.AddCommand(cmdLog)
+
+	cmdStats := &cobra.Command{
+		Use:   "stats [<repo>]",
+		Short: "print commit stats (of all repositories, or of a given one)",
+	}
+	statsOpts := registerStatsFlags(cmdStats.Flags(), "top commits per git repository (0 means no limit)")
 	sort := cmdStats.Flags().String("sort", "touch", "sort commits by [add, remove, modify, touch, file]")
-	noMerge := cmdStats.Flags().Bool("nomerge", false, "do not show merge commits")
+	format := registerStatsFormatFlag(cmdStats.Flags())
 	cmdStats.RunE = func(cmd *cobra.Command, args []string) error {
-		if *limit < 0 {
-			*limit = 0
+		opts, err := statsOpts(args)
+		if err != nil {
+			return err
+		}
+		by, err := codegraph.SortByName(*sort)
+		if err != nil {
+			return fmt.Errorf("Invalid -sort argument: %v", *sort)
 		}
 
-		var by codegraph.SortBy
-		switch strings.ToLower(*sort) {
-		case "add":
-			by = func(cs1, cs2 *codegraph.CommitStats) bool {
-				return cs1.NumAdded > cs2.NumAdded
-			}
+		ctx := context.TODO()
+		if err := g.PrintStats(ctx, os.Stdout, *format, by, opts); err != nil {
+			return err
+		}
+		return nil
+	}
+	cmdGit.AddCommand(cmdStats)
 
-		case "remove":
-			by = func(cs1, cs2 *codegraph.CommitStats) bool {
-				return cs1.NumRemoved > cs2.NumRemoved
-			}
+	cmdActivity := &cobra.Command{
+		Use:   "activity [<repo>]",
+		Short: "print commits, merges, active authors and touched files per time bucket (of all repositories, or of a given one)",
+	}
+	activityOpts := registerStatsFlags(cmdActivity.Flags(), "last time buckets per git repository (0 means no limit)")
+	bucket := cmdActivity.Flags().String("bucket", codegraph.BucketDay, "time bucket ["+strings.Join(codegraph.ActivityBuckets, ", ")+"]")
+	activityFormat := registerStatsFormatFlag(cmdActivity.Flags())
+	cmdActivity.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := activityOpts(args)
+		if err != nil {
+			return err
+		}
+		return g.PrintActivity(context.TODO(), os.Stdout, *activityFormat, *bucket, opts)
+	}
+	cmdStats.AddCommand(cmdActivity)
 
-		case "modify":
-			by = func(cs1, cs2 *codegraph.CommitStats) bool {
-				return cs1.NumModified > cs2.NumModified
-			}
+	cmdLanguages := &cobra.Command{
+		Use:   "languages <repo> <ref>",
+		Short: "print language composition of a repository at a branch, a tag or a commit",
+	}
+	languagesFormat := registerStatsFormatFlag(cmdLanguages.Flags())
+	cmdLanguages.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return errors.New("expected a repository and a ref")
+		}
+		return g.PrintLanguages(context.TODO(), os.Stdout, *languagesFormat, args[0], args[1])
+	}
+	cmdStats.AddCommand(cmdLanguages)
 
-		case "file":
-			by = func(cs1, cs2 *codegraph.CommitStats) bool {
-				return cs1.NumFiles > cs2.NumFiles
-			}
+	cmdLanguageHistory := &cobra.Command{
+		Use:   "language-history [<repo>]",
+		Short: "print files added, removed and modified per language and time bucket (in all repositories, or in a given one)",
+	}
+	languageHistoryOpts := registerStatsFlags(cmdLanguageHistory.Flags(), "")
+	languageBucket := cmdLanguageHistory.Flags().String("bucket", codegraph.BucketMonth, "time bucket ["+strings.Join(codegraph.ActivityBuckets, ", ")+"]")
+	languageHistoryFormat := registerStatsFormatFlag(cmdLanguageHistory.Flags())
+	cmdLanguageHistory.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := languageHistoryOpts(args)
+		if err != nil {
+			return err
+		}
+		return g.PrintLanguageHistory(context.TODO(), os.Stdout, *languageHistoryFormat, *languageBucket, opts)
+	}
+	cmdStats.AddCommand(cmdLanguageHistory)
 
-		case "touch":
-			by = func(cs1, cs2 *codegraph.CommitStats) bool {
-				n1 := cs1.NumAdded + cs1.NumRemoved + cs1.NumModified
-				n2 := cs2.NumAdded + cs2.NumRemoved + cs2.NumModified
-				return n1 > n2
-			}
-		default:
-			return fmt.Errorf("Invalid -sort argument: %v", *sort)
+	cmdSizes := &cobra.Command{
+		Use:   "sizes [<repo>]",
+		Short: "print percentiles of commit sizes (touched files) per repository and author, and outlier commits",
+	}
+	sizesOpts := registerStatsFlags(cmdSizes.Flags(), "top outlier commits per git repository (0 means no limit)")
+	percentile := cmdSizes.Flags().Float64("percentile", codegraph.DefaultOutlierPercentile, "flag commits larger than this percentile of commit sizes")
+	maxFiles := cmdSizes.Flags().Int("max-files", 0, "flag commits touching more files (overrides --percentile)")
+	sizesFormat := registerStatsFormatFlag(cmdSizes.Flags())
+	cmdSizes.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := sizesOpts(args)
+		if err != nil {
+			return err
+		}
+		return g.PrintCommitSizes(context.TODO(), os.Stdout, *sizesFormat, &codegraph.CommitSizeOptions{
+			StatsOptions: *opts,
+			Percentile:   *percentile,
+			MaxFiles:     *maxFiles,
+		})
+	}
+	cmdStats.AddCommand(cmdSizes)
+
+	cmdAuthors := &cobra.Command{
+		Use:   "authors [<repo>]",
+		Short: "print contribution stats of authors (in all repositories, or in a given one)",
+	}
+	authorsOpts := registerStatsFlags(cmdAuthors.Flags(), "top authors (0 means no limit)")
+	authorsSort := cmdAuthors.Flags().String("sort", "commit", "sort authors by [commit, add, remove, modify, touch, file]")
+	authorsFormat := registerStatsFormatFlag(cmdAuthors.Flags())
+	cmdAuthors.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := authorsOpts(args)
+		if err != nil {
+			return err
+		}
+		by, err := codegraph.AuthorSortByName(*authorsSort)
+		if err != nil {
+			return fmt.Errorf("Invalid -sort argument: %v", *authorsSort)
+		}
+		return g.PrintAuthorStats(context.TODO(), os.Stdout, *authorsFormat, by, opts)
+	}
+	cmdGit.AddCommand(cmdAuthors)
+
+	cmdHotspots := &cobra.Command{
+		Use:   "hotspots [<repo>]",
+		Short: "print the most frequently changed paths (in all repositories, or in a given one)",
+	}
+	hotspotsOpts := registerStatsFlags(cmdHotspots.Flags(), "top paths per git repository (0 means no limit)")
+	hotspotsSort := cmdHotspots.Flags().String("sort", "commit", "sort paths by [commit, author, touch]")
+	hotspotsFormat := registerStatsFormatFlag(cmdHotspots.Flags())
+	cmdHotspots.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := hotspotsOpts(args)
+		if err != nil {
+			return err
+		}
+		by, err := codegraph.HotspotSortByName(*hotspotsSort)
+		if err != nil {
+			return fmt.Errorf("Invalid -sort argument: %v", *hotspotsSort)
 		}
+		return g.PrintHotspots(context.TODO(), os.Stdout, *hotspotsFormat, by, opts)
+	}
+	cmdGit.AddCommand(cmdHotspots)
 
+	cmdCoChange := &cobra.Command{
+		Use:   "cochange [<repo>]",
+		Short: "print pairs of paths which change together (in all repositories, or in a given one)",
+	}
+	coChangeOpts := registerStatsFlags(cmdCoChange.Flags(), "top pairs of paths per git repository (0 means no limit)")
+	minCommits := cmdCoChange.Flags().Int("min-commits", 2, "minimal number of commits changing both paths")
+	minConfidence := cmdCoChange.Flags().Float64("min-confidence", 0.5, "minimal share of commits changing a path which also change the other one")
+	maxCommitSize := cmdCoChange.Flags().Int("max-commit-size", codegraph.DefaultMaxCommitSize, "ignore commits changing more paths (0 means no limit)")
+	coChangeWrite := cmdCoChange.Flags().Bool("write", false, "write pairs back to the database as analysis:coChanged links")
+	coChangeFormat := registerStatsFormatFlag(cmdCoChange.Flags())
+	cmdCoChange.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := coChangeOpts(args)
+		if err != nil {
+			return err
+		}
 		ctx := context.TODO()
-		if err := g.PrintStats(ctx, *limit, by, *noMerge); err != nil {
+		cochanges, err := g.CoChanges(ctx, &codegraph.CoChangeOptions{
+			StatsOptions:  *opts,
+			MinCommits:    *minCommits,
+			MinConfidence: *minConfidence,
+			MaxCommitSize: *maxCommitSize,
+		})
+		if err != nil {
 			return err
 		}
+		if err := codegraph.PrintCoChanges(os.Stdout, *coChangeFormat, cochanges); err != nil {
+			return err
+		}
+		if *coChangeWrite {
+			// replace results of previous runs in all analyzed repositories, even those without pairs
+			repos := []string{opts.Repo}
+			if opts.Repo == "" {
+				list, err := g.Repos(ctx)
+				if err != nil {
+					return err
+				}
+				repos = repos[:0]
+				for _, r := range list {
+					repos = append(repos, r.ID)
+				}
+			}
+			n, err := g.WriteCoChanges(ctx, repos, cochanges)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Written: %d quads\n", n)
+		}
 		return nil
 	}
-	cmdGit.AddCommand(cmdStats)
+	cmdGit.AddCommand(cmdCoChange)
+
+	cmdOwnership := &cobra.Command{
+		Use:   "ownership [<repo>]",
+		Short: "print code ownership and bus factor of files or directories (in all repositories, or in a given one)",
+	}
+	ownershipOpts := registerStatsFlags(cmdOwnership.Flags(), "top paths per git repository (0 means no limit)")
+	threshold := cmdOwnership.Flags().Float64("threshold", codegraph.DefaultOwnershipThreshold, "share of changes which authors counted in the bus factor must cover")
+	dirs := cmdOwnership.Flags().Bool("dirs", false, "report directories instead of files")
+	ownershipFormat := registerStatsFormatFlag(cmdOwnership.Flags())
+	cmdOwnership.RunE = func(cmd *cobra.Command, args []string) error {
+		opts, err := ownershipOpts(args)
+		if err != nil {
+			return err
+		}
+		return g.PrintOwnership(context.TODO(), os.Stdout, *ownershipFormat, &codegraph.OwnershipOptions{
+			StatsOptions: *opts,
+			Threshold:    *threshold,
+			Dirs:         *dirs,
+		})
+	}
+	cmdGit.AddCommand(cmdOwnership)
 }
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// openInput opens a file for reading, or stdin if path is "-".
+// Files with ".gz" extension are decompressed.
+func openInput(path string) (io.ReadCloser, error) {
+	var (
+		r io.Reader = os.Stdin
+		c []io.Closer
+	)
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		r = f
+		c = append(c, f)
+	}
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			closeAll(c)
+			return nil, err
+		}
+		r = zr
+		c = append(c, zr)
+	}
+	return &readCloser{Reader: r, c: c}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	c []io.Closer
+}
+
+func (r *readCloser) Close() error {
+	return closeAll(r.c)
+}
+
+func closeAll(c []io.Closer) error {
+	var last error
+	for i := len(c) - 1; i >= 0; i-- {
+		if err := c[i].Close(); err != nil {
+			last = err
+		}
+	}
+	return last
+}
+
+func init() {
+	cmdLoad := &cobra.Command{
+		Use:   "load <file.nq[.gz]> [<files>...]",
+		Short: "load quads from files into the database",
+	}
+	openDB := registerDBFlags(cmdLoad.Flags())
+	cmdLoad.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("expected at least one argument")
+		}
+		g, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer g.Close()
+
+		cmd.SilenceUsage = true
+		for _, path := range args {
+			err := func() error {
+				r, err := openInput(path)
+				if err != nil {
+					return err
+				}
+				defer r.Close()
+
+				n, err := g.Load(context.TODO(), r)
+				fmt.Fprintf(os.Stderr, "%s: loaded %d quads\n", path, n)
+				return err
+			}()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	root.AddCommand(cmdLoad)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/mloncode/codegraph"
+	"github.com/spf13/cobra"
+)
+
+// readQuery reads a query from a file, or from stdin if path is "-".
+func readQuery(path string) (string, error) {
+	r, err := openInput(path)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	return string(data), err
+}
+
+// printJSON writes a value to stdout as indented JSON.
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func init() {
+	cmdQuery := &cobra.Command{
+		Use:   "query <file or -> | query <name> [<args>...]",
+		Short: "run a query (or one of predefined queries) against the database and print results as JSON",
+	}
+	openDB := registerDBFlags(cmdQuery.PersistentFlags())
+	flang := cmdQuery.Flags().String("lang", "gizmo", "query language ["+strings.Join(codegraph.QueryLanguages(), ", ")+"]")
+	flimit := cmdQuery.Flags().IntP("limit", "n", 0, "maximal number of results (0 means no limit)")
+	ftimeout := cmdQuery.Flags().DurationP("timeout", "t", 0, "query timeout (0 means no timeout)")
+	cmdQuery.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("expected one argument")
+		}
+		q, err := readQuery(args[0])
+		if err != nil {
+			return err
+		}
+		g, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer g.Close()
+
+		cmd.SilenceUsage = true
+		ctx := context.Background()
+		if *ftimeout > 0 {
+			var cancel func()
+			ctx, cancel = context.WithTimeout(ctx, *ftimeout)
+			defer cancel()
+		}
+		res, err := g.Query(ctx, *flang, q, *flimit)
+		if err != nil {
+			return err
+		}
+		return printJSON(res)
+	}
+	root.AddCommand(cmdQuery)
+
+	// canned queries
+	addCanned := func(use, short string, minArgs, maxArgs int, run func(g *codegraph.Graph, args []string) (interface{}, error)) {
+		cmdQuery.AddCommand(&cobra.Command{
+			Use:   use,
+			Short: short,
+			Args:  cobra.RangeArgs(minArgs, maxArgs),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				g, err := openDB()
+				if err != nil {
+					return err
+				}
+				defer g.Close()
+
+				cmd.SilenceUsage = true
+				res, err := run(g, args)
+				if err != nil {
+					return err
+				}
+				return printJSON(res)
+			},
+		})
+	}
+	optArg := func(args []string) string {
+		if len(args) > 0 {
+			return args[0]
+		}
+		return ""
+	}
+	addCanned("imports [<repo>]", "list imports in files of a repository (or all files)", 0, 1, func(g *codegraph.Graph, args []string) (interface{}, error) {
+		return g.Imports(context.TODO(), optArg(args))
+	})
+	addCanned("import-stats [<repo>]", "count imports of each package in a repository (or all files)", 0, 1, func(g *codegraph.Graph, args []string) (interface{}, error) {
+		return g.ImportStats(context.TODO(), optArg(args))
+	})
+	addCanned("files-importing <package>", "list files which import a given package", 1, 1, func(g *codegraph.Graph, args []string) (interface{}, error) {
+		return g.FilesImporting(context.TODO(), args[0])
+	})
+	addCanned("identifiers <file>", "list identifiers in a file (file ID or blob hash)", 1, 1, func(g *codegraph.Graph, args []string) (interface{}, error) {
+		return g.Identifiers(context.TODO(), args[0])
+	})
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/mloncode/codegraph"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdServe := &cobra.Command{
+		Use:   "serve",
+		Short: "serve Cayley's query API and UI, and codegraph REST endpoints over the database",
+	}
+	openDB := registerDBFlags(cmdServe.Flags())
+	host := cmdServe.Flags().String("host", "127.0.0.1", "host to listen on")
+	port := cmdServe.Flags().IntP("port", "p", 64210, "port to listen on")
+	assets := cmdServe.Flags().String("assets", "", "directory with Cayley's UI (templates and static files) to use instead of the built-in one")
+	write := cmdServe.Flags().Bool("write", false, "enable write endpoints of Cayley's API (read-only by default)")
+	timeout := cmdServe.Flags().DurationP("timeout", "t", 30*time.Second, "query timeout (0 means no timeout)")
+	limit := cmdServe.Flags().IntP("limit", "n", 100, "default limit of query results (0 means no limit)")
+	cmdServe.RunE = func(cmd *cobra.Command, args []string) error {
+		g, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer g.Close()
+
+		h, err := codegraph.NewServer(g, &codegraph.ServerOptions{
+			Write:   *write,
+			Timeout: *timeout,
+			Limit:   *limit,
+			Assets:  *assets,
+		})
+		if err != nil {
+			return err
+		}
+
+		cmd.SilenceUsage = true
+		addr := net.JoinHostPort(*host, strconv.Itoa(*port))
+		fmt.Fprintf(os.Stderr, "Listening on http://%s\n", addr)
+		return http.ListenAndServe(addr, h)
+	}
+	root.AddCommand(cmdServe)
+}
@@ -0,0 +1,276 @@
+package codegraph
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+const (
+	// DefaultMaxCommitSize is the default number of changed paths above which commits are ignored by co-change analysis.
+	DefaultMaxCommitSize = 50
+
+	coChangeBatchSize = 10000
+)
+
+type (
+	// CoChange describes how often two paths of a repository change together
+	CoChange struct {
+		Repo   string `json:"repo"` // repository ID
+		Path   string `json:"path"`
+		CoPath string `json:"co_path"`
+
+		NumCommits int     `json:"commits"`    // number of commits changing both paths
+		Support    float64 `json:"support"`    // share of analyzed commits changing both paths
+		Confidence float64 `json:"confidence"` // share of commits changing Path which also change CoPath
+	}
+
+	// CoChangeOptions controls co-change analysis
+	CoChangeOptions struct {
+		StatsOptions // commits to analyze; Limit is the number of path pairs per repository
+
+		MinCommits    int     // skip pairs of paths changed together by fewer commits
+		MinConfidence float64 // skip pairs of paths with a lower confidence
+		MaxCommitSize int     // skip commits changing more paths (e.g. bulk renames); 0 means no limit
+	}
+)
+
+// CoChanges returns pairs of paths which change together in all repositories (or in opts.Repo), grouped by repository.
+// Pairs are directed: confidence of (a, b) is the share of commits changing a which also change b.
+// Pairs of each repository are sorted by confidence, then by the number of commits.
+func (g *Graph) CoChanges(ctx context.Context, opts *CoChangeOptions) ([]*CoChange, error) {
+	if opts == nil {
+		opts = &CoChangeOptions{MaxCommitSize: DefaultMaxCommitSize}
+	}
+	repos, err := g.selectRepos(ctx, &opts.StatsOptions)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, &opts.StatsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*CoChange, 0)
+	for _, repo := range repos {
+		out = append(out, h.coChanges(repo, opts)...)
+	}
+	return out, nil
+}
+
+// coChanges returns pairs of paths which change together in a repository.
+func (h *history) coChanges(repo quad.Value, opts *CoChangeOptions) []*CoChange {
+	type pair struct {
+		a, b string
+	}
+	var (
+		total  int
+		counts = make(map[string]int)
+		pairs  = make(map[pair]int)
+	)
+	for _, c := range h.commits(repo, &opts.StatsOptions) {
+		seen := make(map[string]struct{})
+		var paths []string
+		for _, ch := range h.changes[c] {
+			if _, ok := seen[ch.Path]; !ok {
+				seen[ch.Path] = struct{}{}
+				paths = append(paths, ch.Path)
+			}
+		}
+		if len(paths) == 0 || (opts.MaxCommitSize > 0 && len(paths) > opts.MaxCommitSize) {
+			continue
+		}
+		total++
+		sort.Strings(paths)
+		for i, a := range paths {
+			counts[a]++
+			for _, b := range paths[i+1:] {
+				pairs[pair{a, b}]++
+			}
+		}
+	}
+
+	out := make([]*CoChange, 0)
+	add := func(a, b string, n int) {
+		cc := &CoChange{
+			Repo:       iriString(repo),
+			Path:       a,
+			CoPath:     b,
+			NumCommits: n,
+			Support:    float64(n) / float64(total),
+			Confidence: float64(n) / float64(counts[a]),
+		}
+		if cc.Confidence >= opts.MinConfidence {
+			out = append(out, cc)
+		}
+	}
+	for p, n := range pairs {
+		if n < opts.MinCommits {
+			continue
+		}
+		add(p.a, p.b, n)
+		add(p.b, p.a, n)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		c1, c2 := out[i], out[j]
+		if c1.Confidence != c2.Confidence {
+			return c1.Confidence > c2.Confidence
+		}
+		if c1.NumCommits != c2.NumCommits {
+			return c1.NumCommits > c2.NumCommits
+		}
+		if c1.Path != c2.Path {
+			return c1.Path < c2.Path
+		}
+		return c1.CoPath < c2.CoPath
+	})
+	if opts.Limit > 0 && len(out) > opts.Limit {
+		out = out[:opts.Limit]
+	}
+	return out
+}
+
+// pathNode returns an ID of a node for a path in a repository.
+// Every segment of the path is percent-escaped, so paths with spaces, '#' or '>' still give valid IRIs;
+// the original path is stored as the node name.
+func pathNode(repo, path string) quad.IRI {
+	segs := strings.Split(path, "/")
+	for i, s := range segs {
+		segs[i] = url.PathEscape(s)
+	}
+	return quad.IRI("path:" + repo + "#" + strings.Join(segs, "/"))
+}
+
+// coChangeNode returns an ID of a node describing a co-change pair of paths in a repository.
+func coChangeNode(repo, path, coPath string) quad.IRI {
+	return quad.IRI(fmt.Sprintf("cochange:%x", sha1.Sum([]byte(repo+"\x00"+path+"\x00"+coPath))))
+}
+
+// WriteCoChanges writes co-change pairs back to the graph. Every pair is written as an analysis:coChanged link
+// between path nodes and as a pair node linked from the repository with analysis:coChange, which refers
+// to the path nodes with analysis:from and analysis:to and stores analysis:commits, analysis:support
+// and analysis:confidence. Path nodes are linked to repositories with analysis:path and have a name.
+// Path nodes and pair nodes from previous runs are removed for all given repositories (the analyzed ones),
+// including repositories without pairs. Returns number of written quads.
+func (g *Graph) WriteCoChanges(ctx context.Context, repos []string, cochanges []*CoChange) (int, error) {
+	var (
+		buf  []graph.Delta
+		n    int
+		seen = make(map[quad.Quad]struct{})
+	)
+	flush := func() error {
+		if len(buf) == 0 {
+			return nil
+		}
+		err := g.store.ApplyDeltas(buf, graph.IgnoreOpts{IgnoreDup: true, IgnoreMissing: true})
+		buf = buf[:0]
+		return err
+	}
+	apply := func(q quad.Quad, action graph.Procedure) error {
+		if _, ok := seen[q]; ok {
+			return nil
+		}
+		seen[q] = struct{}{}
+		buf = append(buf, graph.Delta{Quad: q, Action: action})
+		if action == graph.Add {
+			n++
+		}
+		if len(buf) >= coChangeBatchSize {
+			return flush()
+		}
+		return nil
+	}
+
+	// remove nodes from previous runs
+	for _, repo := range repos {
+		id, err := g.findRepo(ctx, repo)
+		if err != nil {
+			return 0, err
+		}
+		for _, pred := range []quad.IRI{predAnalysisPath, predCoChange} {
+			for _, q := range linkedQuads(ctx, g.store, quad.Subject, id, pred) {
+				if err := apply(q, graph.Delete); err != nil {
+					return 0, err
+				}
+				for _, q := range linkedQuads(ctx, g.store, quad.Subject, q.Object, "") {
+					if err := apply(q, graph.Delete); err != nil {
+						return 0, err
+					}
+				}
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	seen = make(map[quad.Quad]struct{})
+
+	for _, cc := range cochanges {
+		var (
+			repo     = quad.IRI(cc.Repo)
+			from, to = pathNode(cc.Repo, cc.Path), pathNode(cc.Repo, cc.CoPath)
+			pair     = coChangeNode(cc.Repo, cc.Path, cc.CoPath)
+		)
+		for _, q := range []quad.Quad{
+			{Subject: repo, Predicate: predAnalysisPath, Object: from},
+			{Subject: from, Predicate: git.PredName, Object: quad.String(cc.Path)},
+			{Subject: repo, Predicate: predAnalysisPath, Object: to},
+			{Subject: to, Predicate: git.PredName, Object: quad.String(cc.CoPath)},
+			{Subject: from, Predicate: predCoChanged, Object: to},
+			{Subject: repo, Predicate: predCoChange, Object: pair},
+			{Subject: pair, Predicate: predCoChangeFrom, Object: from},
+			{Subject: pair, Predicate: predCoChangeTo, Object: to},
+			{Subject: pair, Predicate: predCoChangeCommits, Object: quad.Int(cc.NumCommits)},
+			{Subject: pair, Predicate: predCoChangeSupport, Object: quad.Float(cc.Support)},
+			{Subject: pair, Predicate: predCoChangeConfidence, Object: quad.Float(cc.Confidence)},
+		} {
+			if err := apply(q, graph.Add); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, flush()
+}
+
+// PrintCoChanges writes co-change pairs to w in a given format: text (default), json or csv.
+func PrintCoChanges(w io.Writer, format string, cochanges []*CoChange) error {
+	header := []string{"repo", "path", "co_path", "commits", "support", "confidence"}
+	return writeReport(w, format, cochanges, header, func() [][]string {
+		return coChangeRows(cochanges)
+	}, func(w io.Writer) {
+		printCoChanges(w, cochanges)
+	})
+}
+
+func printCoChanges(w io.Writer, cochanges []*CoChange) {
+	repo := ""
+	for _, cc := range cochanges {
+		if cc.Repo != repo {
+			repo = cc.Repo
+			fmt.Fprintf(w, "\n%s\n--\n", quad.IRI(repo).String())
+		}
+		fmt.Fprintf(w, "%s -> %s: %d commits, support %.3f, confidence %.3f\n", cc.Path, cc.CoPath, cc.NumCommits, cc.Support, cc.Confidence)
+	}
+}
+
+func coChangeRows(cochanges []*CoChange) [][]string {
+	rows := make([][]string, 0, len(cochanges))
+	for _, cc := range cochanges {
+		rows = append(rows, []string{
+			cc.Repo, cc.Path, cc.CoPath,
+			strconv.Itoa(cc.NumCommits),
+			strconv.FormatFloat(cc.Support, 'f', -1, 64),
+			strconv.FormatFloat(cc.Confidence, 'f', -1, 64),
+		})
+	}
+	return rows
+}
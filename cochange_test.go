@@ -0,0 +1,32 @@
+package codegraph
+
+import (
+	"testing"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/cayleygraph/cayley/quad/nquads"
+)
+
+func TestPathNode(t *testing.T) {
+	for _, c := range []struct {
+		path string
+		exp  quad.IRI
+	}{
+		{path: "cmd/main.go", exp: "path:github.com/x/y#cmd/main.go"},
+		{path: "docs/read me.md", exp: "path:github.com/x/y#docs/read%20me.md"},
+		{path: "a#b/c>d.txt", exp: "path:github.com/x/y#a%23b/c%3Ed.txt"},
+	} {
+		id := pathNode("github.com/x/y", c.path)
+		if id != c.exp {
+			t.Errorf("pathNode(%q): %v, expected: %v", c.path, id, c.exp)
+		}
+		// the IRI survives a round trip through N-Quads
+		q := quad.Quad{Subject: id, Predicate: predCoChanged, Object: id}
+		q2, err := nquads.Parse(q.NQuad())
+		if err != nil {
+			t.Errorf("pathNode(%q): %v", c.path, err)
+		} else if q2 != q {
+			t.Errorf("pathNode(%q): parsed as %v", c.path, q2)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package codegraph
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+	"github.com/mloncode/codegraph/uast"
+)
+
+// DotOptions controls Graphviz DOT export.
+type DotOptions struct {
+	MaxDepth int // maximal depth of the rendered graph (0 means no limit)
+}
+
+// dotWriter writes a directed graph in Graphviz DOT format.
+type dotWriter struct {
+	w   *bufio.Writer
+	ids map[quad.Value]int
+	n   int
+}
+
+func newDotWriter(w io.Writer, name string) *dotWriter {
+	dw := &dotWriter{w: bufio.NewWriter(w), ids: make(map[quad.Value]int)}
+	fmt.Fprintf(dw.w, "digraph %s {\n", dotQuote(name))
+	return dw
+}
+
+func dotQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	return `"` + s + `"`
+}
+
+func (w *dotWriter) attr(s string) {
+	fmt.Fprintf(w.w, "\t%s;\n", s)
+}
+
+// node writes a node if it was not written before and returns its DOT ID.
+func (w *dotWriter) node(v quad.Value, label string, attrs ...string) string {
+	if id, ok := w.ids[v]; ok {
+		return fmt.Sprintf("n%d", id)
+	}
+	w.ids[v] = w.n
+	return w.leaf(label, attrs...)
+}
+
+// leaf writes a new node that is never shared with other nodes and returns its DOT ID.
+func (w *dotWriter) leaf(label string, attrs ...string) string {
+	sid := fmt.Sprintf("n%d", w.n)
+	w.n++
+	fmt.Fprintf(w.w, "\t%s [label=%s", sid, dotQuote(label))
+	for i := 0; i+1 < len(attrs); i += 2 {
+		fmt.Fprintf(w.w, ", %s=%s", attrs[i], dotQuote(attrs[i+1]))
+	}
+	fmt.Fprint(w.w, "];\n")
+	return sid
+}
+
+func (w *dotWriter) edge(from, to, label string) {
+	if label == "" {
+		fmt.Fprintf(w.w, "\t%s -> %s;\n", from, to)
+		return
+	}
+	fmt.Fprintf(w.w, "\t%s -> %s [label=%s];\n", from, to, dotQuote(label))
+}
+
+func (w *dotWriter) Close() error {
+	fmt.Fprint(w.w, "}\n")
+	return w.w.Flush()
+}
+
+// CommitsDOT writes a commit graph of a repository in Graphviz DOT format.
+// It renders commits reachable from the "to" ref, but not from the "from" ref (like "git log from..to").
+// Refs can be branch names, tag names or commit hashes; if "from" is empty, all ancestors are rendered.
+func (g *Graph) CommitsDOT(ctx context.Context, w io.Writer, repo, from, to string, opts *DotOptions) error {
+	if opts == nil {
+		opts = &DotOptions{}
+	}
+	repoIRI, err := g.findRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+	head, err := g.resolveRef(ctx, repoIRI, to)
+	if err != nil {
+		return err
+	}
+	exclude := make(map[quad.Value]struct{})
+	if from != "" {
+		base, err := g.resolveRef(ctx, repoIRI, from)
+		if err != nil {
+			return err
+		}
+		g.walkParents(ctx, base, 0, func(c quad.Value, _ int) bool {
+			exclude[c] = struct{}{}
+			return true
+		})
+	}
+
+	dw := newDotWriter(w, string(repoIRI))
+	dw.attr("rankdir=BT")
+	dw.attr("node [shape=box]")
+	g.walkParents(ctx, head, opts.MaxDepth, func(c quad.Value, depth int) bool {
+		if _, ok := exclude[c]; ok {
+			return false
+		}
+		id := dw.node(c, g.commitLabel(ctx, c))
+		if opts.MaxDepth > 0 && depth+1 >= opts.MaxDepth {
+			// parents are beyond the depth limit
+			return true
+		}
+		for _, p := range outValues(ctx, g.store, c, git.PredParent) {
+			if _, ok := exclude[p]; ok {
+				continue
+			}
+			dw.edge(id, dw.node(p, g.commitLabel(ctx, p)), "")
+		}
+		return true
+	})
+	return dw.Close()
+}
+
+// walkParents visits commits starting from a given one in breadth-first order, following git:parent links.
+// Parents of a commit are not visited if fnc returns false, or if the depth limit is reached.
+func (g *Graph) walkParents(ctx context.Context, start quad.Value, maxDepth int, fnc func(c quad.Value, depth int) bool) {
+	seen := map[quad.Value]struct{}{start: {}}
+	cur := []quad.Value{start}
+	for depth := 0; len(cur) > 0; depth++ {
+		var next []quad.Value
+		for _, c := range cur {
+			if !fnc(c, depth) || (maxDepth > 0 && depth+1 >= maxDepth) {
+				continue
+			}
+			for _, p := range outValues(ctx, g.store, c, git.PredParent) {
+				if _, ok := seen[p]; !ok {
+					seen[p] = struct{}{}
+					next = append(next, p)
+				}
+			}
+		}
+		cur = next
+	}
+}
+
+// iriString returns an IRI without angle brackets, or a string representation of other values.
+func iriString(v quad.Value) string {
+	if iri, ok := v.(quad.IRI); ok {
+		return string(iri)
+	}
+	return valueString(v)
+}
+
+// commitLabel returns an abbreviated commit hash with the first line of the commit message.
+func (g *Graph) commitLabel(ctx context.Context, c quad.Value) string {
+	hash := strings.TrimPrefix(iriString(c), "sha1:")
+	if len(hash) > 7 {
+		hash = hash[:7]
+	}
+	msg := outString(ctx, g.store, c, git.PredMessage)
+	if i := strings.Index(msg, "\n"); i >= 0 {
+		msg = msg[:i]
+	}
+	return hash + "\n" + msg
+}
+
+// UASTDOT writes the UAST of a file in Graphviz DOT format.
+// The file can be given by its ID (e.g. "sha1:<blob hash>") or by a blob hash.
+// Node labels contain the UAST type and the name (if any); positions and roles are omitted.
+func (g *Graph) UASTDOT(ctx context.Context, w io.Writer, file string, opts *DotOptions) error {
+	if opts == nil {
+		opts = &DotOptions{}
+	}
+	fileIRI, roots, err := g.uastRoots(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	dw := newDotWriter(w, string(fileIRI))
+	dw.attr("node [shape=box]")
+	fid := dw.node(fileIRI, string(fileIRI), "shape", "folder")
+
+	type item struct {
+		parent string
+		pred   quad.Value
+		node   quad.Value
+		depth  int
+	}
+	stack := make([]item, 0, len(roots))
+	for i := len(roots) - 1; i >= 0; i-- {
+		stack = append(stack, item{parent: fid, pred: uast.PredRoot, node: roots[i], depth: 1})
+	}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if _, ok := it.node.(quad.BNode); !ok {
+			// literal field value
+			id := dw.leaf(valueString(it.node), "shape", "plaintext")
+			dw.edge(it.parent, id, iriString(it.pred))
+			continue
+		}
+		id := dw.node(it.node, g.uastLabel(ctx, it.node))
+		dw.edge(it.parent, id, iriString(it.pred))
+		if opts.MaxDepth > 0 && it.depth >= opts.MaxDepth {
+			continue
+		}
+		quads := linkedQuads(ctx, g.store, quad.Subject, it.node, "")
+		for i := len(quads) - 1; i >= 0; i-- {
+			switch quads[i].Predicate {
+			case git.PredType, predUASTName, uast.PredRole, uast.PredPos:
+				continue
+			}
+			stack = append(stack, item{parent: id, pred: quads[i].Predicate, node: quads[i].Object, depth: it.depth + 1})
+		}
+	}
+	return dw.Close()
+}
+
+// uastLabel returns the UAST type of a node with its name (if any).
+func (g *Graph) uastLabel(ctx context.Context, n quad.Value) string {
+	label := ""
+	if typ, ok := outValue(ctx, g.store, n, git.PredType).(quad.IRI); ok {
+		label = string(typ)
+	}
+	name := outString(ctx, g.store, n, predUASTName)
+	if name == "" {
+		name = outString(ctx, g.store, n, git.PredName)
+	}
+	if name != "" {
+		label += "\n" + name
+	}
+	return label
+}
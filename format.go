@@ -0,0 +1,98 @@
+package codegraph
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cayleygraph/cayley/quad"
+	_ "github.com/cayleygraph/cayley/quad/json"
+	_ "github.com/cayleygraph/cayley/quad/jsonld"
+	"github.com/cayleygraph/cayley/quad/nquads"
+)
+
+const (
+	// FormatNQuads is the default export format.
+	FormatNQuads = "nquads"
+	// FormatNTriples is N-Triples format; quad labels are dropped.
+	FormatNTriples = "ntriples"
+	// FormatNDJSON is a newline-delimited JSON with one quad per line.
+	FormatNDJSON = "ndjson"
+)
+
+// exportFormat describes an export format. Most formats are taken from Cayley's registry.
+type exportFormat struct {
+	Ext    []string
+	Writer func(w io.Writer) quad.WriteCloser
+}
+
+var exportFormats = map[string]exportFormat{
+	FormatNQuads: {
+		Ext:    []string{".nq"},
+		Writer: func(w io.Writer) quad.WriteCloser { return nquads.NewWriter(w) },
+	},
+	FormatNTriples: {
+		Ext: []string{".nt"},
+		Writer: func(w io.Writer) quad.WriteCloser {
+			return &tripleWriter{nquads.NewWriter(w)}
+		},
+	},
+	FormatNDJSON: {
+		Ext:    []string{".ndjson"},
+		Writer: quad.FormatByName("json-stream").Writer,
+	},
+	"jsonld": {
+		Ext:    []string{".jsonld"},
+		Writer: quad.FormatByName("jsonld").Writer,
+	},
+	FormatJSON: {
+		Ext:    []string{".json"},
+		Writer: quad.FormatByName("json").Writer,
+	},
+}
+
+// ExportFormats returns names of supported export formats.
+func ExportFormats() []string {
+	names := make([]string, 0, len(exportFormats))
+	for name := range exportFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FormatByExt returns the name of an export format for a given file extension (e.g. ".nq"),
+// or an empty string if there is no such format.
+func FormatByExt(ext string) string {
+	for name, f := range exportFormats {
+		for _, e := range f.Ext {
+			if e == ext {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// NewFormatWriter creates a quad writer for a given export format.
+// Empty format name means N-Quads.
+func NewFormatWriter(w io.Writer, format string) (quad.WriteCloser, error) {
+	if format == "" {
+		format = FormatNQuads
+	}
+	f, ok := exportFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported format: %q", format)
+	}
+	return f.Writer(w), nil
+}
+
+// tripleWriter drops quad labels.
+type tripleWriter struct {
+	quad.WriteCloser
+}
+
+func (w *tripleWriter) WriteQuad(q quad.Quad) error {
+	q.Label = nil
+	return w.WriteCloser.WriteQuad(q)
+}
@@ -0,0 +1,74 @@
+package git
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/storage/memory"
+)
+
+// refSpecs map all remote branches and tags to local ones, so that
+// branches of a cloned repository are exported the same way as for a local checkout.
+var refSpecs = []config.RefSpec{
+	"+refs/heads/*:refs/heads/*",
+	"+refs/tags/*:refs/tags/*",
+}
+
+// isRemote checks if gitpath should be cloned instead of opened in-place.
+func isRemote(gitpath string) bool {
+	if strings.Contains(gitpath, "://") {
+		return true
+	}
+	if _, err := os.Stat(gitpath); err == nil {
+		return false
+	}
+	// scp-like syntax: [user@]host:path
+	i := strings.Index(gitpath, ":")
+	return i > 1 && !strings.ContainsAny(gitpath[:i], `/\`)
+}
+
+// cloneGit clones a remote repository into a cache directory, or fetches
+// updates if it was cloned before. If cacheDir is empty, the repository is
+// cloned into memory.
+func cloneGit(url, cacheDir string) (*git.Repository, error) {
+	if cacheDir == "" {
+		repo, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{URL: url})
+		if err != nil {
+			return nil, err
+		}
+		return repo, fetchGit(repo)
+	}
+
+	dir := filepath.Join(cacheDir, cacheName(url))
+	repo, err := git.PlainOpen(dir)
+	if err == git.ErrRepositoryNotExists {
+		repo, err = git.PlainClone(dir, true, &git.CloneOptions{URL: url})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return repo, fetchGit(repo)
+}
+
+// fetchGit fetches all branches and tags from the origin.
+func fetchGit(repo *git.Repository) error {
+	err := repo.Fetch(&git.FetchOptions{RefSpecs: refSpecs, Tags: git.NoTags})
+	if err == git.NoErrAlreadyUpToDate {
+		err = nil
+	}
+	return err
+}
+
+// cacheName returns a directory name for a cached clone of the repository.
+func cacheName(url string) string {
+	h := sha1.Sum([]byte(url))
+	name := strings.TrimSuffix(path.Base(strings.TrimRight(url, "/")), ".git")
+	name = strings.Replace(name, ":", "_", -1)
+	return name + "-" + hex.EncodeToString(h[:8]) + ".git"
+}
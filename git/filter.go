@@ -0,0 +1,103 @@
+package git
+
+import (
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+// Filter selects a subset of the history to export.
+// Zero value exports all commits reachable from HEAD.
+// Branches are exported only if their tip commit is exported.
+type Filter struct {
+	Since    time.Time // skip commits committed before this time (if set)
+	Until    time.Time // skip commits committed at or after this time (if set)
+	Author   string    // regexp matched against "name <email>" of the commit author
+	Paths    []string  // pathspecs; skip commits that do not change any of them
+	MaxCount int       // maximal number of commits to export (0 means no limit)
+}
+
+type commitFilter struct {
+	Filter
+	author *regexp.Regexp
+}
+
+func newCommitFilter(f Filter) (*commitFilter, error) {
+	cf := &commitFilter{Filter: f}
+	if f.Author != "" {
+		re, err := regexp.Compile(f.Author)
+		if err != nil {
+			return nil, err
+		}
+		cf.author = re
+	}
+	return cf, nil
+}
+
+// logOptions maps the filter onto go-git log options.
+func (f *commitFilter) logOptions() *git.LogOptions {
+	opts := &git.LogOptions{}
+	if f.MaxCount > 0 {
+		// like git log, take the most recent commits first
+		opts.Order = git.LogOrderCommitterTime
+	}
+	return opts
+}
+
+// matchCommit checks commit metadata; it is cheap and runs before the diff.
+func (f *commitFilter) matchCommit(c *object.Commit) bool {
+	t := c.Committer.When
+	if !f.Since.IsZero() && t.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !t.Before(f.Until) {
+		return false
+	}
+	if f.author != nil && !f.author.MatchString(c.Author.Name+" <"+c.Author.Email+">") {
+		return false
+	}
+	return true
+}
+
+// matchChanges reports whether any of the changes touch one of the pathspecs.
+func (f *commitFilter) matchChanges(changes object.Changes) bool {
+	if len(f.Paths) == 0 {
+		return true
+	}
+	for _, ch := range changes {
+		for _, name := range []string{ch.From.Name, ch.To.Name} {
+			if name != "" && f.matchPath(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *commitFilter) matchPath(name string) bool {
+	for _, spec := range f.Paths {
+		if matchPathspec(spec, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPathspec matches a file name against a pathspec, which can be
+// an exact path, a directory prefix or a glob pattern.
+func matchPathspec(spec, name string) bool {
+	spec = strings.TrimPrefix(spec, "./")
+	if spec == "" || spec == "." {
+		return true
+	}
+	if strings.ContainsAny(spec, "*?[") {
+		ok, _ := path.Match(spec, name)
+		return ok
+	}
+	spec = strings.TrimSuffix(spec, "/")
+	return name == spec || strings.HasPrefix(name, spec+"/")
+}
@@ -3,6 +3,7 @@ package git
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"path"
 	"strings"
 
 	bblfsh "github.com/bblfsh/go-client/v4"
@@ -12,6 +13,7 @@ import (
 	"gopkg.in/src-d/go-git.v4"
 	"gopkg.in/src-d/go-git.v4/plumbing"
 	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/storer"
 	"gopkg.in/src-d/go-git.v4/utils/merkletrie"
 )
 
@@ -19,6 +21,7 @@ const (
 	// TypeRepo node keep (back) references to all to level nodes (so far used only for repos)
 	TypeRepo   = quad.IRI("git:Repo")
 	TypeBranch = quad.IRI("git:Branch")
+	TypeTag    = quad.IRI("git:Tag")
 	TypeCommit = quad.IRI("git:Commit")
 	TypeFile   = quad.IRI("git:File")
 	TypeAuthor = quad.IRI("git:Author")
@@ -26,8 +29,12 @@ const (
 	// node type predicate
 	PredType = quad.IRI(rdf.Type)
 
+	// repos
+	PredURL = quad.IRI("git:url")
+
 	// commits
 	PredBranch   = quad.IRI("git:branch")
+	PredTag      = quad.IRI("git:tag")
 	PredCommit   = quad.IRI("git:commit")
 	PredMetadata = quad.IRI("git:metadata")
 	PredMessage  = quad.IRI("git:message")
@@ -38,9 +45,16 @@ const (
 	PredChild    = quad.IRI("git:child")
 	PredParent   = quad.IRI("git:parent")
 
+	// commit time and timezone offset (e.g. "+0200")
+	PredAuthoredAt  = quad.IRI("git:authoredAt")
+	PredAuthoredTZ  = quad.IRI("git:authoredTZ")
+	PredCommittedAt = quad.IRI("git:committedAt")
+	PredCommittedTZ = quad.IRI("git:committedTZ")
+
 	// files
 	PredFile     = quad.IRI("git:file")
 	PredFilename = quad.IRI("git:filename")
+	PredSize     = quad.IRI("git:size")
 	PredAdd      = quad.IRI("git:add")
 	PredRemove   = quad.IRI("git:remove")
 	PredModify   = quad.IRI("git:modify")
@@ -63,6 +77,9 @@ type QuadExporter struct {
 	Hooks struct {
 		OnFile func(id quad.Value, f *object.File) error
 	}
+	Filter   Filter // selects commits to export
+	CacheDir string // directory for clones of remote repositories; clone in memory if empty
+	RepoID   string // overrides the repository ID derived from its URL
 	ExportStats
 }
 
@@ -78,19 +95,30 @@ func NewQuadExporter(w quad.Writer) (*QuadExporter, error) {
 	return exp, nil
 }
 
-// ExportPath writes a git repository at a given path as a set of quads.
+// ExportPath writes a git repository at a given path or URL as a set of quads.
+// Remote repositories are cloned into CacheDir, or into memory if it is not set.
 func (e *QuadExporter) ExportPath(gitpath string) error {
-	repo, repoIRI, err := openGit(gitpath)
+	filter, err := newCommitFilter(e.Filter)
 	if err != nil {
 		return err
 	}
+	repo, repoIRI, urls, err := openGit(gitpath, e.CacheDir)
+	if err != nil {
+		return err
+	}
+	if e.RepoID != "" {
+		repoIRI = quad.IRI(e.RepoID)
+	}
 
 	imp := &repoExporter{
 		e:       e,
 		repo:    repo,
 		repoIRI: repoIRI,
+		urls:    urls,
+		filter:  filter,
 	}
 	imp.seen.files = make(map[plumbing.Hash]struct{})
+	imp.seen.commits = make(map[plumbing.Hash]struct{})
 	defer imp.Close()
 	return imp.Do()
 }
@@ -116,10 +144,13 @@ type repoExporter struct {
 
 	repo    *git.Repository
 	repoIRI quad.IRI
+	urls    []string // original URLs of the repository
+	filter  *commitFilter
 	cli     *bblfsh.Client // optional
 
 	seen struct {
-		files map[plumbing.Hash]struct{}
+		files   map[plumbing.Hash]struct{}
+		commits map[plumbing.Hash]struct{} // exported commits
 	}
 }
 
@@ -138,15 +169,38 @@ func (imp *repoExporter) Do() error {
 	}); err != nil {
 		return err
 	}
-	if err := imp.importBranches(); err != nil {
+	if err := imp.importRepoInfo(); err != nil {
 		return err
 	}
 	if err := imp.importCommits(); err != nil {
 		return err
 	}
+	// branches go after commits to skip the ones pointing to commits which were not exported
+	if err := imp.importBranches(); err != nil {
+		return err
+	}
+	if err := imp.importTags(); err != nil {
+		return err
+	}
 	return nil
 }
 
+func (imp *repoExporter) importRepoInfo() error {
+	quads := []quad.Quad{{
+		Subject:   imp.repoIRI,
+		Predicate: PredName,
+		Object:    quad.String(path.Base(string(imp.repoIRI))),
+	}}
+	for _, u := range imp.urls {
+		quads = append(quads, quad.Quad{
+			Subject:   imp.repoIRI,
+			Predicate: PredURL,
+			Object:    quad.String(u),
+		})
+	}
+	return imp.e.WriteQuads(quads...)
+}
+
 func (imp *repoExporter) importBranches() error {
 	it, err := imp.repo.Branches()
 	if err != nil {
@@ -155,6 +209,10 @@ func (imp *repoExporter) importBranches() error {
 	defer it.Close()
 
 	return it.ForEach(func(b *plumbing.Reference) error {
+		if _, ok := imp.seen.commits[b.Hash()]; !ok {
+			// filtered out or not reachable from HEAD
+			return nil
+		}
 		commitIRI := gitHashToIRI(b.Hash())
 		branchIRI := imp.repoIRI + "/" + quad.IRI(b.Name())
 
@@ -183,41 +241,154 @@ func (imp *repoExporter) importBranches() error {
 	})
 }
 
+// importTags imports lightweight and annotated tags; annotated tags are peeled to commits.
+// Tags pointing to other objects (e.g. trees) or to commits which were not exported are skipped.
+func (imp *repoExporter) importTags() error {
+	it, err := imp.repo.Tags()
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	return it.ForEach(func(t *plumbing.Reference) error {
+		hash := t.Hash()
+		if tag, err := imp.repo.TagObject(hash); err == nil {
+			c, err := tag.Commit()
+			if err == object.ErrUnsupportedObject {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			hash = c.Hash
+		} else if err != plumbing.ErrObjectNotFound {
+			return err
+		}
+		if _, ok := imp.seen.commits[hash]; !ok {
+			return nil
+		}
+		tagIRI := imp.repoIRI + "/" + quad.IRI(t.Name())
+
+		return imp.e.WriteQuads([]quad.Quad{
+			{
+				Subject:   imp.repoIRI,
+				Predicate: PredTag,
+				Object:    tagIRI,
+			},
+			{
+				Subject:   tagIRI,
+				Predicate: PredCommit,
+				Object:    gitHashToIRI(hash),
+			},
+			{
+				Subject:   tagIRI,
+				Predicate: PredType,
+				Object:    TypeTag,
+			},
+			{
+				Subject:   tagIRI,
+				Predicate: PredName,
+				Object:    quad.String(t.Name().Short()),
+			},
+		}...)
+	})
+}
+
 func (imp *repoExporter) importCommits() error {
-	it, err := imp.repo.Log(&git.LogOptions{})
+	it, err := imp.repo.Log(imp.filter.logOptions())
 	if err != nil {
 		return err
 	}
 	defer it.Close()
 
+	n := 0
 	return it.ForEach(func(c *object.Commit) error {
+		if imp.filter.MaxCount > 0 && n >= imp.filter.MaxCount {
+			return storer.ErrStop
+		}
+		if !imp.filter.matchCommit(c) {
+			return nil
+		}
+		changes, err := commitChanges(c)
+		if err != nil {
+			return err
+		}
+		if !imp.filter.matchChanges(changes) {
+			return nil
+		}
+		n++
 		imp.e.Commits++
-		return imp.importCommit(c)
+		imp.seen.commits[c.Hash] = struct{}{}
+		return imp.importCommit(c, changes)
 	})
 }
 
-func openGit(gitpath string) (*git.Repository, quad.IRI, error) {
-	repoIRI := quad.IRI(gitpath)
-
-	// We instanciate a new repository targeting the given path (the .git folder)
-	repo, err := git.PlainOpen(gitpath)
+// openGit opens or clones a repository. It returns the repository, its normalized ID
+// and original URLs of the repository.
+func openGit(gitpath, cacheDir string) (*git.Repository, quad.IRI, []string, error) {
+	var (
+		repo *git.Repository
+		err  error
+	)
+	if isRemote(gitpath) {
+		repo, err = cloneGit(gitpath, cacheDir)
+	} else {
+		// We instanciate a new repository targeting the given path (the .git folder)
+		repo, err = git.PlainOpen(gitpath)
+	}
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
-	if origin, err := repo.Remote("origin"); err == nil {
-		if origin != nil && len(origin.Config().URLs) > 0 {
-			repoIRI = quad.IRI(origin.Config().URLs[0])
+
+	id := gitpath
+	var urls []string
+	if origin, err := repo.Remote("origin"); err == nil && origin != nil {
+		urls = origin.Config().URLs
+		if len(urls) > 0 {
+			id = urls[0]
 		}
 	}
+	if isRemote(gitpath) && !containsString(urls, gitpath) {
+		urls = append(urls, gitpath)
+	}
+	return repo, quad.IRI(NormalizeURL(id)), urls, nil
+}
 
-	return repo, repoIRI, nil
+func containsString(arr []string, s string) bool {
+	for _, v := range arr {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
 
 func gitHashToIRI(h plumbing.Hash) quad.IRI {
 	return quad.IRI("sha1:" + h.String())
 }
 
-func (imp *repoExporter) importCommit(commit *object.Commit) error {
+// commitChanges returns changes introduced by the commit compared to its first parent.
+func commitChanges(commit *object.Commit) (object.Changes, error) {
+	var (
+		from *object.Tree
+		to   *object.Tree
+	)
+	if commit.NumParents() > 0 {
+		p, err := commit.Parent(0)
+		if err != nil {
+			return nil, err
+		}
+		if from, err = p.Tree(); err != nil {
+			return nil, err
+		}
+	}
+	to, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+	return object.DiffTree(from, to)
+}
+
+func (imp *repoExporter) importCommit(commit *object.Commit, changes object.Changes) error {
 	commitIRI := gitHashToIRI(commit.Hash)
 
 	if err := imp.e.WriteQuads([]quad.Quad{
@@ -241,6 +412,26 @@ func (imp *repoExporter) importCommit(commit *object.Commit) error {
 			Predicate: PredMessage,
 			Object:    quad.String(commit.Message),
 		},
+		{
+			Subject:   commitIRI,
+			Predicate: PredAuthoredAt,
+			Object:    quad.Time(commit.Author.When),
+		},
+		{
+			Subject:   commitIRI,
+			Predicate: PredAuthoredTZ,
+			Object:    quad.String(commit.Author.When.Format("-0700")),
+		},
+		{
+			Subject:   commitIRI,
+			Predicate: PredCommittedAt,
+			Object:    quad.Time(commit.Committer.When),
+		},
+		{
+			Subject:   commitIRI,
+			Predicate: PredCommittedTZ,
+			Object:    quad.String(commit.Committer.When.Format("-0700")),
+		},
 	}...); err != nil {
 		return err
 	}
@@ -282,26 +473,6 @@ func (imp *repoExporter) importCommit(commit *object.Commit) error {
 	}
 
 	// dump changes
-	var (
-		from *object.Tree
-		to   *object.Tree
-	)
-	if commit.NumParents() > 0 {
-		p, err := commit.Parent(0)
-		if err != nil {
-			return err
-		}
-		if from, err = p.Tree(); err != nil {
-			return err
-		}
-	}
-	if to, err = commit.Tree(); err != nil {
-		return err
-	}
-	changes, err := object.DiffTree(from, to)
-	if err != nil {
-		return err
-	}
 	for _, ch := range changes {
 		if err = imp.importChange(commitIRI, ch); err != nil {
 			return err
@@ -369,6 +540,11 @@ func (imp *repoExporter) importFile(commitIRI quad.IRI, file *object.File) error
 			Predicate: PredFilename,
 			Object:    quad.String(file.Name),
 		},
+		{
+			Subject:   fileIRI,
+			Predicate: PredSize,
+			Object:    quad.Int(file.Size),
+		},
 	}...); err != nil {
 		return err
 	}
@@ -0,0 +1,219 @@
+package git
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+type quadCollector []quad.Quad
+
+func (c *quadCollector) WriteQuad(q quad.Quad) error {
+	*c = append(*c, q)
+	return nil
+}
+
+// newTestRepo creates a repository with commits at given times on master.
+// For each name in branches, a branch points to the commit with the same index.
+func newTestRepo(t *testing.T, times []time.Time, branches []string) (string, []plumbing.Hash) {
+	dir, err := ioutil.TempDir("", "codegraph-git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hashes []plumbing.Hash
+	for i, when := range times {
+		name := filepath.Join(dir, "file.txt")
+		if err := ioutil.WriteFile(name, []byte(when.String()), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add("file.txt"); err != nil {
+			t.Fatal(err)
+		}
+		sig := &object.Signature{Name: "Alice", Email: "alice@x.org", When: when}
+		h, err := wt.Commit(when.Format("2006-01-02"), &git.CommitOptions{Author: sig, Committer: sig})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, h)
+		if i < len(branches) && branches[i] != "" {
+			ref := plumbing.NewHashReference(plumbing.NewBranchReferenceName(branches[i]), h)
+			if err := repo.Storer.SetReference(ref); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return dir, hashes
+}
+
+func TestExportFilterRefs(t *testing.T) {
+	times := []time.Time{
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	dir, hashes := newTestRepo(t, times, []string{"old"})
+	defer os.RemoveAll(dir)
+
+	for _, c := range []struct {
+		name     string
+		filter   Filter
+		commits  []quad.Value
+		branches []string
+	}{
+		{
+			name:     "all",
+			commits:  []quad.Value{gitHashToIRI(hashes[0]), gitHashToIRI(hashes[1])},
+			branches: []string{"master", "old"},
+		},
+		{
+			// the tip of master is filtered out
+			name:     "until",
+			filter:   Filter{Until: time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)},
+			commits:  []quad.Value{gitHashToIRI(hashes[0])},
+			branches: []string{"old"},
+		},
+	} {
+		var quads quadCollector
+		e, err := NewQuadExporter(&quads)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.Filter = c.filter
+		if err := e.ExportPath(dir); err != nil {
+			t.Fatal(err)
+		}
+
+		var (
+			commits  []quad.Value
+			branches []string
+			names    = make(map[quad.Value]string)
+			targets  = make(map[quad.Value]quad.Value)
+			isBranch = make(map[quad.Value]bool)
+		)
+		for _, q := range quads {
+			switch q.Predicate {
+			case PredType:
+				if q.Object == TypeCommit {
+					commits = append(commits, q.Subject)
+				}
+				isBranch[q.Subject] = q.Object == TypeBranch
+			case PredName:
+				if s, ok := q.Object.(quad.String); ok {
+					names[q.Subject] = string(s)
+				}
+			case PredCommit:
+				targets[q.Subject] = q.Object
+			}
+		}
+		for b, ok := range isBranch {
+			if ok {
+				branches = append(branches, names[b])
+			}
+		}
+		sort.Strings(branches)
+		sortValues(commits)
+		sortValues(c.commits)
+
+		if !reflect.DeepEqual(commits, c.commits) {
+			t.Errorf("%s: commits: %v, expected: %v", c.name, commits, c.commits)
+		}
+		if !reflect.DeepEqual(branches, c.branches) {
+			t.Errorf("%s: branches: %v, expected: %v", c.name, branches, c.branches)
+		}
+		// every ref (and the repository) points to exported commits
+		for _, target := range targets {
+			if !containsValue(commits, target) {
+				t.Errorf("%s: dangling ref to %v", c.name, target)
+			}
+		}
+	}
+}
+
+func sortValues(vals []quad.Value) {
+	sort.Slice(vals, func(i, j int) bool { return vals[i].String() < vals[j].String() })
+}
+
+func containsValue(vals []quad.Value, v quad.Value) bool {
+	for _, v2 := range vals {
+		if v2 == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestExportTags(t *testing.T) {
+	times := []time.Time{
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	dir, hashes := newTestRepo(t, times, nil)
+	defer os.RemoveAll(dir)
+
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTag("v1", hashes[0], nil); err != nil {
+		t.Fatal(err)
+	}
+	sig := &object.Signature{Name: "Alice", Email: "alice@x.org", When: times[1]}
+	if _, err := repo.CreateTag("v2", hashes[1], &git.CreateTagOptions{Tagger: sig, Message: "v2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var quads quadCollector
+	e, err := NewQuadExporter(&quads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.ExportPath(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		tags    = make(map[quad.Value]bool)
+		names   = make(map[quad.Value]string)
+		commits = make(map[quad.Value]quad.Value)
+	)
+	for _, q := range quads {
+		switch q.Predicate {
+		case PredTag:
+			tags[q.Object] = true
+		case PredName:
+			if s, ok := q.Object.(quad.String); ok {
+				names[q.Subject] = string(s)
+			}
+		case PredCommit:
+			commits[q.Subject] = q.Object
+		}
+	}
+	res := make(map[string]quad.Value)
+	for tag := range tags {
+		res[names[tag]] = commits[tag]
+	}
+	// the annotated tag is peeled to its commit
+	exp := map[string]quad.Value{
+		"v1": gitHashToIRI(hashes[0]),
+		"v2": gitHashToIRI(hashes[1]),
+	}
+	if !reflect.DeepEqual(res, exp) {
+		t.Errorf("tags: %v, expected: %v", res, exp)
+	}
+}
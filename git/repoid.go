@@ -0,0 +1,74 @@
+package git
+
+import (
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// defaultPorts are omitted from normalized URLs.
+var defaultPorts = map[string]string{
+	"http":    "80",
+	"https":   "443",
+	"ssh":     "22",
+	"git+ssh": "22",
+	"git":     "9418",
+}
+
+// NormalizeURL returns a canonical identifier of a repository given by URL or a local path,
+// so that different ways of addressing the same repository result in the same ID.
+//
+// Remote URLs (including scp-like "user@host:path") are converted to "host/path" form
+// without scheme, user info and default port; host is lower-cased and ".git" suffix is removed.
+// Local paths are converted to absolute "file://" URLs.
+func NormalizeURL(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return s
+	}
+	if strings.Contains(s, "://") {
+		u, err := url.Parse(s)
+		if err != nil {
+			return s
+		}
+		scheme := strings.ToLower(u.Scheme)
+		if scheme == "file" {
+			return "file://" + normalizePath(u.Path)
+		}
+		host := strings.ToLower(u.Hostname())
+		if port := u.Port(); port != "" && port != defaultPorts[scheme] {
+			host += ":" + port
+		}
+		return host + normalizeRepoPath(u.Path)
+	}
+	if isRemote(s) {
+		// scp-like syntax: [user@]host:path
+		i := strings.Index(s, ":")
+		host := s[:i]
+		if j := strings.LastIndex(host, "@"); j >= 0 {
+			host = host[j+1:]
+		}
+		return strings.ToLower(host) + normalizeRepoPath("/"+s[i+1:])
+	}
+	return "file://" + normalizePath(s)
+}
+
+// normalizeRepoPath cleans a path component of a remote URL.
+func normalizeRepoPath(p string) string {
+	p = path.Clean("/" + p)
+	p = strings.TrimSuffix(p, ".git")
+	return strings.TrimRight(p, "/")
+}
+
+// normalizePath converts a local path to an absolute path to the repository root.
+func normalizePath(p string) string {
+	if abs, err := filepath.Abs(p); err == nil {
+		p = abs
+	}
+	p = filepath.ToSlash(filepath.Clean(p))
+	if strings.HasSuffix(p, "/.git") {
+		p = strings.TrimSuffix(p, "/.git")
+	}
+	return p
+}
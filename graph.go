@@ -2,31 +2,131 @@ package codegraph
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/cayleygraph/cayley"
 	"github.com/cayleygraph/cayley/graph"
 	_ "github.com/cayleygraph/cayley/graph/kv/bolt"
+	_ "github.com/cayleygraph/cayley/graph/kv/leveldb"
+	"github.com/cayleygraph/cayley/graph/memstore"
+	_ "github.com/cayleygraph/cayley/graph/sql/cockroach"
+	_ "github.com/cayleygraph/cayley/graph/sql/mysql"
+	_ "github.com/cayleygraph/cayley/graph/sql/postgres"
+	"github.com/cayleygraph/cayley/quad"
 	"github.com/cayleygraph/cayley/quad/nquads"
 )
 
 const (
 	defaultKV = "bolt"
+
+	// BackendMemory is an in-memory backend; the data is lost after closing the graph.
+	BackendMemory = "memory"
 )
 
+// Backends lists supported storage backends: the in-memory one, key-value stores (bolt and leveldb)
+// and SQL databases (postgres, mysql and cockroach).
+// There is no SQLite backend, because Cayley v0.7.5 does not implement it.
+var Backends = []string{BackendMemory, "bolt", "leveldb", "postgres", "mysql", "cockroach"}
+
+type settingType int
+
+const (
+	settingBool settingType = iota
+	settingInt
+)
+
+var (
+	kvSettings = map[string]settingType{
+		"nosync":  settingBool,
+		"upfront": settingBool,
+	}
+	sqlSettings = map[string]settingType{
+		"db_fill_factor": settingInt,
+		"local_optimize": settingBool,
+		"use_estimates":  settingBool,
+	}
+	// backendSettings lists types of non-string settings known to each backend.
+	backendSettings = map[string]map[string]settingType{
+		"bolt":      kvSettings,
+		"leveldb":   kvSettings,
+		"postgres":  sqlSettings,
+		"mysql":     sqlSettings,
+		"cockroach": sqlSettings,
+	}
+)
+
+// ParseSettings converts backend settings given as strings (e.g. on the command line) to Options.Settings,
+// using the type each backend expects for a setting: "nosync=1" is a bool for bolt, "db_fill_factor=1" is an int for SQL backends.
+// Unknown settings are kept as strings.
+func ParseSettings(backend string, settings map[string]string) (map[string]interface{}, error) {
+	if backend == "" {
+		backend = defaultKV
+	}
+	known := backendSettings[backend]
+	out := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		typ, ok := known[k]
+		if !ok {
+			out[k] = v
+			continue
+		}
+		switch typ {
+		case settingBool:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s setting %q: expected a bool", k, v)
+			}
+			out[k] = b
+		case settingInt:
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s setting %q: expected an integer", k, v)
+			}
+			out[k] = i
+		}
+	}
+	return out, nil
+}
+
+// Options for opening a graph database.
+type Options struct {
+	// Backend is a storage backend, one of Backends; defaults to "bolt".
+	Backend string
+	// Settings are backend-specific settings, e.g. "nosync" for bolt.
+	Settings map[string]interface{}
+}
+
 // Graph is a opaque type for cayley graph database handler.
 type Graph struct {
 	store *cayley.Handle
 }
 
-// Open opens git graph database
-func Open(dbpath string) (*Graph, error) {
-	err := graph.InitQuadStore(defaultKV, dbpath, nil)
-	if err != nil && err != graph.ErrDatabaseExists {
+// Open opens git graph database.
+// Path is a database directory for key-value backends or a connection string for SQL backends,
+// and is ignored for the in-memory backend.
+func Open(dbpath string, opts *Options) (*Graph, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	backend := opts.Backend
+	switch backend {
+	case "":
+		backend = defaultKV
+	case BackendMemory:
+		backend, dbpath = memstore.QuadStoreType, ""
+	}
+	if !graph.IsRegistered(backend) {
+		return nil, fmt.Errorf("unsupported backend: %q", opts.Backend)
+	}
+
+	err := graph.InitQuadStore(backend, dbpath, opts.Settings)
+	if err != nil && err != graph.ErrDatabaseExists && err != graph.ErrOperationNotSupported {
 		return nil, err
 	}
 
-	store, err := cayley.NewGraph(defaultKV, dbpath, nil)
+	store, err := cayley.NewGraph(backend, dbpath, opts.Settings)
 	if err != nil {
 		return nil, err
 	}
@@ -51,11 +151,33 @@ func (g *Graph) Import(_ context.Context, gitpath string, opts *ExportOptions) e
 	return err
 }
 
+// Load reads quads in N-Quads format and writes them to the graph database in batches.
+// Returns number of loaded quads
+func (g *Graph) Load(_ context.Context, r io.Reader) (int, error) {
+	qr := nquads.NewReader(r, false)
+	defer qr.Close()
+
+	w := graph.NewWriter(g.store)
+	n, err := quad.CopyBatch(w, qr, quad.DefaultBatch)
+	if err != nil {
+		return n, err
+	}
+	return n, w.Close()
+}
+
 // Export exports quads in raw format
 // Returns number of exported quads
 func (g *Graph) Export(ctx context.Context, w io.Writer) (int, error) {
-	qw := nquads.NewWriter(w)
-	defer qw.Close()
+	return g.ExportFormat(ctx, w, FormatNQuads)
+}
+
+// ExportFormat exports quads in a given format (see ExportFormats)
+// Returns number of exported quads
+func (g *Graph) ExportFormat(ctx context.Context, w io.Writer, format string) (int, error) {
+	qw, err := NewFormatWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
 
 	it, _ := g.store.QuadsAllIterator().Optimize()
 	defer it.Close()
@@ -64,11 +186,12 @@ func (g *Graph) Export(ctx context.Context, w io.Writer) (int, error) {
 	for it.Next(ctx) {
 		q := g.store.Quad(it.Result())
 		if err := qw.WriteQuad(q); err != nil {
+			_ = qw.Close()
 			return 0, err
 		}
 		n++
 	}
-	return n, nil
+	return n, qw.Close()
 }
 
 // Close implements io.Closer
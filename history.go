@@ -0,0 +1,183 @@
+package codegraph
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+// change is a change of a file made by a commit
+type change struct {
+	Kind quad.IRI   // git:add, git:remove or git:modify
+	File quad.Value // file (blob) node; for removed files it is the last version of the file
+	Path string
+}
+
+// newChange returns a change for a quad linking a file to a commit.
+func newChange(q quad.Quad) change {
+	ch := change{File: q.Subject}
+	ch.Kind, _ = q.Predicate.(quad.IRI)
+	if path, ok := q.Label.(quad.String); ok {
+		ch.Path = string(path)
+	}
+	return ch
+}
+
+// history is an index of all commits in the graph, built with a few bulk scans over predicates.
+type history struct {
+	stats      map[quad.Value]*CommitStats // commit -> statistics
+	repos      map[quad.Value][]quad.Value // commit -> repositories
+	authors    map[quad.Value]quad.Value   // commit -> author
+	committers map[quad.Value]quad.Value   // commit -> committer
+	changes    map[quad.Value][]change     // commit -> changed files
+}
+
+// loadHistory builds an index of commits of opts.Repo, visiting them one by one,
+// or an index of all commits in the graph with bulk scans if opts.Repo is not set.
+func (g *Graph) loadHistory(ctx context.Context, opts *StatsOptions) (*history, error) {
+	repo, err := g.repoFilter(ctx, opts)
+	if err != nil {
+		return nil, err
+	} else if repo != nil {
+		return g.loadRepoHistory(ctx, repo), nil
+	}
+	return g.loadAllHistory(ctx)
+}
+
+func newHistory() *history {
+	return &history{
+		stats:      make(map[quad.Value]*CommitStats),
+		repos:      make(map[quad.Value][]quad.Value),
+		authors:    make(map[quad.Value]quad.Value),
+		committers: make(map[quad.Value]quad.Value),
+		changes:    make(map[quad.Value][]change),
+	}
+}
+
+// loadRepoHistory builds an index of commits of a single repository from quads linked to each commit.
+func (g *Graph) loadRepoHistory(ctx context.Context, repo quad.Value) *history {
+	h := newHistory()
+	for _, c := range outValues(ctx, g.store, repo, git.PredCommit) {
+		out := linkedQuads(ctx, g.store, quad.Subject, c, "")
+		in := linkedQuads(ctx, g.store, quad.Object, c, "")
+		h.stats[c] = commitStatsFromQuads(c, out, in)
+		h.repos[c] = []quad.Value{repo}
+		for _, q := range out {
+			switch q.Predicate {
+			case git.PredAuthor:
+				h.authors[c] = q.Object
+			case git.PredCommiter:
+				h.committers[c] = q.Object
+			}
+		}
+		for _, q := range in {
+			switch q.Predicate {
+			case git.PredAdd, git.PredRemove, git.PredModify:
+				h.changes[c] = append(h.changes[c], newChange(q))
+			}
+		}
+	}
+	return h
+}
+
+// loadAllHistory builds an index of all commits in the graph.
+func (g *Graph) loadAllHistory(ctx context.Context) (*history, error) {
+	repos, err := g.repos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isRepo := make(map[quad.Value]struct{}, len(repos))
+	for _, r := range repos {
+		isRepo[r] = struct{}{}
+	}
+
+	h := newHistory()
+	if h.stats, err = commitStatsIndex(ctx, g.store); err != nil {
+		return nil, err
+	}
+
+	scans := []predicateScan{
+		// branches are linked to commits with the same predicate
+		{git.PredCommit, func(q quad.Quad) {
+			if _, ok := isRepo[q.Subject]; ok {
+				h.repos[q.Object] = append(h.repos[q.Object], q.Subject)
+			}
+		}},
+		{git.PredAuthor, func(q quad.Quad) { h.authors[q.Subject] = q.Object }},
+		{git.PredCommiter, func(q quad.Quad) { h.committers[q.Subject] = q.Object }},
+	}
+	for _, kind := range []quad.IRI{git.PredAdd, git.PredRemove, git.PredModify} {
+		scans = append(scans, predicateScan{kind, func(q quad.Quad) {
+			h.changes[q.Object] = append(h.changes[q.Object], newChange(q))
+		}})
+	}
+	if err := scanPredicates(ctx, g.store, scans); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// commits returns commits of a repository (or of all repositories if repo is nil) which match the options
+// (merges and time range; the limit is ignored), oldest first.
+func (h *history) commits(repo quad.Value, opts *StatsOptions) []quad.Value {
+	var out []quad.Value
+	for c, repos := range h.repos {
+		if repo != nil && !containsValue(repos, repo) {
+			continue
+		}
+		cs := h.commitStats(c)
+		if opts.NoMerge && cs.NumParents > 1 {
+			continue
+		}
+		if !opts.inRange(cs.CommittedAt) {
+			continue
+		}
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		t1, t2 := h.commitStats(out[i]).CommittedAt, h.commitStats(out[j]).CommittedAt
+		if !t1.Equal(t2) {
+			return t1.Before(t2)
+		}
+		return iriString(out[i]) < iriString(out[j])
+	})
+	return out
+}
+
+// commitStats returns statistics of a commit. The result must not be modified.
+func (h *history) commitStats(c quad.Value) *CommitStats {
+	if cs, ok := h.stats[c]; ok {
+		return cs
+	}
+	return &CommitStats{Hash: commitHash(c)}
+}
+
+// repoFilter resolves opts.Repo to a repository node, or returns nil if it is not set.
+func (g *Graph) repoFilter(ctx context.Context, opts *StatsOptions) (quad.Value, error) {
+	if opts.Repo == "" {
+		return nil, nil
+	}
+	return g.findRepo(ctx, opts.Repo)
+}
+
+// selectRepos returns opts.Repo, or all repositories in the graph if it is not set.
+func (g *Graph) selectRepos(ctx context.Context, opts *StatsOptions) ([]quad.Value, error) {
+	repo, err := g.repoFilter(ctx, opts)
+	if err != nil {
+		return nil, err
+	} else if repo != nil {
+		return []quad.Value{repo}, nil
+	}
+	return g.repos(ctx)
+}
+
+func containsValue(vals []quad.Value, v quad.Value) bool {
+	for _, v2 := range vals {
+		if v2 == v {
+			return true
+		}
+	}
+	return false
+}
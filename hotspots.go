@@ -0,0 +1,187 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+type (
+	// Hotspot contains change statistics of a path in a repository.
+	// Line counts are not stored in the graph, so churn is measured in file changes.
+	Hotspot struct {
+		Repo string `json:"repo"` // repository ID
+		Path string `json:"path"`
+
+		NumCommits  int `json:"commits"`  // number of commits changing the path
+		NumAuthors  int `json:"authors"`  // number of distinct authors of these commits
+		NumAdded    int `json:"added"`    // number of times the path was added
+		NumRemoved  int `json:"removed"`  // number of times the path was removed
+		NumModified int `json:"modified"` // number of times the path was modified
+
+		FirstChange time.Time `json:"first_change"` // commit time of the first change
+		LastChange  time.Time `json:"last_change"`  // commit time of the last change
+	}
+
+	// HotspotSortBy is a function to sort hotspots
+	HotspotSortBy func(h1, h2 *Hotspot) bool
+)
+
+// Touched returns the number of changes of the path.
+func (hs *Hotspot) Touched() int {
+	return hs.NumAdded + hs.NumRemoved + hs.NumModified
+}
+
+// HotspotSortByName returns a function to sort hotspots by a given field: commit, author or touch.
+func HotspotSortByName(name string) (HotspotSortBy, error) {
+	switch strings.ToLower(name) {
+	case "commit":
+		return func(h1, h2 *Hotspot) bool {
+			return h1.NumCommits > h2.NumCommits
+		}, nil
+
+	case "author":
+		return func(h1, h2 *Hotspot) bool {
+			return h1.NumAuthors > h2.NumAuthors
+		}, nil
+
+	case "touch":
+		return func(h1, h2 *Hotspot) bool {
+			return h1.Touched() > h2.Touched()
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid sort: %q", name)
+}
+
+// Hotspots returns change statistics of paths in all repositories (or in opts.Repo), grouped by repository.
+// Merge commits and commits outside of the time window are skipped according to the options,
+// and opts.Limit limits the number of paths per repository.
+func (g *Graph) Hotspots(ctx context.Context, by HotspotSortBy, opts *StatsOptions) ([]*Hotspot, error) {
+	if opts == nil {
+		opts = &StatsOptions{}
+	}
+	repos, err := g.selectRepos(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*Hotspot, 0)
+	for _, repo := range repos {
+		out = append(out, h.hotspots(repo, by, opts)...)
+	}
+	return out, nil
+}
+
+// hotspots returns change statistics of paths in a repository.
+func (h *history) hotspots(repo quad.Value, by HotspotSortBy, opts *StatsOptions) []*Hotspot {
+	type pathInfo struct {
+		*Hotspot
+		authors map[quad.Value]struct{}
+	}
+	paths := make(map[string]*pathInfo)
+	for _, c := range h.commits(repo, opts) {
+		t := h.commitStats(c).CommittedAt
+		seen := make(map[string]struct{})
+		for _, ch := range h.changes[c] {
+			pi, ok := paths[ch.Path]
+			if !ok {
+				pi = &pathInfo{
+					Hotspot: &Hotspot{Repo: iriString(repo), Path: ch.Path},
+					authors: make(map[quad.Value]struct{}),
+				}
+				paths[ch.Path] = pi
+			}
+			switch ch.Kind {
+			case git.PredAdd:
+				pi.NumAdded++
+			case git.PredRemove:
+				pi.NumRemoved++
+			case git.PredModify:
+				pi.NumModified++
+			}
+			if _, ok := seen[ch.Path]; ok {
+				continue
+			}
+			seen[ch.Path] = struct{}{}
+			pi.NumCommits++
+			if a, ok := h.authors[c]; ok {
+				pi.authors[a] = struct{}{}
+			}
+			// commits are sorted by time
+			if pi.FirstChange.IsZero() {
+				pi.FirstChange = t
+			}
+			pi.LastChange = t
+		}
+	}
+
+	out := make([]*Hotspot, 0, len(paths))
+	for _, pi := range paths {
+		pi.NumAuthors = len(pi.authors)
+		out = append(out, pi.Hotspot)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Path < out[j].Path
+	})
+	if by != nil {
+		sort.SliceStable(out, func(i, j int) bool {
+			return by(out[i], out[j])
+		})
+	}
+	if opts.Limit > 0 && len(out) > opts.Limit {
+		out = out[:opts.Limit]
+	}
+	return out
+}
+
+// PrintHotspots writes hotspots to w in a given format: text (default), json or csv.
+func (g *Graph) PrintHotspots(ctx context.Context, w io.Writer, format string, by HotspotSortBy, opts *StatsOptions) error {
+	hotspots, err := g.Hotspots(ctx, by, opts)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"repo", "path", "commits", "authors", "touched", "added", "removed", "modified", "first_change", "last_change"}
+	return writeReport(w, format, hotspots, header, func() [][]string {
+		return hotspotRows(hotspots)
+	}, func(w io.Writer) {
+		printHotspots(w, hotspots)
+	})
+}
+
+func printHotspots(w io.Writer, hotspots []*Hotspot) {
+	repo := ""
+	for _, hs := range hotspots {
+		if hs.Repo != repo {
+			repo = hs.Repo
+			fmt.Fprintf(w, "\n%s\n", quad.IRI(repo).String())
+		}
+		fmt.Fprintf(w, "--\npath: %s\n", hs.Path)
+		fmt.Fprintf(w, "%d commits, %d authors, changed %s - %s\n", hs.NumCommits, hs.NumAuthors, formatDate(hs.FirstChange), formatDate(hs.LastChange))
+		fmt.Fprintf(w, "%d touched (+, -, #), %d added(+), %d removed(-), %d modified(#)\n", hs.Touched(), hs.NumAdded, hs.NumRemoved, hs.NumModified)
+	}
+}
+
+func hotspotRows(hotspots []*Hotspot) [][]string {
+	rows := make([][]string, 0, len(hotspots))
+	for _, hs := range hotspots {
+		rows = append(rows, []string{
+			hs.Repo, hs.Path,
+			strconv.Itoa(hs.NumCommits), strconv.Itoa(hs.NumAuthors),
+			strconv.Itoa(hs.Touched()), strconv.Itoa(hs.NumAdded), strconv.Itoa(hs.NumRemoved), strconv.Itoa(hs.NumModified),
+			formatTime(hs.FirstChange), formatTime(hs.LastChange),
+		})
+	}
+	return rows
+}
@@ -0,0 +1,239 @@
+package codegraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cayleygraph/cayley/query"
+	cayleyhttp "github.com/cayleygraph/cayley/server/http"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mloncode/codegraph/ui"
+)
+
+// APIPrefix is a path prefix of codegraph REST endpoints.
+const APIPrefix = "/api/codegraph/"
+
+// NewHandler returns an HTTP handler with codegraph REST endpoints:
+//
+//	GET /api/codegraph/repos                 - list of repositories (see Repos)
+//	GET /api/codegraph/commits?repo=<repo>   - commits of a repository (see Commits)
+//	GET /api/codegraph/stats[?repo=<repo>]   - commit statistics; accepts sort, limit, nomerge, since and until
+//	                                           parameters, the same as "codegraph git stats" flags
+//
+// Responses are JSON; errors are returned as {"error": "..."}.
+func NewHandler(g *Graph) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(APIPrefix+"repos", g.serveRepos)
+	mux.HandleFunc(APIPrefix+"commits", g.serveCommits)
+	mux.HandleFunc(APIPrefix+"stats", g.serveStats)
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+func (g *Graph) serveRepos(w http.ResponseWriter, r *http.Request) {
+	repos, err := g.Repos(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, repos)
+}
+
+func (g *Graph) serveCommits(w http.ResponseWriter, r *http.Request) {
+	repo := r.FormValue("repo")
+	if repo == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("missing repo parameter"))
+		return
+	}
+	commits, err := g.Commits(r.Context(), repo)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, commits)
+}
+
+func (g *Graph) serveStats(w http.ResponseWriter, r *http.Request) {
+	by, opts, err := statsParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	opts.Repo = r.FormValue("repo")
+	out, err := g.statsByRepo(r.Context(), by, opts)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// statsParams parses parameters of the stats endpoint.
+func statsParams(r *http.Request) (SortBy, *StatsOptions, error) {
+	sort := r.FormValue("sort")
+	if sort == "" {
+		sort = "touch"
+	}
+	by, err := SortByName(sort)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := &StatsOptions{}
+	if s := r.FormValue("limit"); s != "" {
+		if opts.Limit, err = strconv.Atoi(s); err != nil {
+			return nil, nil, fmt.Errorf("invalid limit: %q", s)
+		}
+	}
+	if s := r.FormValue("nomerge"); s != "" {
+		if opts.NoMerge, err = strconv.ParseBool(s); err != nil {
+			return nil, nil, fmt.Errorf("invalid nomerge: %q", s)
+		}
+	}
+	if opts.Since, err = ParseTime(r.FormValue("since")); err != nil {
+		return nil, nil, err
+	}
+	if opts.Until, err = ParseUntil(r.FormValue("until")); err != nil {
+		return nil, nil, err
+	}
+	return by, opts, nil
+}
+
+// ServerOptions configures an HTTP server.
+type ServerOptions struct {
+	Write   bool          // enable write endpoints of Cayley's API; the server is read-only by default
+	Timeout time.Duration // query timeout (0 means no timeout)
+	Limit   int           // default limit of query results (0 means no limit)
+	Assets  string        // directory with Cayley's UI (templates and static files) to use instead of the built-in one
+}
+
+// NewServer returns an HTTP handler serving Cayley's query API (v1 query and shape endpoints used by Cayley's UI, and v2),
+// Cayley's UI (built in, or from opts.Assets), and codegraph REST endpoints (see NewHandler).
+func NewServer(g *Graph, opts *ServerOptions) (http.Handler, error) {
+	if opts == nil {
+		opts = &ServerOptions{}
+	}
+	r := httprouter.New()
+
+	api := cayleyhttp.NewAPIv2(g.store)
+	api.SetReadOnly(!opts.Write)
+	api.SetQueryTimeout(opts.Timeout)
+	api.SetQueryLimit(opts.Limit)
+	api.RegisterOn(r)
+
+	s := &server{g: g, opts: opts}
+	r.POST("/api/v1/query/:query_lang", s.serveV1Query)
+	r.POST("/api/v1/shape/:query_lang", s.serveV1Shape)
+
+	h := NewHandler(g)
+	r.Handler(http.MethodGet, APIPrefix+"*endpoint", h)
+
+	static := ui.Static()
+	if opts.Assets != "" {
+		tmpl, err := template.ParseGlob(filepath.Join(opts.Assets, "templates", "*.tmpl"))
+		if err != nil {
+			return nil, fmt.Errorf("cannot find Cayley's UI in %q: %v", opts.Assets, err)
+		}
+		if tmpl, err = tmpl.ParseGlob(filepath.Join(opts.Assets, "templates", "*.html")); err != nil {
+			return nil, err
+		}
+		s.ui = tmpl
+		static = http.Dir(filepath.Join(opts.Assets, "static"))
+	} else {
+		tmpl, err := ui.Templates()
+		if err != nil {
+			return nil, err
+		}
+		s.ui = tmpl
+	}
+	r.GET("/", s.serveUI)
+	r.GET("/ui/:ui_type", s.serveUI)
+	r.ServeFiles("/static/*filepath", static)
+	return r, nil
+}
+
+type server struct {
+	g    *Graph
+	opts *ServerOptions
+	ui   *template.Template
+}
+
+func (s *server) queryParams(r *http.Request) (context.Context, func(), string, error) {
+	ctx, cancel := r.Context(), func() {}
+	if s.opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, s.opts.Timeout)
+	}
+	q, err := ioutil.ReadAll(r.Body)
+	return ctx, cancel, string(q), err
+}
+
+func (s *server) serveV1Query(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	ctx, cancel, q, err := s.queryParams(r)
+	defer cancel()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit := s.opts.Limit
+	if l := r.FormValue("limit"); l != "" {
+		if limit, err = strconv.Atoi(l); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid limit: %q", l))
+			return
+		}
+	}
+	lang := params.ByName("query_lang")
+	res, err := s.g.Query(ctx, lang, q, limit)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	key := "result"
+	if l := query.GetLanguage(lang); l.HTTP == nil {
+		key = "data"
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{key: res})
+}
+
+func (s *server) serveV1Shape(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	ctx, cancel, q, err := s.queryParams(r)
+	defer cancel()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	shape, err := s.g.QueryShape(ctx, params.ByName("query_lang"), q)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, shape)
+}
+
+func (s *server) serveUI(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	uiType := params.ByName("ui_type")
+	if r.URL.Path == "/" {
+		uiType = "query"
+	}
+	if err := s.ui.ExecuteTemplate(w, uiType+".html", nil); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+	}
+}
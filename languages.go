@@ -0,0 +1,248 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+type (
+	// LanguageStats contains the number and the size of files in a programming language
+	LanguageStats struct {
+		Language string `json:"language"` // empty if the language was not detected
+		NumFiles int    `json:"files"`
+		NumBytes int64  `json:"bytes"` // only available for repositories imported with file sizes (git:size)
+	}
+
+	// LanguageActivity contains the number and the size of files in a programming language at the end of a time bucket
+	// and the number of files in this language changed during the bucket
+	LanguageActivity struct {
+		Repo     string    `json:"repo"`     // repository ID
+		Start    time.Time `json:"start"`    // start of the bucket (in UTC)
+		Language string    `json:"language"` // empty if the language was not detected
+
+		NumFiles int   `json:"files"` // files in the tree of the last commit of the bucket
+		NumBytes int64 `json:"bytes"` // only available for repositories imported with file sizes (git:size)
+
+		NumAdded    int `json:"added"`
+		NumRemoved  int `json:"removed"`
+		NumModified int `json:"modified"`
+	}
+)
+
+// Languages returns language composition of a repository (given by ID or URL) at a given ref (branch name, tag name or commit hash),
+// the largest languages first.
+func (g *Graph) Languages(ctx context.Context, repo, ref string) ([]*LanguageStats, error) {
+	files, err := g.FilesAt(ctx, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	langs := make(map[string]*LanguageStats)
+	for _, f := range files {
+		ls, ok := langs[f.Language]
+		if !ok {
+			ls = &LanguageStats{Language: f.Language}
+			langs[f.Language] = ls
+		}
+		ls.NumFiles++
+		ls.NumBytes += f.Size
+	}
+
+	out := make([]*LanguageStats, 0, len(langs))
+	for _, ls := range langs {
+		out = append(out, ls)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].NumBytes != out[j].NumBytes {
+			return out[i].NumBytes > out[j].NumBytes
+		}
+		if out[i].NumFiles != out[j].NumFiles {
+			return out[i].NumFiles > out[j].NumFiles
+		}
+		return out[i].Language < out[j].Language
+	})
+	return out, nil
+}
+
+// LanguageHistory returns the number and the size of files per language at the end of each time bucket (see Activity),
+// together with the number of files added, removed and modified during the bucket, in all repositories (or in opts.Repo),
+// sorted by time and language. Files at the end of a bucket are taken from the tree of its last commit.
+// Buckets without commits are skipped, since the composition does not change in them.
+func (g *Graph) LanguageHistory(ctx context.Context, bucket string, opts *StatsOptions) ([]*LanguageActivity, error) {
+	switch bucket {
+	case "":
+		bucket = BucketMonth
+	case BucketDay, BucketWeek, BucketMonth:
+	default:
+		return nil, fmt.Errorf("invalid bucket: %q", bucket)
+	}
+	if opts == nil {
+		opts = &StatsOptions{}
+	}
+	repos, err := g.selectRepos(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		langs = make(map[quad.Value]string)
+		sizes = make(map[quad.Value]int64)
+	)
+	err = scanPredicates(ctx, g.store, []predicateScan{
+		{pred: predEnryLang, fnc: func(q quad.Quad) {
+			if lang, ok := q.Object.(quad.String); ok {
+				langs[q.Subject] = string(lang)
+			}
+		}},
+		{pred: git.PredSize, fnc: func(q quad.Quad) {
+			if size, ok := q.Object.(quad.Int); ok {
+				sizes[q.Subject] = int64(size)
+			}
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*LanguageActivity, 0)
+	for _, repo := range repos {
+		type key struct {
+			start time.Time
+			lang  string
+		}
+		var (
+			res     []*LanguageActivity
+			buckets = make(map[key]*LanguageActivity)
+			starts  []time.Time
+			last    = make(map[time.Time]quad.Value) // the last commit of each bucket
+		)
+		get := func(start time.Time, lang string) *LanguageActivity {
+			k := key{start: start, lang: lang}
+			la, ok := buckets[k]
+			if !ok {
+				la = &LanguageActivity{Repo: iriString(repo), Start: start, Language: lang}
+				buckets[k] = la
+				res = append(res, la)
+			}
+			return la
+		}
+		// commits are sorted by time
+		for _, c := range h.commits(repo, opts) {
+			t := h.commitStats(c).CommittedAt
+			if t.IsZero() {
+				continue
+			}
+			start := bucketStart(t, bucket)
+			if _, ok := last[start]; !ok {
+				starts = append(starts, start)
+			}
+			last[start] = c
+			for _, ch := range h.changes[c] {
+				la := get(start, langs[ch.File])
+				switch ch.Kind {
+				case git.PredAdd:
+					la.NumAdded++
+				case git.PredRemove:
+					la.NumRemoved++
+				case git.PredModify:
+					la.NumModified++
+				}
+			}
+		}
+		for _, start := range starts {
+			for _, q := range linkedQuads(ctx, g.store, quad.Subject, last[start], git.PredFile) {
+				la := get(start, langs[q.Object])
+				la.NumFiles++
+				la.NumBytes += sizes[q.Object]
+			}
+		}
+		sort.SliceStable(res, func(i, j int) bool {
+			if !res[i].Start.Equal(res[j].Start) {
+				return res[i].Start.Before(res[j].Start)
+			}
+			return res[i].Language < res[j].Language
+		})
+		out = append(out, res...)
+	}
+	return out, nil
+}
+
+// PrintLanguages writes language composition of a repository at a given ref to w in a given format: text (default), json or csv.
+func (g *Graph) PrintLanguages(ctx context.Context, w io.Writer, format, repo, ref string) error {
+	res, err := g.Languages(ctx, repo, ref)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"language", "files", "bytes"}
+	return writeReport(w, format, res, header, func() [][]string {
+		rows := make([][]string, 0, len(res))
+		for _, ls := range res {
+			rows = append(rows, []string{ls.Language, strconv.Itoa(ls.NumFiles), strconv.FormatInt(ls.NumBytes, 10)})
+		}
+		return rows
+	}, func(w io.Writer) {
+		var files int
+		var size int64
+		for _, ls := range res {
+			files += ls.NumFiles
+			size += ls.NumBytes
+		}
+		for _, ls := range res {
+			share := 100 * float64(ls.NumFiles) / float64(files)
+			if size > 0 {
+				share = 100 * float64(ls.NumBytes) / float64(size)
+			}
+			fmt.Fprintf(w, "%-20s %5.1f%%  %d files, %d bytes\n", languageName(ls.Language), share, ls.NumFiles, ls.NumBytes)
+		}
+	})
+}
+
+// PrintLanguageHistory writes language composition and changes over history to w in a given format: text (default), json or csv.
+func (g *Graph) PrintLanguageHistory(ctx context.Context, w io.Writer, format, bucket string, opts *StatsOptions) error {
+	res, err := g.LanguageHistory(ctx, bucket, opts)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"repo", "start", "language", "files", "bytes", "added", "removed", "modified"}
+	return writeReport(w, format, res, header, func() [][]string {
+		rows := make([][]string, 0, len(res))
+		for _, la := range res {
+			rows = append(rows, []string{
+				la.Repo, formatDate(la.Start), la.Language,
+				strconv.Itoa(la.NumFiles), strconv.FormatInt(la.NumBytes, 10),
+				strconv.Itoa(la.NumAdded), strconv.Itoa(la.NumRemoved), strconv.Itoa(la.NumModified),
+			})
+		}
+		return rows
+	}, func(w io.Writer) {
+		repo := ""
+		for _, la := range res {
+			if la.Repo != repo {
+				repo = la.Repo
+				fmt.Fprintf(w, "\n%s\n--\n", quad.IRI(repo).String())
+			}
+			fmt.Fprintf(w, "%s: %-20s %d files, %d bytes; %d added(+), %d removed(-), %d modified(#)\n",
+				formatDate(la.Start), languageName(la.Language), la.NumFiles, la.NumBytes, la.NumAdded, la.NumRemoved, la.NumModified)
+		}
+	})
+}
+
+// languageName returns a language name for text output.
+func languageName(lang string) string {
+	if lang == "" {
+		return "(unknown)"
+	}
+	return lang
+}
@@ -0,0 +1,141 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+// linkedQuads returns quads with a given node in the specified direction, optionally filtered by predicate.
+func linkedQuads(ctx context.Context, qs graph.QuadStore, d quad.Direction, node quad.Value, pred quad.IRI) []quad.Quad {
+	ref := qs.ValueOf(node)
+	if ref == nil {
+		return nil
+	}
+	it := qs.QuadIterator(d, ref)
+	defer it.Close()
+
+	var out []quad.Quad
+	for it.Next(ctx) {
+		q := qs.Quad(it.Result())
+		if pred != "" && q.Predicate != pred {
+			continue
+		}
+		out = append(out, q)
+	}
+	return out
+}
+
+// outValues returns objects of quads with a given subject and predicate.
+func outValues(ctx context.Context, qs graph.QuadStore, node quad.Value, pred quad.IRI) []quad.Value {
+	var out []quad.Value
+	for _, q := range linkedQuads(ctx, qs, quad.Subject, node, pred) {
+		out = append(out, q.Object)
+	}
+	return out
+}
+
+// outValue returns the first object of quads with a given subject and predicate, or nil.
+func outValue(ctx context.Context, qs graph.QuadStore, node quad.Value, pred quad.IRI) quad.Value {
+	if vals := outValues(ctx, qs, node, pred); len(vals) > 0 {
+		return vals[0]
+	}
+	return nil
+}
+
+// outString returns the first string object of quads with a given subject and predicate.
+func outString(ctx context.Context, qs graph.QuadStore, node quad.Value, pred quad.IRI) string {
+	if s, ok := outValue(ctx, qs, node, pred).(quad.String); ok {
+		return string(s)
+	}
+	return ""
+}
+
+// outInt returns the first integer object of quads with a given subject and predicate.
+func outInt(ctx context.Context, qs graph.QuadStore, node quad.Value, pred quad.IRI) int {
+	if i, ok := outValue(ctx, qs, node, pred).(quad.Int); ok {
+		return int(i)
+	}
+	return 0
+}
+
+// resolveRef returns a commit for a branch name (e.g. "master" or "refs/heads/master"),
+// a tag name (e.g. "v1.0" or "refs/tags/v1.0"; annotated tags are peeled on import)
+// or a commit hash (full or abbreviated) in a given repository. Branches take precedence over tags with the same name.
+func (g *Graph) resolveRef(ctx context.Context, repo quad.IRI, ref string) (quad.IRI, error) {
+	for _, r := range []struct {
+		pred   quad.IRI
+		prefix string
+	}{
+		{pred: git.PredBranch, prefix: "refs/heads/"},
+		{pred: git.PredTag, prefix: "refs/tags/"},
+	} {
+		if strings.HasPrefix(ref, "refs/") && !strings.HasPrefix(ref, r.prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(ref, r.prefix)
+		for _, b := range outValues(ctx, g.store, repo, r.pred) {
+			if outString(ctx, g.store, b, git.PredName) == name {
+				if c, ok := outValue(ctx, g.store, b, git.PredCommit).(quad.IRI); ok {
+					return c, nil
+				}
+			}
+		}
+	}
+
+	hash := strings.TrimPrefix(ref, "sha1:")
+	if len(hash) < 4 {
+		return "", fmt.Errorf("unknown ref: %q", ref)
+	}
+	var found quad.IRI
+	for _, c := range outValues(ctx, g.store, repo, git.PredCommit) {
+		iri, ok := c.(quad.IRI)
+		if !ok || !strings.HasPrefix(string(iri), "sha1:"+hash) {
+			continue
+		}
+		if found != "" && found != iri {
+			return "", fmt.Errorf("ambiguous ref: %q", ref)
+		}
+		found = iri
+	}
+	if found == "" {
+		return "", fmt.Errorf("unknown ref: %q", ref)
+	}
+	return found, nil
+}
+
+// scanPredicate calls fnc for every quad with a given predicate.
+// It is much faster than following the predicate from each node separately when most of the nodes are needed.
+func scanPredicate(ctx context.Context, qs graph.QuadStore, pred quad.IRI, fnc func(q quad.Quad)) error {
+	ref := qs.ValueOf(pred)
+	if ref == nil {
+		return nil
+	}
+	it := qs.QuadIterator(quad.Predicate, ref)
+	defer it.Close()
+
+	for it.Next(ctx) {
+		fnc(qs.Quad(it.Result()))
+	}
+	return it.Err()
+}
+
+// predicateScan is a callback for quads with a given predicate (see scanPredicates).
+type predicateScan struct {
+	pred quad.IRI
+	fnc  func(q quad.Quad)
+}
+
+// scanPredicates runs scanPredicate for each of the scans.
+func scanPredicates(ctx context.Context, qs graph.QuadStore, scans []predicateScan) error {
+	for _, sc := range scans {
+		if err := scanPredicate(ctx, qs, sc.pred, sc.fnc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
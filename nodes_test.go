@@ -0,0 +1,78 @@
+package codegraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+func TestResolveRef(t *testing.T) {
+	g, err := Open("", &Options{Backend: BackendMemory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	ctx := context.Background()
+
+	var (
+		repo = quad.IRI("file:///repo")
+		c1   = quad.IRI("sha1:aaaa000000000000000000000000000000000000")
+		c2   = quad.IRI("sha1:bbbb000000000000000000000000000000000000")
+	)
+	quads := []quad.Quad{
+		{Subject: repo, Predicate: git.PredType, Object: git.TypeRepo},
+		{Subject: repo, Predicate: git.PredCommit, Object: c1},
+		{Subject: repo, Predicate: git.PredCommit, Object: c2},
+	}
+	for _, r := range []struct {
+		pred, typ  quad.IRI
+		name, full string
+		commit     quad.IRI
+	}{
+		{pred: git.PredBranch, typ: git.TypeBranch, name: "master", full: "refs/heads/master", commit: c2},
+		{pred: git.PredTag, typ: git.TypeTag, name: "v1", full: "refs/tags/v1", commit: c1},
+		// a tag with the name of a branch
+		{pred: git.PredTag, typ: git.TypeTag, name: "master", full: "refs/tags/master", commit: c1},
+	} {
+		node := repo + "/" + quad.IRI(r.full)
+		quads = append(quads,
+			quad.Quad{Subject: repo, Predicate: r.pred, Object: node},
+			quad.Quad{Subject: node, Predicate: git.PredType, Object: r.typ},
+			quad.Quad{Subject: node, Predicate: git.PredName, Object: quad.String(r.name)},
+			quad.Quad{Subject: node, Predicate: git.PredCommit, Object: r.commit},
+		)
+	}
+	if err := g.store.AddQuadSet(quads); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		ref string
+		exp quad.IRI
+	}{
+		{ref: "master", exp: c2},
+		{ref: "refs/heads/master", exp: c2},
+		{ref: "refs/tags/master", exp: c1},
+		{ref: "v1", exp: c1},
+		{ref: "refs/tags/v1", exp: c1},
+		{ref: "refs/heads/v1"},
+		{ref: "bbbb", exp: c2},
+		{ref: "sha1:aaaa", exp: c1},
+		{ref: "cccc"},
+	} {
+		res, err := g.resolveRef(ctx, repo, c.ref)
+		if c.exp == "" {
+			if err == nil {
+				t.Errorf("resolveRef(%q): expected an error, got %v", c.ref, res)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("resolveRef(%q): %v", c.ref, err)
+		} else if res != c.exp {
+			t.Errorf("resolveRef(%q): %v, expected: %v", c.ref, res, c.exp)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/cayleygraph/cayley/quad"
+)
+
+// DefaultOwnershipThreshold is the default share of changes which authors counted in the bus factor must cover.
+const DefaultOwnershipThreshold = 0.5
+
+type (
+	// Ownership describes which authors changed a file or a directory of a repository
+	Ownership struct {
+		Repo  string `json:"repo"` // repository ID
+		Path  string `json:"path"` // file or directory path; "." is the root directory
+		IsDir bool   `json:"dir,omitempty"`
+
+		NumChanges int      `json:"changes"`    // number of changes of the path (or of files in the directory)
+		BusFactor  int      `json:"bus_factor"` // minimal number of authors who made the threshold share of changes
+		Owners     []*Owner `json:"owners"`     // authors, the major owners first
+	}
+
+	// Owner is an author who changed a path
+	Owner struct {
+		Author     string  `json:"author"` // name <email>
+		NumChanges int     `json:"changes"`
+		Share      float64 `json:"share"` // share of changes of the path
+	}
+
+	// OwnershipOptions controls ownership reports
+	OwnershipOptions struct {
+		StatsOptions // commits to analyze; Limit is the number of paths per repository
+
+		Threshold float64 // share of changes for the bus factor; DefaultOwnershipThreshold if zero
+		Dirs      bool    // report directories instead of files
+	}
+)
+
+// Ownership returns ownership of files (or directories) in all repositories (or in opts.Repo), grouped by repository.
+// Every added, modified or removed file counts as a change made by the author of the commit;
+// changes of a directory are changes of all files in it, including subdirectories.
+// Paths of each repository are sorted by the bus factor, then by the number of changes, the most risky ones first.
+func (g *Graph) Ownership(ctx context.Context, opts *OwnershipOptions) ([]*Ownership, error) {
+	if opts == nil {
+		opts = &OwnershipOptions{}
+	}
+	threshold := opts.Threshold
+	if threshold == 0 {
+		threshold = DefaultOwnershipThreshold
+	} else if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("invalid threshold: %v", threshold)
+	}
+	repos, err := g.selectRepos(ctx, &opts.StatsOptions)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, &opts.StatsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[quad.Value]string)
+	authorName := func(a quad.Value) string {
+		name, ok := names[a]
+		if !ok {
+			name = g.authorString(ctx, a)
+			names[a] = name
+		}
+		return name
+	}
+
+	out := make([]*Ownership, 0)
+	for _, repo := range repos {
+		changes := make(map[string]map[quad.Value]int) // path -> author -> changes
+		count := func(p string, a quad.Value) {
+			m, ok := changes[p]
+			if !ok {
+				m = make(map[quad.Value]int)
+				changes[p] = m
+			}
+			m[a]++
+		}
+		for _, c := range h.commits(repo, &opts.StatsOptions) {
+			a, ok := h.authors[c]
+			if !ok {
+				continue
+			}
+			for _, ch := range h.changes[c] {
+				if !opts.Dirs {
+					count(ch.Path, a)
+					continue
+				}
+				for dir := path.Dir(ch.Path); ; dir = path.Dir(dir) {
+					count(dir, a)
+					if dir == "." || dir == "/" {
+						break
+					}
+				}
+			}
+		}
+
+		var res []*Ownership
+		for p, authors := range changes {
+			o := &Ownership{Repo: iriString(repo), Path: p, IsDir: opts.Dirs}
+			for a, n := range authors {
+				o.NumChanges += n
+				o.Owners = append(o.Owners, &Owner{Author: authorName(a), NumChanges: n})
+			}
+			sort.Slice(o.Owners, func(i, j int) bool {
+				if o.Owners[i].NumChanges != o.Owners[j].NumChanges {
+					return o.Owners[i].NumChanges > o.Owners[j].NumChanges
+				}
+				return o.Owners[i].Author < o.Owners[j].Author
+			})
+			covered := 0
+			for _, ow := range o.Owners {
+				ow.Share = float64(ow.NumChanges) / float64(o.NumChanges)
+				if float64(covered) < threshold*float64(o.NumChanges) {
+					covered += ow.NumChanges
+					o.BusFactor++
+				}
+			}
+			res = append(res, o)
+		}
+		sort.Slice(res, func(i, j int) bool {
+			if res[i].BusFactor != res[j].BusFactor {
+				return res[i].BusFactor < res[j].BusFactor
+			}
+			if res[i].NumChanges != res[j].NumChanges {
+				return res[i].NumChanges > res[j].NumChanges
+			}
+			return res[i].Path < res[j].Path
+		})
+		if opts.Limit > 0 && len(res) > opts.Limit {
+			res = res[:opts.Limit]
+		}
+		out = append(out, res...)
+	}
+	return out, nil
+}
+
+// PrintOwnership writes an ownership report to w in a given format: text (default), json or csv.
+// CSV output has a row per path and owner.
+func (g *Graph) PrintOwnership(ctx context.Context, w io.Writer, format string, opts *OwnershipOptions) error {
+	res, err := g.Ownership(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"repo", "path", "dir", "changes", "bus_factor", "author", "author_changes", "share"}
+	return writeReport(w, format, res, header, func() [][]string {
+		return ownershipRows(res)
+	}, func(w io.Writer) {
+		printOwnership(w, res)
+	})
+}
+
+func printOwnership(w io.Writer, res []*Ownership) {
+	repo := ""
+	for _, o := range res {
+		if o.Repo != repo {
+			repo = o.Repo
+			fmt.Fprintf(w, "\n%s\n", quad.IRI(repo).String())
+		}
+		kind := "file"
+		if o.IsDir {
+			kind = "dir"
+		}
+		fmt.Fprintf(w, "--\n%s: %s\n", kind, o.Path)
+		fmt.Fprintf(w, "%d changes, %d authors, bus factor %d\n", o.NumChanges, len(o.Owners), o.BusFactor)
+		// only print owners counted in the bus factor
+		for _, ow := range o.Owners[:o.BusFactor] {
+			fmt.Fprintf(w, "%5.1f%% %s (%d)\n", 100*ow.Share, ow.Author, ow.NumChanges)
+		}
+		if n := len(o.Owners) - o.BusFactor; n > 0 {
+			fmt.Fprintf(w, "and %d more\n", n)
+		}
+	}
+}
+
+func ownershipRows(res []*Ownership) [][]string {
+	var rows [][]string
+	for _, o := range res {
+		path := []string{o.Repo, o.Path, strconv.FormatBool(o.IsDir), strconv.Itoa(o.NumChanges), strconv.Itoa(o.BusFactor)}
+		if len(o.Owners) == 0 {
+			rows = append(rows, append(path, "", "", ""))
+		}
+		for _, ow := range o.Owners {
+			row := append(append([]string{}, path...), ow.Author, strconv.Itoa(ow.NumChanges), strconv.FormatFloat(ow.Share, 'f', -1, 64))
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
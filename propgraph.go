@@ -0,0 +1,396 @@
+package codegraph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/cayleygraph/cayley/voc/rdf"
+	"github.com/cayleygraph/cayley/voc/rdfs"
+	"github.com/cayleygraph/cayley/voc/schema"
+)
+
+const (
+	// FormatGEXF is a Gephi graph format.
+	FormatGEXF = "gexf"
+	// FormatGraphML is a GraphML format supported by Gephi, yEd, NetworkX and others.
+	FormatGraphML = "graphml"
+
+	predGephiInline = quad.IRI("gephi:inline")
+
+	edgeAttrPred  = "pred"
+	edgeAttrLabel = "quad_label"
+
+	// attrValueSep separates values of node attributes with more than one value (e.g. several UAST roles).
+	attrValueSep = "|"
+)
+
+// defaultInline predicates are always converted to node attributes (same as in Cayley's Gephi streaming).
+var defaultInline = []quad.IRI{
+	quad.IRI("gephi:x"), quad.IRI("gephi:y"),
+	rdf.Type,
+	rdfs.Label,
+	schema.Name,
+	schema.UrlProp,
+}
+
+func init() {
+	exportFormats[FormatGEXF] = exportFormat{
+		Ext: []string{".gexf"},
+		Writer: func(w io.Writer) quad.WriteCloser {
+			return &propGraphWriter{w: w, write: writeGEXF}
+		},
+	}
+	exportFormats[FormatGraphML] = exportFormat{
+		Ext: []string{".graphml"},
+		Writer: func(w io.Writer) quad.WriteCloser {
+			return &propGraphWriter{w: w, write: writeGraphML}
+		},
+	}
+}
+
+// propGraph is a property graph: objects of inline predicates and numeric literals
+// become node attributes, and other quads become edges. Attributes with more than one value
+// keep all distinct values in the order of quads, joined with attrValueSep.
+type propGraph struct {
+	nodes     []*propNode
+	edges     []propEdge
+	nodeAttrs []string
+}
+
+type propNode struct {
+	label string
+	attrs map[string][]string
+}
+
+// attr returns a value of a node attribute; multiple values are joined with attrValueSep.
+func (n *propNode) attr(key string) (string, bool) {
+	vals, ok := n.attrs[key]
+	return strings.Join(vals, attrValueSep), ok
+}
+
+type propEdge struct {
+	from, to int
+	pred     string
+	label    string
+}
+
+// valueString returns a value without N-Quads quoting: IRIs without angle brackets and raw literal values.
+func valueString(v quad.Value) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case quad.IRI:
+		return iriString(v)
+	case quad.String:
+		return string(v)
+	case quad.TypedString:
+		return string(v.Value)
+	case quad.LangString:
+		return string(v.Value)
+	case quad.Time:
+		return time.Time(v).Format(time.RFC3339)
+	}
+	return fmt.Sprint(v.Native())
+}
+
+func shouldInline(v quad.Value) bool {
+	switch v.(type) {
+	case quad.Bool, quad.Int, quad.Float, quad.Time, quad.String:
+		return true
+	}
+	return false
+}
+
+// newPropGraph converts quads to a property graph.
+// Predicates marked with "gephi:inline" are converted to node attributes.
+func newPropGraph(quads []quad.Quad) *propGraph {
+	inline := make(map[quad.Value]struct{})
+	for _, iri := range defaultInline {
+		inline[iri] = struct{}{}
+		inline[iri.Full()] = struct{}{}
+	}
+	for _, q := range quads {
+		if q.Predicate == predGephiInline && q.Object == quad.Bool(true) {
+			inline[q.Subject] = struct{}{}
+		}
+	}
+
+	var (
+		pg    = &propGraph{}
+		ids   = make(map[quad.Value]int)
+		attrs = make(map[string]struct{})
+	)
+	node := func(v quad.Value) int {
+		if id, ok := ids[v]; ok {
+			return id
+		}
+		id := len(pg.nodes)
+		ids[v] = id
+		pg.nodes = append(pg.nodes, &propNode{label: valueString(v), attrs: make(map[string][]string)})
+		return id
+	}
+	for _, q := range quads {
+		if q.Predicate == predGephiInline {
+			continue
+		}
+		s := node(q.Subject)
+		if _, ok := inline[q.Predicate]; ok || shouldInline(q.Object) {
+			key, val := valueString(q.Predicate), valueString(q.Object)
+			if !containsString(pg.nodes[s].attrs[key], val) {
+				pg.nodes[s].attrs[key] = append(pg.nodes[s].attrs[key], val)
+			}
+			attrs[key] = struct{}{}
+			continue
+		}
+		pg.edges = append(pg.edges, propEdge{
+			from:  s,
+			to:    node(q.Object),
+			pred:  valueString(q.Predicate),
+			label: valueString(q.Label),
+		})
+	}
+	for _, n := range pg.nodes {
+		for _, key := range []quad.IRI{schema.Name, rdfs.Label} {
+			if names := n.attrs[string(key)]; len(names) != 0 {
+				n.label = names[0]
+				break
+			}
+		}
+	}
+	for key := range attrs {
+		pg.nodeAttrs = append(pg.nodeAttrs, key)
+	}
+	sort.Strings(pg.nodeAttrs)
+	return pg
+}
+
+func containsString(vals []string, s string) bool {
+	for _, v := range vals {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// propGraphWriter collects all quads and writes them as a property graph on Close.
+type propGraphWriter struct {
+	w     io.Writer
+	write func(w io.Writer, pg *propGraph) error
+	quads []quad.Quad
+}
+
+func (w *propGraphWriter) WriteQuad(q quad.Quad) error {
+	w.quads = append(w.quads, q)
+	return nil
+}
+
+func (w *propGraphWriter) Close() error {
+	pg := newPropGraph(w.quads)
+	w.quads = nil
+	return w.write(w.w, pg)
+}
+
+type xmlAttr struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type xmlAttrValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type gexfNode struct {
+	XMLName xml.Name       `xml:"node"`
+	ID      string         `xml:"id,attr"`
+	Label   string         `xml:"label,attr"`
+	Values  []xmlAttrValue `xml:"attvalues>attvalue,omitempty"`
+}
+
+type gexfEdge struct {
+	XMLName xml.Name       `xml:"edge"`
+	ID      string         `xml:"id,attr"`
+	Source  string         `xml:"source,attr"`
+	Target  string         `xml:"target,attr"`
+	Label   string         `xml:"label,attr"`
+	Values  []xmlAttrValue `xml:"attvalues>attvalue,omitempty"`
+}
+
+type gexfAttributes struct {
+	XMLName xml.Name  `xml:"attributes"`
+	Class   string    `xml:"class,attr"`
+	Attrs   []xmlAttr `xml:"attribute"`
+}
+
+// xmlWriter writes XML elements and remembers the first error.
+type xmlWriter struct {
+	enc *xml.Encoder
+	err error
+}
+
+func (w *xmlWriter) start(name string, attrs ...string) {
+	if w.err != nil {
+		return
+	}
+	el := xml.StartElement{Name: xml.Name{Local: name}}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		el.Attr = append(el.Attr, xml.Attr{Name: xml.Name{Local: attrs[i]}, Value: attrs[i+1]})
+	}
+	w.err = w.enc.EncodeToken(el)
+}
+
+func (w *xmlWriter) end(name string) {
+	if w.err != nil {
+		return
+	}
+	w.err = w.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}})
+}
+
+func (w *xmlWriter) encode(v interface{}) {
+	if w.err != nil {
+		return
+	}
+	w.err = w.enc.Encode(v)
+}
+
+func (w *xmlWriter) flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.enc.Flush()
+}
+
+func newXMLWriter(w io.Writer) (*xmlWriter, error) {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return nil, err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return &xmlWriter{enc: enc}, nil
+}
+
+func writeGEXF(w io.Writer, pg *propGraph) error {
+	xw, err := newXMLWriter(w)
+	if err != nil {
+		return err
+	}
+	xw.start("gexf", "xmlns", "http://www.gexf.net/1.2draft", "version", "1.2")
+	xw.start("graph", "mode", "static", "defaultedgetype", "directed")
+
+	nodeAttrs := gexfAttributes{Class: "node"}
+	attrID := make(map[string]string, len(pg.nodeAttrs))
+	for i, key := range pg.nodeAttrs {
+		attrID[key] = fmt.Sprint(i)
+		nodeAttrs.Attrs = append(nodeAttrs.Attrs, xmlAttr{ID: attrID[key], Title: key, Type: "string"})
+	}
+	xw.encode(nodeAttrs)
+	xw.encode(gexfAttributes{Class: "edge", Attrs: []xmlAttr{
+		{ID: "0", Title: edgeAttrPred, Type: "string"},
+		{ID: "1", Title: edgeAttrLabel, Type: "string"},
+	}})
+
+	xw.start("nodes")
+	for i, n := range pg.nodes {
+		node := gexfNode{ID: fmt.Sprint(i), Label: n.label}
+		for _, key := range pg.nodeAttrs {
+			if v, ok := n.attr(key); ok {
+				node.Values = append(node.Values, xmlAttrValue{For: attrID[key], Value: v})
+			}
+		}
+		xw.encode(node)
+	}
+	xw.end("nodes")
+
+	xw.start("edges")
+	for i, e := range pg.edges {
+		edge := gexfEdge{
+			ID: fmt.Sprint(i), Source: fmt.Sprint(e.from), Target: fmt.Sprint(e.to),
+			Label:  e.pred,
+			Values: []xmlAttrValue{{For: "0", Value: e.pred}},
+		}
+		if e.label != "" {
+			edge.Values = append(edge.Values, xmlAttrValue{For: "1", Value: e.label})
+		}
+		xw.encode(edge)
+	}
+	xw.end("edges")
+
+	xw.end("graph")
+	xw.end("gexf")
+	return xw.flush()
+}
+
+type graphmlKey struct {
+	XMLName xml.Name `xml:"key"`
+	ID      string   `xml:"id,attr"`
+	For     string   `xml:"for,attr"`
+	Name    string   `xml:"attr.name,attr"`
+	Type    string   `xml:"attr.type,attr"`
+}
+
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphmlNode struct {
+	XMLName xml.Name      `xml:"node"`
+	ID      string        `xml:"id,attr"`
+	Data    []graphmlData `xml:"data"`
+}
+
+type graphmlEdge struct {
+	XMLName xml.Name      `xml:"edge"`
+	ID      string        `xml:"id,attr"`
+	Source  string        `xml:"source,attr"`
+	Target  string        `xml:"target,attr"`
+	Data    []graphmlData `xml:"data"`
+}
+
+func writeGraphML(w io.Writer, pg *propGraph) error {
+	xw, err := newXMLWriter(w)
+	if err != nil {
+		return err
+	}
+	xw.start("graphml", "xmlns", "http://graphml.graphdrawing.org/xmlns")
+
+	xw.encode(graphmlKey{ID: "label", For: "node", Name: "label", Type: "string"})
+	attrID := make(map[string]string, len(pg.nodeAttrs))
+	for i, key := range pg.nodeAttrs {
+		attrID[key] = fmt.Sprintf("d%d", i)
+		xw.encode(graphmlKey{ID: attrID[key], For: "node", Name: key, Type: "string"})
+	}
+	xw.encode(graphmlKey{ID: "e0", For: "edge", Name: edgeAttrPred, Type: "string"})
+	xw.encode(graphmlKey{ID: "e1", For: "edge", Name: edgeAttrLabel, Type: "string"})
+
+	xw.start("graph", "id", "G", "edgedefault", "directed")
+	for i, n := range pg.nodes {
+		node := graphmlNode{ID: fmt.Sprintf("n%d", i), Data: []graphmlData{{Key: "label", Value: n.label}}}
+		for _, key := range pg.nodeAttrs {
+			if v, ok := n.attr(key); ok {
+				node.Data = append(node.Data, graphmlData{Key: attrID[key], Value: v})
+			}
+		}
+		xw.encode(node)
+	}
+	for i, e := range pg.edges {
+		edge := graphmlEdge{
+			ID: fmt.Sprintf("e%d", i), Source: fmt.Sprintf("n%d", e.from), Target: fmt.Sprintf("n%d", e.to),
+			Data: []graphmlData{{Key: "e0", Value: e.pred}},
+		}
+		if e.label != "" {
+			edge.Data = append(edge.Data, graphmlData{Key: "e1", Value: e.label})
+		}
+		xw.encode(edge)
+	}
+	xw.end("graph")
+	xw.end("graphml")
+	return xw.flush()
+}
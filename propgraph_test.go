@@ -0,0 +1,64 @@
+package codegraph
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/cayleygraph/cayley/voc/rdf"
+	"github.com/mloncode/codegraph/uast"
+)
+
+func TestPropGraphMultipleValues(t *testing.T) {
+	var (
+		n1 = quad.BNode("n1")
+		n2 = quad.BNode("n2")
+	)
+	quads := []quad.Quad{
+		{Subject: n1, Predicate: quad.IRI(rdf.Type), Object: quad.IRI("uast:Identifier")},
+		{Subject: n1, Predicate: uast.PredRole, Object: quad.String("Identifier")},
+		{Subject: n1, Predicate: uast.PredRole, Object: quad.String("Expression")},
+		// the same value in another graph is kept once
+		{Subject: n1, Predicate: uast.PredRole, Object: quad.String("Identifier"), Label: quad.IRI("sha1:f1")},
+		{Subject: n1, Predicate: quad.IRI("uast:Name"), Object: quad.String("x")},
+		{Subject: n2, Predicate: quad.IRI("uast:Value"), Object: n1},
+	}
+	pg := newPropGraph(quads)
+	if len(pg.nodes) != 2 || len(pg.edges) != 1 {
+		t.Fatalf("unexpected graph: %d nodes, %d edges", len(pg.nodes), len(pg.edges))
+	}
+	exp := map[string][]string{
+		"rdf:type":  {"uast:Identifier"},
+		"uast:Role": {"Identifier", "Expression"},
+		"uast:Name": {"x"},
+	}
+	if !reflect.DeepEqual(pg.nodes[0].attrs, exp) {
+		t.Errorf("attributes:\n%v\nexpected:\n%v", pg.nodes[0].attrs, exp)
+	}
+	if v, _ := pg.nodes[0].attr("uast:Role"); v != "Identifier|Expression" {
+		t.Errorf("unexpected roles: %q", v)
+	}
+
+	for _, c := range []struct {
+		name  string
+		write func(w *bytes.Buffer, pg *propGraph) error
+		exp   string
+	}{
+		{name: FormatGEXF, exp: `<attvalue for="2" value="Identifier|Expression"></attvalue>`, write: func(w *bytes.Buffer, pg *propGraph) error {
+			return writeGEXF(w, pg)
+		}},
+		{name: FormatGraphML, exp: `<data key="d2">Identifier|Expression</data>`, write: func(w *bytes.Buffer, pg *propGraph) error {
+			return writeGraphML(w, pg)
+		}},
+	} {
+		var buf bytes.Buffer
+		if err := c.write(&buf, pg); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), c.exp) {
+			t.Errorf("%s: expected %s in:\n%s", c.name, c.exp, buf.String())
+		}
+	}
+}
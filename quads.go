@@ -24,8 +24,11 @@ type Exporter struct {
 }
 
 type ExportOptions struct {
-	UASTs      bool   // export UASTs
-	BblfshAddr string // for processing UASTs; defaults to "localhost:9432"
+	UASTs      bool       // export UASTs
+	BblfshAddr string     // for processing UASTs; defaults to "localhost:9432"
+	Filter     git.Filter // selects commits to export
+	CacheDir   string     // for cloning remote repositories; clone in memory if empty
+	RepoID     string     // overrides the repository ID derived from its URL
 }
 
 func NewExporter(w quad.Writer, opts *ExportOptions) (*Exporter, error) {
@@ -72,6 +75,9 @@ func (e *Exporter) ExportRepoPath(gitpath string) error {
 			defer rc.Close()
 			return e.exportFile(id, f.Name, rc)
 		}
+		ge.Filter = e.opts.Filter
+		ge.CacheDir = e.opts.CacheDir
+		ge.RepoID = e.opts.RepoID
 		e.ge = ge
 	}
 	return e.ge.ExportPath(gitpath)
@@ -0,0 +1,282 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+	"github.com/mloncode/codegraph/uast"
+)
+
+const (
+	typeUASTImport              = quad.IRI("uast:Import")
+	typeUASTInlineImport        = quad.IRI("uast:InlineImport")
+	typeUASTIdentifier          = quad.IRI("uast:Identifier")
+	typeUASTString              = quad.IRI("uast:String")
+	typeUASTAlias               = quad.IRI("uast:Alias")
+	typeUASTQualifiedIdentifier = quad.IRI("uast:QualifiedIdentifier")
+
+	predUASTName   = quad.IRI("uast:Name")
+	predUASTPath   = quad.IRI("uast:Path")
+	predUASTValue  = quad.IRI("uast:Value")
+	predUASTNode   = quad.IRI("uast:Node")
+	predUASTNames  = quad.IRI("uast:Names")
+	predUASTStart  = quad.IRI("uast:start")
+	predUASTOffset = quad.IRI("uast:offset")
+	predUASTLine   = quad.IRI("uast:line")
+	predUASTCol    = quad.IRI("uast:col")
+)
+
+type (
+	// Import is an import found in a UAST
+	Import struct {
+		File string `json:"file"` // file ID
+		Path string `json:"path"` // import path
+	}
+
+	// Identifier is an identifier found in a UAST
+	Identifier struct {
+		Name string `json:"name"`
+		Line int    `json:"line,omitempty"`
+		Col  int    `json:"col,omitempty"`
+	}
+
+	// ImportCount is the number of imports of a package
+	ImportCount struct {
+		Path  string `json:"path"`
+		Count int    `json:"count"`
+	}
+)
+
+// Imports returns imports found in UASTs of files of a repository (given by ID or URL),
+// or in all UASTs in the graph if repo is empty. Imports are sorted by file and path.
+func (g *Graph) Imports(ctx context.Context, repo string) ([]Import, error) {
+	var files map[quad.Value]struct{}
+	if repo != "" {
+		repoIRI, err := g.findRepo(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		files = make(map[quad.Value]struct{})
+		for _, c := range outValues(ctx, g.store, repoIRI, git.PredCommit) {
+			for _, f := range outValues(ctx, g.store, c, git.PredFile) {
+				files[f] = struct{}{}
+			}
+		}
+	}
+
+	out := make([]Import, 0)
+	err := g.eachImport(ctx, func(file quad.Value, path string) {
+		if files != nil {
+			if _, ok := files[file]; !ok {
+				return
+			}
+		}
+		out = append(out, Import{File: iriString(file), Path: path})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].File != out[j].File {
+			return out[i].File < out[j].File
+		}
+		return out[i].Path < out[j].Path
+	})
+	return out, nil
+}
+
+// FilesImporting returns IDs of files which import a given package (e.g. "fmt" or "github.com/pkg/errors").
+func (g *Graph) FilesImporting(ctx context.Context, pkg string) ([]string, error) {
+	seen := make(map[string]struct{})
+	err := g.eachImport(ctx, func(file quad.Value, path string) {
+		if path == pkg {
+			seen[iriString(file)] = struct{}{}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(seen))
+	for f := range seen {
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// ImportStats returns the number of imports of each package in a repository (given by ID or URL),
+// or in the whole graph if repo is empty, most imported packages first.
+func (g *Graph) ImportStats(ctx context.Context, repo string) ([]ImportCount, error) {
+	imports, err := g.Imports(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, imp := range imports {
+		counts[imp.Path]++
+	}
+	out := make([]ImportCount, 0, len(counts))
+	for path, n := range counts {
+		out = append(out, ImportCount{Path: path, Count: n})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Path < out[j].Path
+	})
+	return out, nil
+}
+
+// Identifiers returns identifiers in the UAST of a file (given by ID or blob hash), in the order of their positions.
+func (g *Graph) Identifiers(ctx context.Context, file string) ([]Identifier, error) {
+	_, roots, err := g.uastRoots(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	type ident struct {
+		Identifier
+		offset int
+	}
+	var idents []ident
+	stack := roots
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, q := range linkedQuads(ctx, g.store, quad.Subject, n, "") {
+			switch q.Predicate {
+			case uast.PredPos, uast.PredRole:
+				continue
+			case git.PredType:
+				if q.Object != typeUASTIdentifier {
+					continue
+				}
+				id := ident{Identifier: Identifier{Name: outString(ctx, g.store, n, predUASTName)}, offset: -1}
+				if start := g.uastStart(ctx, n); start != nil {
+					id.offset = outInt(ctx, g.store, start, predUASTOffset)
+					id.Line = outInt(ctx, g.store, start, predUASTLine)
+					id.Col = outInt(ctx, g.store, start, predUASTCol)
+				}
+				idents = append(idents, id)
+				continue
+			}
+			if _, ok := q.Object.(quad.BNode); ok {
+				stack = append(stack, q.Object)
+			}
+		}
+	}
+	sort.SliceStable(idents, func(i, j int) bool {
+		return idents[i].offset < idents[j].offset
+	})
+
+	out := make([]Identifier, 0, len(idents))
+	for _, id := range idents {
+		out = append(out, id.Identifier)
+	}
+	return out, nil
+}
+
+// uastRoots returns roots of the UAST of a file given by its ID (e.g. "sha1:<blob hash>") or by a blob hash.
+func (g *Graph) uastRoots(ctx context.Context, file string) (quad.IRI, []quad.Value, error) {
+	for _, id := range []quad.IRI{quad.IRI(file), quad.IRI("sha1:" + file)} {
+		if roots := outValues(ctx, g.store, id, uast.PredRoot); len(roots) > 0 {
+			return id, roots, nil
+		}
+	}
+	return "", nil, fmt.Errorf("no UAST for file: %q", file)
+}
+
+// eachImport calls fnc for every import node in the graph with the file it belongs to and the import path.
+func (g *Graph) eachImport(ctx context.Context, fnc func(file quad.Value, path string)) error {
+	it, _ := cayley.StartPath(g.store, typeUASTImport, typeUASTInlineImport).In(git.PredType).BuildIterator().Optimize()
+	it, _ = g.store.OptimizeIterator(it)
+	defer it.Close()
+
+	for it.Next(ctx) {
+		imp := g.store.NameOf(it.Result())
+		file := g.uastFile(ctx, imp)
+		if file == nil {
+			continue
+		}
+		for _, p := range outValues(ctx, g.store, imp, predUASTPath) {
+			if path := g.importPath(ctx, p); path != "" {
+				fnc(file, path)
+			}
+		}
+	}
+	return it.Err()
+}
+
+// importPath converts a path node of an import to a string (the same as "toPath" helper in README).
+func (g *Graph) importPath(ctx context.Context, n quad.Value) string {
+	switch outValue(ctx, g.store, n, git.PredType) {
+	case typeUASTString:
+		return outString(ctx, g.store, n, predUASTValue)
+	case typeUASTIdentifier:
+		return outString(ctx, g.store, n, predUASTName)
+	case typeUASTAlias:
+		if node := outValue(ctx, g.store, n, predUASTNode); node != nil {
+			return g.importPath(ctx, node)
+		}
+	case typeUASTQualifiedIdentifier:
+		names := outValues(ctx, g.store, n, predUASTNames)
+		g.sortByPosition(ctx, names)
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			parts = append(parts, g.importPath(ctx, name))
+		}
+		return strings.Join(parts, "/")
+	}
+	return ""
+}
+
+// uastFile returns a file that a UAST node belongs to by following links back to the UAST root.
+func (g *Graph) uastFile(ctx context.Context, n quad.Value) quad.Value {
+	seen := make(map[quad.Value]struct{})
+	for n != nil {
+		if _, ok := seen[n]; ok {
+			return nil
+		}
+		seen[n] = struct{}{}
+
+		var parent quad.Value
+		for _, q := range linkedQuads(ctx, g.store, quad.Object, n, "") {
+			if q.Predicate == uast.PredRoot {
+				return q.Subject
+			}
+			if _, ok := q.Subject.(quad.BNode); ok && parent == nil {
+				parent = q.Subject
+			}
+		}
+		n = parent
+	}
+	return nil
+}
+
+// uastStart returns the start position node of a UAST node, or nil.
+func (g *Graph) uastStart(ctx context.Context, n quad.Value) quad.Value {
+	if pos := outValue(ctx, g.store, n, uast.PredPos); pos != nil {
+		return outValue(ctx, g.store, pos, predUASTStart)
+	}
+	return nil
+}
+
+// sortByPosition sorts UAST nodes by their start offsets, since quads do not preserve the order of UAST arrays.
+func (g *Graph) sortByPosition(ctx context.Context, nodes []quad.Value) {
+	offsets := make(map[quad.Value]int, len(nodes))
+	for _, n := range nodes {
+		offsets[n] = -1
+		if start := g.uastStart(ctx, n); start != nil {
+			offsets[n] = outInt(ctx, g.store, start, predUASTOffset)
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return offsets[nodes[i]] < offsets[nodes[j]]
+	})
+}
@@ -0,0 +1,152 @@
+package codegraph
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// newTestGraph returns an in-memory graph with quads loaded from N-Quads files in testdata.
+func newTestGraph(t testing.TB, files ...string) *Graph {
+	g, err := Open("", &Options{Backend: BackendMemory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range files {
+		f, err := os.Open(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = g.Load(context.Background(), f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+	return g
+}
+
+func TestImports(t *testing.T) {
+	g := newTestGraph(t, "uast.nq")
+	defer g.Close()
+	ctx := context.Background()
+
+	all := []Import{
+		{File: "sha1:f1", Path: "fmt"},
+		{File: "sha1:f1", Path: "github.com/pkg/errors"},
+		{File: "sha1:f2", Path: "fmt"},
+		{File: "sha1:f2", Path: "github.com/x"},
+		{File: "sha1:f3", Path: "fmt"},
+	}
+	for _, c := range []struct {
+		repo string
+		exp  []Import
+	}{
+		{repo: "", exp: all},
+		{repo: "file:///repo", exp: all[:4]},
+	} {
+		res, err := g.Imports(ctx, c.repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, c.exp) {
+			t.Errorf("Imports(%q):\n%v\nexpected:\n%v", c.repo, res, c.exp)
+		}
+	}
+
+	if _, err := g.Imports(ctx, "file:///missing"); err == nil {
+		t.Error("expected an error for a missing repository")
+	}
+}
+
+func TestFilesImporting(t *testing.T) {
+	g := newTestGraph(t, "uast.nq")
+	defer g.Close()
+	ctx := context.Background()
+
+	for _, c := range []struct {
+		pkg string
+		exp []string
+	}{
+		{pkg: "fmt", exp: []string{"sha1:f1", "sha1:f2", "sha1:f3"}},
+		{pkg: "github.com/pkg/errors", exp: []string{"sha1:f1"}},
+		{pkg: "github.com/x", exp: []string{"sha1:f2"}},
+		{pkg: "errs", exp: []string{}},
+	} {
+		res, err := g.FilesImporting(ctx, c.pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, c.exp) {
+			t.Errorf("FilesImporting(%q): %v, expected: %v", c.pkg, res, c.exp)
+		}
+	}
+}
+
+func TestImportStats(t *testing.T) {
+	g := newTestGraph(t, "uast.nq")
+	defer g.Close()
+	ctx := context.Background()
+
+	for _, c := range []struct {
+		repo string
+		exp  []ImportCount
+	}{
+		{repo: "", exp: []ImportCount{
+			{Path: "fmt", Count: 3},
+			{Path: "github.com/pkg/errors", Count: 1},
+			{Path: "github.com/x", Count: 1},
+		}},
+		{repo: "file:///repo", exp: []ImportCount{
+			{Path: "fmt", Count: 2},
+			{Path: "github.com/pkg/errors", Count: 1},
+			{Path: "github.com/x", Count: 1},
+		}},
+	} {
+		res, err := g.ImportStats(ctx, c.repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, c.exp) {
+			t.Errorf("ImportStats(%q):\n%v\nexpected:\n%v", c.repo, res, c.exp)
+		}
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	g := newTestGraph(t, "uast.nq")
+	defer g.Close()
+	ctx := context.Background()
+
+	for _, c := range []struct {
+		file string
+		exp  []Identifier
+	}{
+		// identifiers without a position go first
+		{file: "sha1:f1", exp: []Identifier{
+			{Name: "errs"},
+			{Name: "main", Line: 6, Col: 6},
+			{Name: "Println", Line: 7, Col: 6},
+		}},
+		// a blob hash instead of a file ID
+		{file: "f2", exp: []Identifier{
+			{Name: "github.com", Line: 3, Col: 2},
+			{Name: "x", Line: 3, Col: 13},
+		}},
+		{file: "sha1:f3", exp: []Identifier{}},
+	} {
+		res, err := g.Identifiers(ctx, c.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, c.exp) {
+			t.Errorf("Identifiers(%q):\n%v\nexpected:\n%v", c.file, res, c.exp)
+		}
+	}
+
+	if _, err := g.Identifiers(ctx, "sha1:missing"); err == nil {
+		t.Error("expected an error for a file without UAST")
+	}
+}
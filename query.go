@@ -0,0 +1,97 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/cayleygraph/cayley/query"
+	_ "github.com/cayleygraph/cayley/query/gizmo"
+	_ "github.com/cayleygraph/cayley/query/graphql"
+	_ "github.com/cayleygraph/cayley/query/mql"
+)
+
+// QueryLanguages returns names of supported query languages.
+func QueryLanguages() []string {
+	langs := query.Languages()
+	sort.Strings(langs)
+	return langs
+}
+
+// Query executes a query in a given language (gizmo, graphql or mql) and returns results
+// in the same form as Cayley's HTTP API: a list of results for gizmo and mql (empty if nothing matches), or a data object for graphql.
+// Limit is the maximal number of results (0 means no limit).
+func (g *Graph) Query(ctx context.Context, lang, q string, limit int) (interface{}, error) {
+	l := query.GetLanguage(lang)
+	if l == nil {
+		return nil, fmt.Errorf("unsupported query language: %q", lang)
+	}
+	if limit <= 0 {
+		limit = -1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		out  = make(chan query.Result, 100)
+		sess query.Session
+		hs   query.HTTP
+	)
+	if l.HTTP != nil {
+		hs = l.HTTP(g.store)
+		sess = hs
+	} else {
+		sess = l.Session(g.store)
+	}
+	go sess.Execute(ctx, q, out, limit)
+
+	results := make([]interface{}, 0)
+	for r := range out {
+		if err := r.Err(); err != nil {
+			return nil, err
+		}
+		if hs != nil {
+			hs.Collate(r)
+			continue
+		}
+		results = append(results, g.queryResult(r.Result()))
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if hs != nil {
+		res, err := hs.Results()
+		if list, ok := res.([]interface{}); ok && list == nil {
+			// no results
+			return results, err
+		}
+		return res, err
+	}
+	return results, nil
+}
+
+// QueryShape returns a shape of a query (a graph of its iterators), as used by Cayley's UI.
+func (g *Graph) QueryShape(_ context.Context, lang, q string) (interface{}, error) {
+	l := query.GetLanguage(lang)
+	if l == nil || l.HTTP == nil {
+		return nil, fmt.Errorf("unsupported query language: %q", lang)
+	}
+	return l.HTTP(g.store).ShapeOf(q)
+}
+
+// queryResult converts tags of a query result to values.
+func (g *Graph) queryResult(r interface{}) interface{} {
+	tags, ok := r.(map[string]graph.Value)
+	if !ok {
+		return r
+	}
+	m := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if name := g.store.NameOf(v); name != nil {
+			m[k] = quad.NativeOf(name)
+		}
+	}
+	return m
+}
@@ -0,0 +1,214 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+	"github.com/mloncode/codegraph/uast"
+)
+
+const removeBatchSize = 10000
+
+// RemoveRepo removes a repository with its branches, tags and commits from the database.
+// Files, authors and UAST nodes are removed only if no other repository refers to them.
+// The repository can be given by its ID or by any URL that normalizes to it.
+// Returns number of removed quads.
+func (g *Graph) RemoveRepo(ctx context.Context, repo string) (int, error) {
+	repoIRI, err := g.findRepo(ctx, repo)
+	if err != nil {
+		return 0, err
+	}
+	r := &repoRemover{qs: g.store}
+
+	var (
+		refs    = append(r.objects(ctx, repoIRI, git.PredBranch), r.objects(ctx, repoIRI, git.PredTag)...)
+		commits = r.objects(ctx, repoIRI, git.PredCommit)
+		files   = make(map[quad.Value]struct{})
+		authors = make(map[quad.Value]struct{})
+	)
+	for _, b := range refs {
+		r.removeSubject(ctx, b)
+	}
+	for _, pred := range []quad.IRI{predAnalysisPath, predCoChange} {
+		for _, p := range r.objects(ctx, repoIRI, pred) {
+			r.removeSubject(ctx, p)
+		}
+	}
+	r.removeNode(ctx, repoIRI)
+	if err := r.flush(); err != nil {
+		return r.n, err
+	}
+
+	for _, c := range commits {
+		if r.count(ctx, quad.Object, c, git.PredCommit) != 0 {
+			// shared with another repository
+			continue
+		}
+		for _, f := range r.objects(ctx, c, git.PredFile) {
+			files[f] = struct{}{}
+		}
+		for _, pred := range []quad.IRI{git.PredAdd, git.PredRemove, git.PredModify} {
+			for _, f := range r.subjects(ctx, c, pred) {
+				files[f] = struct{}{}
+			}
+		}
+		for _, pred := range []quad.IRI{git.PredAuthor, git.PredCommiter} {
+			for _, a := range r.objects(ctx, c, pred) {
+				authors[a] = struct{}{}
+			}
+		}
+		r.removeNode(ctx, c)
+		if len(r.buf) >= removeBatchSize {
+			if err := r.flush(); err != nil {
+				return r.n, err
+			}
+		}
+	}
+	if err := r.flush(); err != nil {
+		return r.n, err
+	}
+
+	for a := range authors {
+		if r.count(ctx, quad.Object, a, "") == 0 {
+			r.removeSubject(ctx, a)
+		}
+	}
+	for f := range files {
+		if r.count(ctx, quad.Object, f, git.PredFile) != 0 ||
+			r.count(ctx, quad.Subject, f, git.PredAdd) != 0 ||
+			r.count(ctx, quad.Subject, f, git.PredRemove) != 0 ||
+			r.count(ctx, quad.Subject, f, git.PredModify) != 0 {
+			// still used by another repository
+			continue
+		}
+		for _, root := range r.objects(ctx, f, uast.PredRoot) {
+			r.removeTree(ctx, root)
+		}
+		r.removeNode(ctx, f)
+		if len(r.buf) >= removeBatchSize {
+			if err := r.flush(); err != nil {
+				return r.n, err
+			}
+		}
+	}
+	return r.n, r.flush()
+}
+
+// findRepo returns the IRI of a repository given by ID or URL.
+func (g *Graph) findRepo(ctx context.Context, repo string) (quad.IRI, error) {
+	for _, id := range []quad.IRI{quad.IRI(repo), quad.IRI(git.NormalizeURL(repo))} {
+		if hasQuad(ctx, g.store, quad.Quad{Subject: id, Predicate: git.PredType, Object: git.TypeRepo}) {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("repository not found: %q", repo)
+}
+
+func hasQuad(ctx context.Context, qs graph.QuadStore, q quad.Quad) bool {
+	ref := qs.ValueOf(q.Subject)
+	if ref == nil {
+		return false
+	}
+	it := qs.QuadIterator(quad.Subject, ref)
+	defer it.Close()
+	for it.Next(ctx) {
+		if cur := qs.Quad(it.Result()); cur.Predicate == q.Predicate && cur.Object == q.Object {
+			return true
+		}
+	}
+	return false
+}
+
+// repoRemover collects quads to be removed and deletes them in batches.
+type repoRemover struct {
+	qs   graph.QuadStore
+	buf  []graph.Delta
+	seen map[quad.Quad]struct{}
+	n    int
+}
+
+func (r *repoRemover) objects(ctx context.Context, node quad.Value, pred quad.IRI) []quad.Value {
+	var out []quad.Value
+	for _, q := range linkedQuads(ctx, r.qs, quad.Subject, node, pred) {
+		out = append(out, q.Object)
+	}
+	return out
+}
+
+func (r *repoRemover) subjects(ctx context.Context, node quad.Value, pred quad.IRI) []quad.Value {
+	var out []quad.Value
+	for _, q := range linkedQuads(ctx, r.qs, quad.Object, node, pred) {
+		out = append(out, q.Subject)
+	}
+	return out
+}
+
+// count returns the number of quads with a given node that are not yet scheduled for removal.
+func (r *repoRemover) count(ctx context.Context, d quad.Direction, node quad.Value, pred quad.IRI) int {
+	n := 0
+	for _, q := range linkedQuads(ctx, r.qs, d, node, pred) {
+		if _, ok := r.seen[q]; !ok {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *repoRemover) remove(quads []quad.Quad) {
+	if r.seen == nil {
+		r.seen = make(map[quad.Quad]struct{})
+	}
+	for _, q := range quads {
+		if _, ok := r.seen[q]; ok {
+			continue
+		}
+		r.seen[q] = struct{}{}
+		r.buf = append(r.buf, graph.Delta{Quad: q, Action: graph.Delete})
+	}
+}
+
+// removeSubject removes all quads with node as a subject.
+func (r *repoRemover) removeSubject(ctx context.Context, node quad.Value) {
+	r.remove(linkedQuads(ctx, r.qs, quad.Subject, node, ""))
+}
+
+// removeNode removes all quads with node as a subject or an object.
+func (r *repoRemover) removeNode(ctx context.Context, node quad.Value) {
+	r.remove(linkedQuads(ctx, r.qs, quad.Subject, node, ""))
+	r.remove(linkedQuads(ctx, r.qs, quad.Object, node, ""))
+}
+
+// removeTree removes a UAST subtree starting at a given node.
+// UAST nodes are blank nodes, so they are never shared between files.
+func (r *repoRemover) removeTree(ctx context.Context, root quad.Value) {
+	stack := []quad.Value{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := node.(quad.BNode); !ok {
+			continue
+		}
+		quads := linkedQuads(ctx, r.qs, quad.Subject, node, "")
+		for _, q := range quads {
+			if _, ok := r.seen[q]; !ok {
+				stack = append(stack, q.Object)
+			}
+		}
+		r.remove(quads)
+	}
+}
+
+func (r *repoRemover) flush() error {
+	if len(r.buf) == 0 {
+		return nil
+	}
+	err := r.qs.ApplyDeltas(r.buf, graph.IgnoreOpts{IgnoreMissing: true})
+	if err == nil {
+		r.n += len(r.buf)
+	}
+	r.buf = r.buf[:0]
+	return err
+}
@@ -0,0 +1,306 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+type (
+	// RepoInfo describes a repository in the graph
+	RepoInfo struct {
+		ID         string            `json:"id"`
+		Name       string            `json:"name,omitempty"`
+		URLs       []string          `json:"urls,omitempty"`
+		Branches   map[string]string `json:"branches,omitempty"` // branch name -> commit hash
+		NumCommits int               `json:"commits"`
+	}
+
+	// CommitInfo describes a commit
+	CommitInfo struct {
+		Hash        string    `json:"hash"`
+		Message     string    `json:"message"`
+		Author      string    `json:"author,omitempty"` // name <email>
+		AuthoredAt  time.Time `json:"authored_at"`
+		CommittedAt time.Time `json:"committed_at"`
+		Parents     []string  `json:"parents,omitempty"`
+	}
+
+	// PathChange describes a change of a file by a commit
+	PathChange struct {
+		CommitInfo
+		Path   string `json:"path"`
+		Action string `json:"action"` // add, remove or modify
+		Blob   string `json:"blob"`   // file hash; the last version of the file for removed files
+	}
+
+	// TreeEntry describes a file in a commit
+	TreeEntry struct {
+		Path     string `json:"path"`
+		Hash     string `json:"hash"`               // blob hash
+		Language string `json:"language,omitempty"` // language detected by enry
+		Size     int64  `json:"size,omitempty"`     // only available for repositories imported with file sizes (git:size)
+	}
+)
+
+// Repos returns all repositories in the graph, sorted by ID.
+func (g *Graph) Repos(ctx context.Context) ([]*RepoInfo, error) {
+	repos, err := g.repos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*RepoInfo, 0, len(repos))
+	for _, repo := range repos {
+		ri := &RepoInfo{
+			ID:         iriString(repo),
+			Name:       outString(ctx, g.store, repo, git.PredName),
+			NumCommits: len(outValues(ctx, g.store, repo, git.PredCommit)),
+		}
+		for _, u := range outValues(ctx, g.store, repo, git.PredURL) {
+			ri.URLs = append(ri.URLs, iriString(u))
+		}
+		for _, b := range outValues(ctx, g.store, repo, git.PredBranch) {
+			if ri.Branches == nil {
+				ri.Branches = make(map[string]string)
+			}
+			ri.Branches[outString(ctx, g.store, b, git.PredName)] = commitHash(outValue(ctx, g.store, b, git.PredCommit))
+		}
+		out = append(out, ri)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+// Commits returns all commits of a repository (given by ID or URL), most recent first.
+func (g *Graph) Commits(ctx context.Context, repo string) ([]*CommitInfo, error) {
+	repoIRI, err := g.findRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*CommitInfo, 0)
+	for _, c := range outValues(ctx, g.store, repoIRI, git.PredCommit) {
+		out = append(out, g.commitInfo(ctx, c))
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CommittedAt.After(out[j].CommittedAt)
+	})
+	return out, nil
+}
+
+// FilesAt returns files of a repository (given by ID or URL) at a given ref (branch name, tag name or commit hash), sorted by path.
+func (g *Graph) FilesAt(ctx context.Context, repo, ref string) ([]*TreeEntry, error) {
+	repoIRI, err := g.findRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := g.resolveRef(ctx, repoIRI, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*TreeEntry, 0)
+	for _, q := range linkedQuads(ctx, g.store, quad.Subject, commit, git.PredFile) {
+		path, _ := q.Label.(quad.String)
+		out = append(out, &TreeEntry{
+			Path:     string(path),
+			Hash:     commitHash(q.Object),
+			Language: outString(ctx, g.store, q.Object, predEnryLang),
+			Size:     int64(outInt(ctx, g.store, q.Object, git.PredSize)),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out, nil
+}
+
+// PathHistory returns changes of a path in a repository (given by ID or URL), most recent first.
+// If the path is a directory, changes of all files in it are returned.
+func (g *Graph) PathHistory(ctx context.Context, repo, path string) ([]*PathChange, error) {
+	repoIRI, err := g.findRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	commits := make(map[quad.Value]struct{})
+	for _, c := range outValues(ctx, g.store, repoIRI, git.PredCommit) {
+		commits[c] = struct{}{}
+	}
+	path = strings.Trim(path, "/")
+
+	var changes []quad.Quad
+	for _, pred := range []quad.IRI{git.PredAdd, git.PredRemove, git.PredModify} {
+		err := scanPredicate(ctx, g.store, pred, func(q quad.Quad) {
+			p, _ := q.Label.(quad.String)
+			if string(p) != path && (path != "" && !strings.HasPrefix(string(p), path+"/")) {
+				return
+			}
+			if _, ok := commits[q.Object]; ok {
+				changes = append(changes, q)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	infos := make(map[quad.Value]*CommitInfo)
+	out := make([]*PathChange, 0, len(changes))
+	for _, q := range changes {
+		ci, ok := infos[q.Object]
+		if !ok {
+			ci = g.commitInfo(ctx, q.Object)
+			infos[q.Object] = ci
+		}
+		p, _ := q.Label.(quad.String)
+		out = append(out, &PathChange{
+			CommitInfo: *ci,
+			Path:       string(p),
+			Action:     strings.TrimPrefix(iriString(q.Predicate), "git:"),
+			Blob:       commitHash(q.Subject),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CommittedAt.Equal(out[j].CommittedAt) {
+			return out[i].CommittedAt.After(out[j].CommittedAt)
+		}
+		if out[i].Hash != out[j].Hash {
+			return out[i].Hash < out[j].Hash
+		}
+		return out[i].Path < out[j].Path
+	})
+	return out, nil
+}
+
+// PrintPathHistory writes changes of a path in a repository to w in a given format: text (default), json or csv.
+// The text format is similar to "git log --name-status". Limit is the number of commits (0 means no limit).
+func (g *Graph) PrintPathHistory(ctx context.Context, w io.Writer, format, repo, path string, limit int) error {
+	changes, err := g.PathHistory(ctx, repo, path)
+	if err != nil {
+		return err
+	}
+	if limit > 0 {
+		n := 0
+		for i, ch := range changes {
+			if i == 0 || ch.Hash != changes[i-1].Hash {
+				if n == limit {
+					changes = changes[:i]
+					break
+				}
+				n++
+			}
+		}
+	}
+
+	header := []string{"hash", "path", "action", "blob", "author", "authored_at", "committed_at", "message"}
+	return writeReport(w, format, changes, header, func() [][]string {
+		rows := make([][]string, 0, len(changes))
+		for _, ch := range changes {
+			rows = append(rows, []string{
+				ch.Hash, ch.Path, ch.Action, ch.Blob, ch.Author,
+				formatTime(ch.AuthoredAt), formatTime(ch.CommittedAt), ch.Message,
+			})
+		}
+		return rows
+	}, func(w io.Writer) {
+		for i, ch := range changes {
+			if i == 0 || ch.Hash != changes[i-1].Hash {
+				if i != 0 {
+					fmt.Fprintln(w)
+				}
+				fmt.Fprintf(w, "commit %s\n", ch.Hash)
+				if ch.Author != "" {
+					fmt.Fprintf(w, "Author: %s\n", ch.Author)
+				}
+				date := ch.AuthoredAt
+				if tz, err := time.Parse("-0700", outString(ctx, g.store, quad.IRI("sha1:"+ch.Hash), git.PredAuthoredTZ)); err == nil {
+					date = date.In(tz.Location())
+				}
+				fmt.Fprintf(w, "Date:   %s\n\n", date.Format("Mon Jan 2 15:04:05 2006 -0700"))
+				for _, line := range strings.Split(strings.TrimRight(ch.Message, "\n"), "\n") {
+					fmt.Fprintf(w, "    %s\n", line)
+				}
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", actionStatus(ch.Action), ch.Path)
+		}
+	})
+}
+
+// actionStatus returns a status letter of a change action, the same as in "git log --name-status".
+func actionStatus(action string) string {
+	switch action {
+	case "add":
+		return "A"
+	case "remove":
+		return "D"
+	case "modify":
+		return "M"
+	}
+	return "?"
+}
+
+// PrintFilesAt writes files of a repository at a given ref to w in a given format: text (default), json or csv.
+// The text format is similar to "git ls-tree": a blob hash, a language and a path per line.
+func (g *Graph) PrintFilesAt(ctx context.Context, w io.Writer, format, repo, ref string) error {
+	files, err := g.FilesAt(ctx, repo, ref)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"path", "hash", "language", "size"}
+	return writeReport(w, format, files, header, func() [][]string {
+		rows := make([][]string, 0, len(files))
+		for _, f := range files {
+			rows = append(rows, []string{f.Path, f.Hash, f.Language, strconv.FormatInt(f.Size, 10)})
+		}
+		return rows
+	}, func(w io.Writer) {
+		for _, f := range files {
+			lang := f.Language
+			if lang == "" {
+				lang = "-"
+			}
+			fmt.Fprintf(w, "%s %-12s\t%s\n", f.Hash, lang, f.Path)
+		}
+	})
+}
+
+func (g *Graph) commitInfo(ctx context.Context, c quad.Value) *CommitInfo {
+	ci := &CommitInfo{
+		Hash:        commitHash(c),
+		Message:     outString(ctx, g.store, c, git.PredMessage),
+		AuthoredAt:  timeOf(ctx, g.store, c, git.PredAuthoredAt),
+		CommittedAt: timeOf(ctx, g.store, c, git.PredCommittedAt),
+	}
+	if author := outValue(ctx, g.store, c, git.PredAuthor); author != nil {
+		ci.Author = g.authorString(ctx, author)
+	}
+	for _, p := range outValues(ctx, g.store, c, git.PredParent) {
+		ci.Parents = append(ci.Parents, commitHash(p))
+	}
+	return ci
+}
+
+// authorString returns a name and an email of an author node in "name <email>" format.
+func (g *Graph) authorString(ctx context.Context, author quad.Value) string {
+	name := outString(ctx, g.store, author, git.PredName)
+	if email := outValue(ctx, g.store, author, git.PredEmail); email != nil {
+		return name + " <" + iriString(email) + ">"
+	}
+	return name
+}
+
+// commitHash returns a git hash of a commit (or file) node.
+func commitHash(c quad.Value) string {
+	if c == nil {
+		return ""
+	}
+	return strings.TrimPrefix(iriString(c), "sha1:")
+}
@@ -4,4 +4,18 @@ import "github.com/cayleygraph/cayley/quad"
 
 const (
 	predEnryLang = quad.IRI("enry:language")
+
+	// predAnalysisPath links a repository to path nodes created by analyses (e.g. co-change analysis)
+	predAnalysisPath = quad.IRI("analysis:path")
+	// predCoChanged links path nodes which often change together
+	predCoChanged = quad.IRI("analysis:coChanged")
+	// predCoChange links a repository to nodes describing co-change pairs of paths
+	predCoChange = quad.IRI("analysis:coChange")
+	// predCoChangeFrom and predCoChangeTo link a co-change pair to its path nodes
+	predCoChangeFrom = quad.IRI("analysis:from")
+	predCoChangeTo   = quad.IRI("analysis:to")
+	// predCoChangeCommits, predCoChangeSupport and predCoChangeConfidence are statistics of a co-change pair
+	predCoChangeCommits    = quad.IRI("analysis:commits")
+	predCoChangeSupport    = quad.IRI("analysis:support")
+	predCoChangeConfidence = quad.IRI("analysis:confidence")
 )
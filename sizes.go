@@ -0,0 +1,240 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/cayleygraph/cayley/quad"
+)
+
+// DefaultOutlierPercentile is the default percentile of commit sizes above which commits are flagged as outliers.
+const DefaultOutlierPercentile = 99
+
+type (
+	// SizeDistribution is a distribution of commit sizes (the number of touched files) of a repository or of an author in it
+	SizeDistribution struct {
+		Repo       string  `json:"repo"`             // repository ID
+		Author     string  `json:"author,omitempty"` // name <email>; empty for all commits of the repository
+		NumCommits int     `json:"commits"`
+		Mean       float64 `json:"mean"`
+		P50        int     `json:"p50"`
+		P75        int     `json:"p75"`
+		P90        int     `json:"p90"`
+		P95        int     `json:"p95"`
+		P99        int     `json:"p99"`
+		Max        int     `json:"max"`
+	}
+
+	// SizeOutlier is a commit touching more files than the outlier limit of its repository
+	SizeOutlier struct {
+		CommitStats
+		Author string `json:"author,omitempty"` // name <email>
+		Limit  int    `json:"limit"`            // outlier limit of the repository
+	}
+
+	// CommitSizes is a report of commit sizes
+	CommitSizes struct {
+		Distributions []*SizeDistribution `json:"distributions"` // per repository, followed by its authors
+		Outliers      []*SizeOutlier      `json:"outliers"`      // the largest first in each repository
+	}
+
+	// CommitSizeOptions controls commit size reports
+	CommitSizeOptions struct {
+		StatsOptions // commits to analyze; Limit is the number of outliers per repository
+
+		Percentile float64 // flag commits larger than this percentile; DefaultOutlierPercentile if zero
+		MaxFiles   int     // flag commits touching more files instead of using the percentile (if set)
+	}
+)
+
+// percentile returns a p-th percentile (nearest rank) of sorted values.
+func percentile(sorted []int, p float64) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	} else if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// sizeDistribution computes a distribution of commit sizes.
+func sizeDistribution(repo, author string, sizes []int) *SizeDistribution {
+	sort.Ints(sizes)
+	d := &SizeDistribution{
+		Repo:       repo,
+		Author:     author,
+		NumCommits: len(sizes),
+		P50:        percentile(sizes, 50),
+		P75:        percentile(sizes, 75),
+		P90:        percentile(sizes, 90),
+		P95:        percentile(sizes, 95),
+		P99:        percentile(sizes, 99),
+		Max:        percentile(sizes, 100),
+	}
+	sum := 0
+	for _, n := range sizes {
+		sum += n
+	}
+	if len(sizes) > 0 {
+		d.Mean = float64(sum) / float64(len(sizes))
+	}
+	return d
+}
+
+// CommitSizes returns distributions of commit sizes (see CommitStats.Touched) in all repositories (or in opts.Repo),
+// for each repository and each author in it, and commits which are larger than the given percentile or opts.MaxFiles.
+// Merge commits are skipped if opts.NoMerge is set.
+func (g *Graph) CommitSizes(ctx context.Context, opts *CommitSizeOptions) (*CommitSizes, error) {
+	if opts == nil {
+		opts = &CommitSizeOptions{}
+	}
+	pct := opts.Percentile
+	if pct == 0 {
+		pct = DefaultOutlierPercentile
+	} else if pct < 0 || pct > 100 {
+		return nil, fmt.Errorf("invalid percentile: %v", pct)
+	}
+	repos, err := g.selectRepos(ctx, &opts.StatsOptions)
+	if err != nil {
+		return nil, err
+	}
+	h, err := g.loadHistory(ctx, &opts.StatsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[quad.Value]string)
+	authorName := func(c quad.Value) string {
+		a, ok := h.authors[c]
+		if !ok {
+			return ""
+		}
+		name, ok := names[a]
+		if !ok {
+			name = g.authorString(ctx, a)
+			names[a] = name
+		}
+		return name
+	}
+
+	res := &CommitSizes{
+		Distributions: make([]*SizeDistribution, 0),
+		Outliers:      make([]*SizeOutlier, 0),
+	}
+	for _, repo := range repos {
+		commits := h.commits(repo, &opts.StatsOptions)
+		var sizes []int
+		byAuthor := make(map[string][]int)
+		for _, c := range commits {
+			n := h.commitStats(c).Touched()
+			sizes = append(sizes, n)
+			if author := authorName(c); author != "" {
+				byAuthor[author] = append(byAuthor[author], n)
+			}
+		}
+		d := sizeDistribution(iriString(repo), "", sizes)
+		res.Distributions = append(res.Distributions, d)
+
+		authors := make([]string, 0, len(byAuthor))
+		for a := range byAuthor {
+			authors = append(authors, a)
+		}
+		sort.Strings(authors)
+		for _, a := range authors {
+			res.Distributions = append(res.Distributions, sizeDistribution(d.Repo, a, byAuthor[a]))
+		}
+
+		limit := opts.MaxFiles
+		if limit <= 0 {
+			limit = percentile(sizes, pct)
+		}
+		var outliers []*SizeOutlier
+		for _, c := range commits {
+			cs := h.commitStats(c)
+			if cs.Touched() <= limit {
+				continue
+			}
+			o := &SizeOutlier{CommitStats: *cs, Author: authorName(c), Limit: limit}
+			o.Repo = d.Repo
+			outliers = append(outliers, o)
+		}
+		sort.SliceStable(outliers, func(i, j int) bool {
+			return outliers[i].Touched() > outliers[j].Touched()
+		})
+		if opts.Limit > 0 && len(outliers) > opts.Limit {
+			outliers = outliers[:opts.Limit]
+		}
+		res.Outliers = append(res.Outliers, outliers...)
+	}
+	return res, nil
+}
+
+// PrintCommitSizes writes a commit size report to w in a given format: text (default), json or csv.
+// CSV output has a row per distribution followed by a row per outlier commit, distinguished by the kind column.
+func (g *Graph) PrintCommitSizes(ctx context.Context, w io.Writer, format string, opts *CommitSizeOptions) error {
+	res, err := g.CommitSizes(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"kind", "repo", "author", "commits", "mean", "p50", "p75", "p90", "p95", "p99", "max", "hash", "touched", "limit"}
+	return writeReport(w, format, res, header, func() [][]string {
+		return commitSizeRows(res)
+	}, func(w io.Writer) {
+		printCommitSizes(w, res)
+	})
+}
+
+func printCommitSizes(w io.Writer, res *CommitSizes) {
+	outliers := make(map[string][]*SizeOutlier)
+	for _, o := range res.Outliers {
+		outliers[o.Repo] = append(outliers[o.Repo], o)
+	}
+	for i, d := range res.Distributions {
+		if d.Author == "" {
+			fmt.Fprintf(w, "\n%s\n--\n", quad.IRI(d.Repo).String())
+		}
+		name := d.Author
+		if name == "" {
+			name = "all"
+		}
+		fmt.Fprintf(w, "%s: %d commits, mean %.1f, p50 %d, p75 %d, p90 %d, p95 %d, p99 %d, max %d\n",
+			name, d.NumCommits, d.Mean, d.P50, d.P75, d.P90, d.P95, d.P99, d.Max)
+
+		if i == len(res.Distributions)-1 || res.Distributions[i+1].Author == "" {
+			// the end of the repository
+			for _, o := range outliers[d.Repo] {
+				fmt.Fprintf(w, "--\ncommit: %s (outlier)\n", quad.IRI("sha1:"+o.Hash).String())
+				fmt.Fprintf(w, "%s\n%d touched (+, -, #), limit %d\n", o.Author, o.Touched(), o.Limit)
+			}
+		}
+	}
+}
+
+func commitSizeRows(res *CommitSizes) [][]string {
+	rows := make([][]string, 0, len(res.Distributions)+len(res.Outliers))
+	for _, d := range res.Distributions {
+		rows = append(rows, []string{
+			"distribution", d.Repo, d.Author,
+			strconv.Itoa(d.NumCommits), strconv.FormatFloat(d.Mean, 'f', -1, 64),
+			strconv.Itoa(d.P50), strconv.Itoa(d.P75), strconv.Itoa(d.P90), strconv.Itoa(d.P95), strconv.Itoa(d.P99), strconv.Itoa(d.Max),
+			"", "", "",
+		})
+	}
+	for _, o := range res.Outliers {
+		rows = append(rows, []string{
+			"outlier", o.Repo, o.Author,
+			"", "", "", "", "", "", "", "",
+			o.Hash, strconv.Itoa(o.Touched()), strconv.Itoa(o.Limit),
+		})
+	}
+	return rows
+}
@@ -1,29 +1,47 @@
 package codegraph
 
 import (
+	"bufio"
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"github.com/mloncode/codegraph/git"
+	"io"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cayleygraph/cayley"
 	"github.com/cayleygraph/cayley/graph"
-	"github.com/cayleygraph/cayley/graph/path"
 	"github.com/cayleygraph/cayley/quad"
 )
 
 type (
 	// CommitStats contains commit statistics
 	CommitStats struct {
-		Hash       string // commit hash
-		Label      string // metadata
-		NumParents int    // number of parents
+		Repo       string `json:"repo"`    // repository ID
+		Hash       string `json:"hash"`    // commit hash
+		Label      string `json:"label"`   // metadata
+		NumParents int    `json:"parents"` // number of parents
 
-		NumFiles    int // number of files
-		NumAdded    int // number of added files by this commit
-		NumRemoved  int // number of removed files by this commit
-		NumModified int // number of modified files by this commit
+		AuthoredAt  time.Time `json:"authored_at"`  // author time
+		CommittedAt time.Time `json:"committed_at"` // committer time
+
+		NumFiles    int `json:"files"`    // number of files
+		NumAdded    int `json:"added"`    // number of added files by this commit
+		NumRemoved  int `json:"removed"`  // number of removed files by this commit
+		NumModified int `json:"modified"` // number of modified files by this commit
+	}
+
+	// StatsOptions controls which commits are included in statistics
+	StatsOptions struct {
+		Repo    string    // repository ID or URL (all repositories if empty)
+		Limit   int       // top commits per repository (0 means no limit)
+		NoMerge bool      // skip merge commits
+		Since   time.Time // skip commits committed before this time (if set)
+		Until   time.Time // skip commits committed at or after this time (if set); see ParseUntil
 	}
 
 	// SortBy is a function to sort commit statistics
@@ -33,96 +51,332 @@ type (
 		stats []*CommitStats
 		by    SortBy
 	}
+
+	repoStatsResult struct {
+		Repo    string         `json:"repo"`
+		Commits []*CommitStats `json:"commits"`
+	}
+)
+
+// Output formats of statistics
+const (
+	FormatText = "text"
+	FormatJSON = "json"
 )
 
-// PrintStats prints commit statistics
-func (g *Graph) PrintStats(ctx context.Context, limit int, by SortBy, nomerge bool) error {
+// StatsFormats lists output formats of statistics.
+var StatsFormats = []string{FormatText, FormatJSON, FormatCSV}
+
+// Touched returns the number of files added, removed or modified by the commit.
+func (cs *CommitStats) Touched() int {
+	return cs.NumAdded + cs.NumRemoved + cs.NumModified
+}
+
+// CommitStats returns commit statistics of all repositories (or of opts.Repo), grouped by repository.
+// Statistics of each repository are sorted, filtered and limited according to the options.
+func (g *Graph) CommitStats(ctx context.Context, by SortBy, opts *StatsOptions) ([]*CommitStats, error) {
+	res, err := g.statsByRepo(ctx, by, opts)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]*CommitStats, 0)
+	for _, r := range res {
+		stats = append(stats, r.Commits...)
+	}
+	return stats, nil
+}
+
+// PrintStats writes commit statistics to w in a given format: text (default), json or csv.
+func (g *Graph) PrintStats(ctx context.Context, w io.Writer, format string, by SortBy, opts *StatsOptions) error {
+	res, err := g.statsByRepo(ctx, by, opts)
+	if err != nil {
+		return err
+	}
+
+	stats := make([]*CommitStats, 0)
+	for _, r := range res {
+		stats = append(stats, r.Commits...)
+	}
+	header := []string{"repo", "hash", "parents", "authored_at", "committed_at", "files", "touched", "added", "removed", "modified", "label"}
+	return writeReport(w, format, stats, header, func() [][]string {
+		return statsRows(stats)
+	}, func(w io.Writer) {
+		printStats(w, res)
+	})
+}
+
+// writeReport writes a report to w in a given format: text (default) written by the text function,
+// json encoded from v, or csv with a header and rows returned by the rows function.
+func writeReport(w io.Writer, format string, v interface{}, header []string, rows func() [][]string, text func(w io.Writer)) error {
+	switch format {
+	case "", FormatText:
+		bw := bufio.NewWriter(w)
+		text(bw)
+		return bw.Flush()
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+		for _, row := range rows() {
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return fmt.Errorf("unsupported format: %q", format)
+}
+
+// statsByRepo returns commit statistics of all repositories (or of opts.Repo).
+func (g *Graph) statsByRepo(ctx context.Context, by SortBy, opts *StatsOptions) ([]repoStatsResult, error) {
+	if opts == nil {
+		opts = &StatsOptions{}
+	}
+	repos, err := g.selectRepos(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := g.commitStatsOf(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]repoStatsResult, 0, len(repos))
+	for _, repo := range repos {
+		stats, err := repoStats(ctx, g.store, repo, idx, by, opts)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, repoStatsResult{Repo: iriString(repo), Commits: stats})
+	}
+	return res, nil
+}
+
+// repos returns all repositories in the graph.
+func (g *Graph) repos(ctx context.Context) ([]quad.Value, error) {
 	it, _ := cayley.StartPath(g.store, git.TypeRepo).In(git.PredType).BuildIterator().Optimize()
 	it, _ = g.store.OptimizeIterator(it)
 	defer it.Close()
 
+	var repos []quad.Value
 	for it.Next(ctx) {
-		repo := g.store.NameOf(it.Result())
-		if err := printStats(ctx, g.store, repo, limit, by, nomerge); err != nil {
-			return err
+		repos = append(repos, g.store.NameOf(it.Result()))
+	}
+	return repos, it.Err()
+}
+
+func printStats(w io.Writer, res []repoStatsResult) {
+	for _, r := range res {
+		fmt.Fprintf(w, "\n%s\n", quad.IRI(r.Repo).String())
+		for _, s := range r.Commits {
+			fmt.Fprintf(w, "--\ncommit: %s", quad.IRI("sha1:"+s.Hash).String())
+			if s.NumParents > 1 {
+				fmt.Fprint(w, " (merge)")
+			}
+			fmt.Fprintf(w, "\n%s\n", strings.ReplaceAll(quad.String(s.Label).String(), `\n`, "\n"))
+
+			fmt.Fprintf(w, "%d files, %d touched (+, -, #), %d added(+), %d removed(-), %d modified(#)\n", s.NumFiles, s.Touched(), s.NumAdded, s.NumRemoved, s.NumModified)
 		}
 	}
+}
+
+func statsRows(stats []*CommitStats) [][]string {
+	rows := make([][]string, 0, len(stats))
+	for _, s := range stats {
+		rows = append(rows, []string{
+			s.Repo, s.Hash, strconv.Itoa(s.NumParents),
+			formatTime(s.AuthoredAt), formatTime(s.CommittedAt),
+			strconv.Itoa(s.NumFiles), strconv.Itoa(s.Touched()),
+			strconv.Itoa(s.NumAdded), strconv.Itoa(s.NumRemoved), strconv.Itoa(s.NumModified),
+			s.Label,
+		})
+	}
+	return rows
+}
 
-	return nil
+// formatTime formats a time in RFC 3339 format, or returns an empty string for a zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
 }
 
-func printStats(ctx context.Context, qs graph.QuadStore, repo quad.Value, limit int, by SortBy, nomerge bool) error {
+// repoStats returns statistics of commits in a repository, filtered, sorted and limited according to the options.
+// Statistics of commits are taken from idx (see commitStatsOf).
+func repoStats(ctx context.Context, qs graph.QuadStore, repo quad.Value, idx map[quad.Value]*CommitStats, by SortBy, opts *StatsOptions) ([]*CommitStats, error) {
 	var stats []*CommitStats
 
 	it, _ := cayley.StartPath(qs, repo).Out(git.PredCommit).BuildIterator().Optimize()
 	it, _ = qs.OptimizeIterator(it)
 	for it.Next(ctx) {
 		commit := qs.NameOf(it.Result())
-		stats = append(stats, commitStats(ctx, qs, commit))
+		cs := CommitStats{Hash: commitHash(commit)}
+		if s, ok := idx[commit]; ok {
+			cs = *s // a commit may belong to several repositories
+		}
+		if !opts.inRange(cs.CommittedAt) {
+			continue
+		}
+		cs.Repo = iriString(repo)
+		stats = append(stats, &cs)
+	}
+	if err := it.Close(); err != nil {
+		return nil, err
 	}
 
-	fmt.Printf("\n%s\n", repo.String())
-	by.Sort(stats)
-	n := 0
+	if by != nil {
+		by.Sort(stats)
+	}
+	out := make([]*CommitStats, 0, len(stats))
 	for _, s := range stats {
-		if limit > 0 && n >= limit {
+		if opts.Limit > 0 && len(out) >= opts.Limit {
 			break
 		}
-		if s.NumParents > 1 && nomerge {
+		if s.NumParents > 1 && opts.NoMerge {
 			continue
 		}
+		out = append(out, s)
+	}
+	return out, nil
+}
 
-		fmt.Printf("--\ncommit: %s", s.Hash)
-		if s.NumParents > 1 {
-			fmt.Print(" (merge)")
-		}
-		fmt.Printf("\n%s\n", strings.ReplaceAll(s.Label, `\n`, "\n"))
+// commitStatsOf returns statistics of commits of opts.Repo, computed commit by commit,
+// or statistics of all commits in the graph if opts.Repo is not set (see commitStatsIndex).
+func (g *Graph) commitStatsOf(ctx context.Context, opts *StatsOptions) (map[quad.Value]*CommitStats, error) {
+	repo, err := g.repoFilter(ctx, opts)
+	if err != nil {
+		return nil, err
+	} else if repo == nil {
+		return commitStatsIndex(ctx, g.store)
+	}
+	idx := make(map[quad.Value]*CommitStats)
+	for _, c := range outValues(ctx, g.store, repo, git.PredCommit) {
+		idx[c] = commitStats(ctx, g.store, c)
+	}
+	return idx, nil
+}
 
-		touch := s.NumAdded + s.NumRemoved + s.NumModified
-		fmt.Printf("%d files, %d touched (+, -, #), %d added(+), %d removed(-), %d modified(#)\n", s.NumFiles, touch, s.NumAdded, s.NumRemoved, s.NumModified)
-		n++
+// commitStatsIndex computes statistics of all commits in the graph with a few bulk scans over predicates.
+// It is much faster than calling commitStats for every commit, but always reads the whole graph.
+func commitStatsIndex(ctx context.Context, qs graph.QuadStore) (map[quad.Value]*CommitStats, error) {
+	idx := make(map[quad.Value]*CommitStats)
+	get := func(commit quad.Value) *CommitStats {
+		cs, ok := idx[commit]
+		if !ok {
+			cs = &CommitStats{Hash: commitHash(commit)}
+			idx[commit] = cs
+		}
+		return cs
 	}
 
-	return it.Close()
+	err := scanPredicates(ctx, qs, []predicateScan{
+		{git.PredMetadata, func(q quad.Quad) { setLabel(get(q.Subject), q.Object) }},
+		{git.PredAuthoredAt, func(q quad.Quad) { setTime(&get(q.Subject).AuthoredAt, q.Object) }},
+		{git.PredCommittedAt, func(q quad.Quad) { setTime(&get(q.Subject).CommittedAt, q.Object) }},
+		{git.PredParent, func(q quad.Quad) { get(q.Subject).NumParents++ }},
+		{git.PredFile, func(q quad.Quad) { get(q.Subject).NumFiles++ }},
+		// changes link files to commits
+		{git.PredAdd, func(q quad.Quad) { get(q.Object).NumAdded++ }},
+		{git.PredRemove, func(q quad.Quad) { get(q.Object).NumRemoved++ }},
+		{git.PredModify, func(q quad.Quad) { get(q.Object).NumModified++ }},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
 }
 
+// commitStats computes statistics of a single commit from quads linked to it.
 func commitStats(ctx context.Context, qs graph.QuadStore, commit quad.Value) *CommitStats {
-	cs := &CommitStats{
-		Hash: commit.String(),
-	}
+	out := linkedQuads(ctx, qs, quad.Subject, commit, "")
+	in := linkedQuads(ctx, qs, quad.Object, commit, "")
+	return commitStatsFromQuads(commit, out, in)
+}
 
-	it, _ := path.StartPath(qs, commit).Out(git.PredMetadata).BuildIterator().Optimize()
-	it, _ = qs.OptimizeIterator(it)
-	if it.Next(ctx) {
-		if lbl := qs.NameOf(it.Result()); lbl != nil {
-			cs.Label = lbl.String()
+// commitStatsFromQuads computes statistics of a commit from quads starting from it (out) and pointing to it (in).
+func commitStatsFromQuads(commit quad.Value, out, in []quad.Quad) *CommitStats {
+	cs := &CommitStats{Hash: commitHash(commit)}
+	for _, q := range out {
+		switch q.Predicate {
+		case git.PredMetadata:
+			setLabel(cs, q.Object)
+		case git.PredAuthoredAt:
+			setTime(&cs.AuthoredAt, q.Object)
+		case git.PredCommittedAt:
+			setTime(&cs.CommittedAt, q.Object)
+		case git.PredParent:
+			cs.NumParents++
+		case git.PredFile:
+			cs.NumFiles++
+		}
+	}
+	// changes link files to commits
+	for _, q := range in {
+		switch q.Predicate {
+		case git.PredAdd:
+			cs.NumAdded++
+		case git.PredRemove:
+			cs.NumRemoved++
+		case git.PredModify:
+			cs.NumModified++
 		}
 	}
-	it.Close()
-
-	path := cayley.StartPath(qs, commit)
-	cs.NumParents = countPaths(ctx, qs, path.Out(git.PredParent))
-	cs.NumFiles = countPaths(ctx, qs, path.Out(git.PredFile))
-	cs.NumAdded = countPaths(ctx, qs, path.In(git.PredAdd))
-	cs.NumRemoved = countPaths(ctx, qs, path.In(git.PredRemove))
-	cs.NumModified = countPaths(ctx, qs, path.In(git.PredModify))
 	return cs
 }
 
-func countPaths(ctx context.Context, qs graph.QuadStore, path *path.Path) int {
-	n := 0
+// setLabel sets the label of commit statistics to commit metadata, unless it is already set.
+func setLabel(cs *CommitStats, v quad.Value) {
+	if lbl, ok := v.(quad.String); ok && cs.Label == "" {
+		cs.Label = string(lbl)
+	}
+}
+
+// setTime sets t to a time literal, unless it is already set.
+func setTime(t *time.Time, v quad.Value) {
+	if qt, ok := v.(quad.Time); ok && t.IsZero() {
+		*t = time.Time(qt)
+	}
+}
 
-	it, _ := path.BuildIterator().Optimize()
+// timeOf returns the first time literal linked from node via pred,
+// or a zero time if there is none.
+func timeOf(ctx context.Context, qs graph.QuadStore, node quad.Value, pred quad.IRI) time.Time {
+	it, _ := cayley.StartPath(qs, node).Out(pred).BuildIterator().Optimize()
 	it, _ = qs.OptimizeIterator(it)
-	for it.Next(ctx) {
-		n++
-		for it.NextPath(ctx) {
-			n++
+	defer it.Close()
+
+	if it.Next(ctx) {
+		if t, ok := qs.NameOf(it.Result()).(quad.Time); ok {
+			return time.Time(t)
 		}
 	}
-	it.Close()
+	return time.Time{}
+}
 
-	return n
+// inRange reports whether t falls within [Since, Until).
+// Commits without a time are kept only when no range is set.
+func (opts *StatsOptions) inRange(t time.Time) bool {
+	if opts.Since.IsZero() && opts.Until.IsZero() {
+		return true
+	}
+	if t.IsZero() {
+		return false
+	}
+	if !opts.Since.IsZero() && t.Before(opts.Since) {
+		return false
+	}
+	if !opts.Until.IsZero() && !t.Before(opts.Until) {
+		return false
+	}
+	return true
 }
 
 // Len is part of sort.Interface.
@@ -140,6 +394,104 @@ func (cs *commitStatsSorter) Less(i, j int) bool {
 	return cs.by(cs.stats[i], cs.stats[j])
 }
 
+// SortByName returns a function to sort commit statistics by a given field: add, remove, modify, touch or file.
+func SortByName(name string) (SortBy, error) {
+	switch strings.ToLower(name) {
+	case "add":
+		return func(cs1, cs2 *CommitStats) bool {
+			return cs1.NumAdded > cs2.NumAdded
+		}, nil
+
+	case "remove":
+		return func(cs1, cs2 *CommitStats) bool {
+			return cs1.NumRemoved > cs2.NumRemoved
+		}, nil
+
+	case "modify":
+		return func(cs1, cs2 *CommitStats) bool {
+			return cs1.NumModified > cs2.NumModified
+		}, nil
+
+	case "file":
+		return func(cs1, cs2 *CommitStats) bool {
+			return cs1.NumFiles > cs2.NumFiles
+		}, nil
+
+	case "touch":
+		return func(cs1, cs2 *CommitStats) bool {
+			n1 := cs1.NumAdded + cs1.NumRemoved + cs1.NumModified
+			n2 := cs2.NumAdded + cs2.NumRemoved + cs2.NumModified
+			return n1 > n2
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid sort: %q", name)
+}
+
+// timeLayouts lists formats accepted by ParseTime and ParseUntil with the precision of each one.
+// The precision of times with fractional seconds is given by the number of digits (see fractionPrecision).
+var timeLayouts = []struct {
+	layout    string
+	precision time.Duration
+}{
+	{time.RFC3339Nano, time.Second},
+	{"2006-01-02T15:04:05.999999999", time.Second},
+	{"2006-01-02", 24 * time.Hour},
+}
+
+// ParseTime parses a date in RFC 3339 (with optional fractional seconds) or "YYYY-MM-DD" format (in local time).
+// An empty string results in a zero time.
+func ParseTime(s string) (time.Time, error) {
+	t, _, err := parseTime(s)
+	return t, err
+}
+
+// ParseUntil parses an upper bound of a time range in the same formats as ParseTime.
+// The bound is exclusive, so it points to the end of a given date, second or fraction of a second,
+// e.g. "2020-01-31" results in the midnight of February 1 and "10:00:00.25" in 10:00:00.26.
+func ParseUntil(s string) (time.Time, error) {
+	t, precision, err := parseTime(s)
+	if err != nil || t.IsZero() {
+		return t, err
+	}
+	if precision == 24*time.Hour {
+		// the day may be longer or shorter than 24h because of DST
+		return t.AddDate(0, 0, 1), nil
+	}
+	return t.Add(precision), nil
+}
+
+func parseTime(s string) (time.Time, time.Duration, error) {
+	if s == "" {
+		return time.Time{}, 0, nil
+	}
+	for _, l := range timeLayouts {
+		if t, err := time.ParseInLocation(l.layout, s, time.Local); err == nil {
+			if l.precision == time.Second {
+				return t, fractionPrecision(s), nil
+			}
+			return t, l.precision, nil
+		}
+	}
+	return time.Time{}, 0, fmt.Errorf("invalid date: %q", s)
+}
+
+// fractionPrecision returns the precision of a time in RFC 3339 format given by its fractional seconds,
+// e.g. a millisecond for "10:00:00.250". Times without fractional seconds have a precision of a second.
+func fractionPrecision(s string) time.Duration {
+	i := strings.IndexByte(s, '.')
+	if i < 0 {
+		return time.Second
+	}
+	precision := time.Second
+	for _, r := range s[i+1:] {
+		if r < '0' || r > '9' || precision == time.Nanosecond {
+			break
+		}
+		precision /= 10
+	}
+	return precision
+}
+
 //Sort sorts
 func (by SortBy) Sort(stats []*CommitStats) {
 	sort.Sort(&commitStatsSorter{
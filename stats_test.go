@@ -0,0 +1,366 @@
+package codegraph
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cayleygraph/cayley"
+	"github.com/cayleygraph/cayley/graph"
+	"github.com/cayleygraph/cayley/graph/path"
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+// baselineCommitStats computes statistics of a commit with path iterations, the way they were computed
+// before bulk scans (see commitStatsIndex). It is kept as a reference for the output of the faster implementations.
+func baselineCommitStats(ctx context.Context, qs graph.QuadStore, commit quad.Value) *CommitStats {
+	cs := &CommitStats{
+		Hash: commitHash(commit),
+	}
+
+	it, _ := path.StartPath(qs, commit).Out(git.PredMetadata).BuildIterator().Optimize()
+	it, _ = qs.OptimizeIterator(it)
+	if it.Next(ctx) {
+		if lbl, ok := qs.NameOf(it.Result()).(quad.String); ok {
+			cs.Label = string(lbl)
+		}
+	}
+	it.Close()
+
+	cs.AuthoredAt = baselineTimeOf(ctx, qs, commit, git.PredAuthoredAt)
+	cs.CommittedAt = baselineTimeOf(ctx, qs, commit, git.PredCommittedAt)
+
+	p := cayley.StartPath(qs, commit)
+	cs.NumParents = countPaths(ctx, qs, p.Out(git.PredParent))
+	cs.NumFiles = countPaths(ctx, qs, p.Out(git.PredFile))
+	cs.NumAdded = countPaths(ctx, qs, p.In(git.PredAdd))
+	cs.NumRemoved = countPaths(ctx, qs, p.In(git.PredRemove))
+	cs.NumModified = countPaths(ctx, qs, p.In(git.PredModify))
+	return cs
+}
+
+func baselineTimeOf(ctx context.Context, qs graph.QuadStore, node quad.Value, pred quad.IRI) time.Time {
+	it, _ := cayley.StartPath(qs, node).Out(pred).BuildIterator().Optimize()
+	it, _ = qs.OptimizeIterator(it)
+	defer it.Close()
+
+	if it.Next(ctx) {
+		if t, ok := qs.NameOf(it.Result()).(quad.Time); ok {
+			return time.Time(t)
+		}
+	}
+	return time.Time{}
+}
+
+func countPaths(ctx context.Context, qs graph.QuadStore, path *path.Path) int {
+	n := 0
+
+	it, _ := path.BuildIterator().Optimize()
+	it, _ = qs.OptimizeIterator(it)
+	for it.Next(ctx) {
+		n++
+		for it.NextPath(ctx) {
+			n++
+		}
+	}
+	it.Close()
+
+	return n
+}
+
+// testHistory returns quads of a synthetic history: repositories with a linear history of a given number of commits,
+// which share the first commit (like forks). Every commit adds a file and modifies the previous one,
+// every fifth commit removes a file, and every tenth commit is a merge with the commit before its parent.
+func testHistory(repos, commits int) []quad.Quad {
+	var (
+		quads []quad.Quad
+		n     int
+		start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	add := func(s quad.Value, p quad.IRI, o, label quad.Value) {
+		quads = append(quads, quad.Quad{Subject: s, Predicate: p, Object: o, Label: label})
+	}
+	hash := func() quad.IRI {
+		n++
+		return quad.IRI(fmt.Sprintf("sha1:%040x", n))
+	}
+	authors := []quad.Value{quad.BNode("alice"), quad.BNode("bob")}
+	for _, a := range authors {
+		add(a, git.PredType, git.TypeAuthor, nil)
+		add(a, git.PredName, quad.String(a.(quad.BNode)), nil)
+	}
+
+	root := hash()
+	for r := 0; r < repos; r++ {
+		repo := quad.IRI(fmt.Sprintf("file:///repo%d", r))
+		add(repo, git.PredType, git.TypeRepo, nil)
+
+		tree := make(map[string]quad.Value)
+		var history []quad.Value
+		for i := 0; i < commits; i++ {
+			c := root
+			if i > 0 {
+				c = hash()
+			}
+			add(repo, git.PredCommit, c, nil)
+			history = append(history, c)
+			if i == 0 && r > 0 {
+				// the shared commit is already written
+				continue
+			}
+
+			t := start.Add(time.Duration(r*commits+i) * time.Hour)
+			add(c, git.PredType, git.TypeCommit, nil)
+			add(c, git.PredMetadata, quad.String(fmt.Sprintf("commit %d of repo %d", i, r)), nil)
+			add(c, git.PredAuthor, authors[i%len(authors)], nil)
+			add(c, git.PredCommiter, authors[0], nil)
+			add(c, git.PredAuthoredAt, quad.Time(t.Add(-time.Minute)), nil)
+			add(c, git.PredCommittedAt, quad.Time(t), nil)
+			if i > 0 {
+				parents := []quad.Value{history[i-1]}
+				if i%10 == 0 && i >= 2 {
+					parents = append(parents, history[i-2])
+				}
+				for _, p := range parents {
+					add(c, git.PredParent, p, nil)
+					add(p, git.PredChild, c, nil)
+				}
+			}
+
+			path := fmt.Sprintf("dir%d/file%d.go", i%3, i)
+			tree[path] = hash()
+			add(tree[path], git.PredAdd, c, quad.String(path))
+			if i > 0 {
+				prev := fmt.Sprintf("dir%d/file%d.go", (i-1)%3, i-1)
+				if _, ok := tree[prev]; ok {
+					tree[prev] = hash()
+					add(tree[prev], git.PredModify, c, quad.String(prev))
+				}
+			}
+			if i%5 == 4 {
+				old := fmt.Sprintf("dir%d/file%d.go", (i-4)%3, i-4)
+				add(tree[old], git.PredRemove, c, quad.String(old))
+				delete(tree, old)
+			}
+			for p, f := range tree {
+				add(c, git.PredFile, f, quad.String(p))
+			}
+		}
+	}
+	return quads
+}
+
+// newTestHistoryGraph returns an in-memory graph with a synthetic history (see testHistory).
+func newTestHistoryGraph(t testing.TB, repos, commits int) *Graph {
+	g, err := Open("", &Options{Backend: BackendMemory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.store.AddQuadSet(testHistory(repos, commits)); err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+// sortStats sorts commit statistics by repository and hash.
+func sortStats(stats []*CommitStats) {
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Repo != stats[j].Repo {
+			return stats[i].Repo < stats[j].Repo
+		}
+		return stats[i].Hash < stats[j].Hash
+	})
+}
+
+func TestCommitStatsIndex(t *testing.T) {
+	g := newTestHistoryGraph(t, 3, 30)
+	defer g.Close()
+	ctx := context.Background()
+
+	idx, err := commitStatsIndex(ctx, g.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repos, err := g.repos(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, repo := range repos {
+		for _, c := range outValues(ctx, g.store, repo, git.PredCommit) {
+			n++
+			exp := baselineCommitStats(ctx, g.store, c)
+			if !reflect.DeepEqual(idx[c], exp) {
+				t.Errorf("%v: bulk scans:\n%+v\nexpected:\n%+v", c, idx[c], exp)
+			}
+			if cs := commitStats(ctx, g.store, c); !reflect.DeepEqual(cs, exp) {
+				t.Errorf("%v: per-commit:\n%+v\nexpected:\n%+v", c, cs, exp)
+			}
+		}
+	}
+	if n != 3*30 {
+		t.Fatalf("unexpected number of commits: %d", n)
+	}
+}
+
+func TestStatsByRepo(t *testing.T) {
+	g := newTestHistoryGraph(t, 3, 30)
+	defer g.Close()
+	ctx := context.Background()
+
+	for _, opts := range []StatsOptions{
+		{},
+		{NoMerge: true},
+		{Since: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Until: time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)},
+	} {
+		// all repositories are computed with bulk scans
+		all, err := g.statsByRepo(ctx, nil, &opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all) != 3 {
+			t.Fatalf("unexpected number of repositories: %d", len(all))
+		}
+		for _, r := range all {
+			for _, cs := range r.Commits {
+				exp := baselineCommitStats(ctx, g.store, quad.IRI("sha1:"+cs.Hash))
+				exp.Repo = r.Repo
+				if !reflect.DeepEqual(cs, exp) {
+					t.Errorf("%s (%+v): bulk scans:\n%+v\nexpected:\n%+v", r.Repo, opts, cs, exp)
+				}
+			}
+			// a single repository is computed commit by commit
+			opts := opts
+			opts.Repo = r.Repo
+			res, err := g.statsByRepo(ctx, nil, &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res) != 1 {
+				t.Fatalf("unexpected number of repositories: %d", len(res))
+			}
+			exp := r.Commits
+			sortStats(exp)
+			sortStats(res[0].Commits)
+			if !reflect.DeepEqual(res[0].Commits, exp) {
+				t.Errorf("%s (%+v): per-commit statistics differ from bulk scans", r.Repo, opts)
+			}
+		}
+	}
+}
+
+func TestLoadHistory(t *testing.T) {
+	g := newTestHistoryGraph(t, 3, 30)
+	defer g.Close()
+	ctx := context.Background()
+
+	all, err := g.loadAllHistory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortChanges := func(changes []change) []change {
+		out := append([]change{}, changes...)
+		sort.Slice(out, func(i, j int) bool {
+			if out[i].Path != out[j].Path {
+				return out[i].Path < out[j].Path
+			}
+			return out[i].Kind < out[j].Kind
+		})
+		return out
+	}
+	repos, err := g.repos(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, repo := range repos {
+		h := g.loadRepoHistory(ctx, repo)
+		commits := h.commits(repo, &StatsOptions{})
+		if exp := all.commits(repo, &StatsOptions{}); !reflect.DeepEqual(commits, exp) {
+			t.Fatalf("%v: commits:\n%v\nexpected:\n%v", repo, commits, exp)
+		}
+		for _, c := range commits {
+			if cs, exp := h.commitStats(c), all.commitStats(c); !reflect.DeepEqual(cs, exp) {
+				t.Errorf("%v: statistics:\n%+v\nexpected:\n%+v", c, cs, exp)
+			}
+			if h.authors[c] != all.authors[c] || h.committers[c] != all.committers[c] {
+				t.Errorf("%v: author or committer differs", c)
+			}
+			if ch, exp := sortChanges(h.changes[c]), sortChanges(all.changes[c]); !reflect.DeepEqual(ch, exp) {
+				t.Errorf("%v: changes:\n%v\nexpected:\n%v", c, ch, exp)
+			}
+		}
+	}
+}
+
+func BenchmarkCommitStats(b *testing.B) {
+	g := newTestHistoryGraph(b, 10, 100)
+	defer g.Close()
+	ctx := context.Background()
+
+	repos, err := g.repos(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("bulk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := commitStatsIndex(ctx, g.store); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("baseline", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, repo := range repos {
+				for _, c := range outValues(ctx, g.store, repo, git.PredCommit) {
+					baselineCommitStats(ctx, g.store, c)
+				}
+			}
+		}
+	})
+	b.Run("per-commit", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, repo := range repos {
+				for _, c := range outValues(ctx, g.store, repo, git.PredCommit) {
+					commitStats(ctx, g.store, c)
+				}
+			}
+		}
+	})
+	b.Run("single-repo", func(b *testing.B) {
+		opts := &StatsOptions{Repo: iriString(repos[0])}
+		for i := 0; i < b.N; i++ {
+			if _, err := g.statsByRepo(ctx, nil, opts); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestParseUntil(t *testing.T) {
+	for _, c := range []struct {
+		in  string
+		exp time.Time
+	}{
+		{in: "", exp: time.Time{}},
+		{in: "2020-01-31", exp: time.Date(2020, 2, 1, 0, 0, 0, 0, time.Local)},
+		{in: "2020-01-31T10:00:00", exp: time.Date(2020, 1, 31, 10, 0, 1, 0, time.Local)},
+		{in: "2020-01-31T10:00:00Z", exp: time.Date(2020, 1, 31, 10, 0, 1, 0, time.UTC)},
+		{in: "2020-01-31T10:00:00.25Z", exp: time.Date(2020, 1, 31, 10, 0, 0, 260000000, time.UTC)},
+		{in: "2020-01-31T10:00:00.123456789Z", exp: time.Date(2020, 1, 31, 10, 0, 0, 123456790, time.UTC)},
+	} {
+		res, err := ParseUntil(c.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Equal(c.exp) {
+			t.Errorf("ParseUntil(%q): %v, expected: %v", c.in, res, c.exp)
+		}
+	}
+	if _, err := ParseUntil("31.01.2020"); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
@@ -0,0 +1,429 @@
+package codegraph
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+// FormatCSV exports node and edge tables as CSV files into a directory (see ExportTables).
+const FormatCSV = "csv"
+
+// TableSchemaFile is the name of a file with descriptions of all exported tables.
+const TableSchemaFile = "schema.json"
+
+// untypedTable is the name of a node table for nodes without rdf:type.
+const untypedTable = "node"
+
+// Column types of exported tables.
+const (
+	ColumnString = "string"
+	ColumnInt    = "int"
+	ColumnFloat  = "float"
+	ColumnBool   = "bool"
+	ColumnTime   = "time" // RFC 3339
+	ColumnID     = "id"   // node ID
+)
+
+// TableColumn describes a column of an exported table.
+type TableColumn struct {
+	Name  string `json:"name"`
+	Pred  string `json:"pred,omitempty"` // predicate of a node attribute
+	Type  string `json:"type"`
+	Multi bool   `json:"multi,omitempty"` // cells hold lists of values (see ExportTables)
+}
+
+// TableSchema describes an exported table.
+// Node tables have one row per node of a given type, with node attributes (literal objects) as columns.
+// Edge tables have one row per quad with a given predicate, subject type and object type.
+type TableSchema struct {
+	File    string        `json:"file"`
+	Kind    string        `json:"kind"` // "nodes" or "edges"
+	Type    string        `json:"type,omitempty"`
+	Pred    string        `json:"pred,omitempty"`
+	From    string        `json:"from,omitempty"`
+	To      string        `json:"to,omitempty"`
+	Rows    int           `json:"rows"`
+	Columns []TableColumn `json:"columns"`
+}
+
+type tableNode struct {
+	id    quad.Value
+	types []quad.IRI
+	attrs map[quad.IRI][]quad.Value
+}
+
+type edgeKey struct {
+	from, pred, to string
+}
+
+// ExportTables writes the graph as CSV tables into a directory: one node table per node type
+// (e.g. "git_Commit.nodes.csv") and one edge table per predicate, subject type and object type
+// (e.g. "git_Commit.git_parent.git_Commit.edges.csv"). Nodes without rdf:type are written to "node.nodes.csv".
+// Column types are derived from literal values and described in "schema.json";
+// if a node has multiple values of the same attribute, the column is marked as "multi" and its type becomes "string":
+// every cell of such column is a list of values joined with ";", where "\" and ";" inside values are escaped with "\".
+// Types and predicates which result in the same table or column name get a numeric suffix (see tableNames).
+// Tables are only written as CSV; there is no Parquet output.
+// It returns the number of exported quads.
+func (g *Graph) ExportTables(ctx context.Context, dir string) (int, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, err
+	}
+
+	it, _ := g.store.QuadsAllIterator().Optimize()
+	defer it.Close()
+
+	var (
+		nodes = make(map[quad.Value]*tableNode)
+		edges []quad.Quad
+		n     int
+	)
+	node := func(v quad.Value) *tableNode {
+		tn, ok := nodes[v]
+		if !ok {
+			tn = &tableNode{id: v, attrs: make(map[quad.IRI][]quad.Value)}
+			nodes[v] = tn
+		}
+		return tn
+	}
+	for it.Next(ctx) {
+		q := g.store.Quad(it.Result())
+		n++
+		s := node(q.Subject)
+		pred, _ := q.Predicate.(quad.IRI)
+		switch o := q.Object.(type) {
+		case quad.IRI:
+			if pred == git.PredType {
+				s.types = append(s.types, o)
+				continue
+			}
+			node(o)
+			edges = append(edges, q)
+		case quad.BNode:
+			node(o)
+			edges = append(edges, q)
+		default:
+			s.attrs[pred] = append(s.attrs[pred], o)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return n, err
+	}
+
+	var (
+		schema []*TableSchema
+		names  = tableNames(nodes, edges)
+		byType = make(map[string][]*tableNode)
+	)
+	for _, tn := range nodes {
+		typ := tn.typ(names)
+		byType[typ] = append(byType[typ], tn)
+	}
+	for _, typ := range sortedKeys(byType) {
+		t, err := writeNodeTable(dir, typ, byType[typ], names)
+		if err != nil {
+			return n, err
+		}
+		schema = append(schema, t)
+	}
+
+	byPred := make(map[edgeKey][]quad.Quad)
+	for _, q := range edges {
+		pred, _ := q.Predicate.(quad.IRI)
+		k := edgeKey{from: nodes[q.Subject].typ(names), pred: names[pred], to: nodes[q.Object].typ(names)}
+		byPred[k] = append(byPred[k], q)
+	}
+	keys := make([]edgeKey, 0, len(byPred))
+	for k := range byPred {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.from != b.from {
+			return a.from < b.from
+		}
+		if a.pred != b.pred {
+			return a.pred < b.pred
+		}
+		return a.to < b.to
+	})
+	for _, k := range keys {
+		t, err := writeEdgeTable(dir, k, byPred[k])
+		if err != nil {
+			return n, err
+		}
+		schema = append(schema, t)
+	}
+
+	f, err := os.Create(filepath.Join(dir, TableSchemaFile))
+	if err != nil {
+		return n, err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(schema)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
+
+// typeIRI returns the node type (the first one if there are many), or an empty IRI if the node has no type.
+func (tn *tableNode) typeIRI() quad.IRI {
+	var min quad.IRI
+	for i, t := range tn.types {
+		if i == 0 || t < min {
+			min = t
+		}
+	}
+	return min
+}
+
+// typ returns the table name of the node type.
+func (tn *tableNode) typ(names map[quad.IRI]string) string {
+	if typ := tn.typeIRI(); typ != "" {
+		return names[typ]
+	}
+	return untypedTable
+}
+
+var reTableName = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// tableName converts a type or predicate IRI to a name that can be used in file names (e.g. "git:Commit" -> "git_Commit").
+func tableName(v quad.Value) string {
+	return strings.Trim(reTableName.ReplaceAllString(nodeID(v), "_"), "_")
+}
+
+// nodeID returns a node ID used in tables: an IRI without angle brackets, or a blank node ID.
+func nodeID(v quad.Value) string {
+	if iri, ok := v.(quad.IRI); ok {
+		return string(iri)
+	}
+	return v.String()
+}
+
+func sortedKeys(m map[string][]*tableNode) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// columnType returns a column type of a literal value.
+func columnType(v quad.Value) string {
+	switch v.(type) {
+	case quad.Int:
+		return ColumnInt
+	case quad.Float:
+		return ColumnFloat
+	case quad.Bool:
+		return ColumnBool
+	case quad.Time:
+		return ColumnTime
+	}
+	return ColumnString
+}
+
+// mergeColumnType returns a type of a column with values of both types.
+func mergeColumnType(a, b string) string {
+	switch {
+	case a == "" || a == b:
+		return b
+	case (a == ColumnInt && b == ColumnFloat) || (a == ColumnFloat && b == ColumnInt):
+		return ColumnFloat
+	}
+	return ColumnString
+}
+
+// cellString formats a literal value as a table cell.
+func cellString(v quad.Value) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case quad.String:
+		return string(v)
+	case quad.Int:
+		return strconv.FormatInt(int64(v), 10)
+	case quad.Float:
+		return strconv.FormatFloat(float64(v), 'g', -1, 64)
+	case quad.Bool:
+		return strconv.FormatBool(bool(v))
+	case quad.Time:
+		return time.Time(v).Format(time.RFC3339Nano)
+	case quad.IRI, quad.BNode:
+		return nodeID(v)
+	}
+	return valueString(v)
+}
+
+// cellEscaper escapes values of multi-valued cells, so they can be split on ";".
+var cellEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`)
+
+// tableNames returns unique table and column names for all types and predicates of the graph (see tableName).
+// IRIs are named in sorted order, and an IRI resulting in an already used name gets a numeric suffix,
+// e.g. "git:name" -> "git_name" and "git_name" -> "git_name_2". Names of the untyped table and of the id column are reserved.
+func tableNames(nodes map[quad.Value]*tableNode, edges []quad.Quad) map[quad.IRI]string {
+	var (
+		iris []quad.IRI
+		seen = make(map[quad.IRI]struct{})
+	)
+	add := func(iri quad.IRI) {
+		if _, ok := seen[iri]; !ok {
+			seen[iri] = struct{}{}
+			iris = append(iris, iri)
+		}
+	}
+	for _, tn := range nodes {
+		for _, t := range tn.types {
+			add(t)
+		}
+		for pred := range tn.attrs {
+			add(pred)
+		}
+	}
+	for _, q := range edges {
+		pred, _ := q.Predicate.(quad.IRI)
+		add(pred)
+	}
+	sort.Slice(iris, func(i, j int) bool { return iris[i] < iris[j] })
+
+	used := map[string]struct{}{untypedTable: {}, "id": {}}
+	names := make(map[quad.IRI]string, len(iris))
+	for _, iri := range iris {
+		base := tableName(iri)
+		name := base
+		for i := 2; ; i++ {
+			if _, ok := used[name]; !ok {
+				break
+			}
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+		used[name] = struct{}{}
+		names[iri] = name
+	}
+	return names
+}
+
+func writeNodeTable(dir, typ string, nodes []*tableNode, names map[quad.IRI]string) (*TableSchema, error) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodeID(nodes[i].id) < nodeID(nodes[j].id)
+	})
+
+	var (
+		types = make(map[quad.IRI]string)
+		multi = make(map[quad.IRI]bool)
+	)
+	for _, tn := range nodes {
+		for pred, vals := range tn.attrs {
+			for _, v := range vals {
+				types[pred] = mergeColumnType(types[pred], columnType(v))
+			}
+			if len(vals) > 1 {
+				multi[pred] = true
+			}
+		}
+	}
+	for pred := range multi {
+		types[pred] = ColumnString
+	}
+	preds := make([]quad.IRI, 0, len(types))
+	for pred := range types {
+		preds = append(preds, pred)
+	}
+	sort.Slice(preds, func(i, j int) bool { return preds[i] < preds[j] })
+
+	t := &TableSchema{
+		File:    typ + ".nodes.csv",
+		Kind:    "nodes",
+		Type:    string(nodes[0].typeIRI()),
+		Rows:    len(nodes),
+		Columns: []TableColumn{{Name: "id", Type: ColumnID}},
+	}
+	for _, pred := range preds {
+		t.Columns = append(t.Columns, TableColumn{Name: names[pred], Pred: string(pred), Type: types[pred], Multi: multi[pred]})
+	}
+
+	rows := make([][]string, 0, len(nodes))
+	for _, tn := range nodes {
+		row := make([]string, 0, len(t.Columns))
+		row = append(row, nodeID(tn.id))
+		for _, pred := range preds {
+			vals := tn.attrs[pred]
+			cells := make([]string, 0, len(vals))
+			for _, v := range vals {
+				c := cellString(v)
+				if multi[pred] {
+					c = cellEscaper.Replace(c)
+				}
+				cells = append(cells, c)
+			}
+			row = append(row, strings.Join(cells, ";"))
+		}
+		rows = append(rows, row)
+	}
+	return t, writeCSV(filepath.Join(dir, t.File), t.Columns, rows)
+}
+
+func writeEdgeTable(dir string, k edgeKey, quads []quad.Quad) (*TableSchema, error) {
+	t := &TableSchema{
+		File: fmt.Sprintf("%s.%s.%s.edges.csv", k.from, k.pred, k.to),
+		Kind: "edges",
+		Pred: nodeID(quads[0].Predicate),
+		From: k.from,
+		To:   k.to,
+		Rows: len(quads),
+	}
+	label := ""
+	for _, q := range quads {
+		if q.Label != nil {
+			label = mergeColumnType(label, columnType(q.Label))
+		}
+	}
+	t.Columns = []TableColumn{{Name: "src", Type: ColumnID}, {Name: "dst", Type: ColumnID}}
+	if label != "" {
+		t.Columns = append(t.Columns, TableColumn{Name: "label", Type: label})
+	}
+
+	rows := make([][]string, 0, len(quads))
+	for _, q := range quads {
+		row := []string{nodeID(q.Subject), nodeID(q.Object)}
+		if label != "" {
+			row = append(row, cellString(q.Label))
+		}
+		rows = append(rows, row)
+	}
+	return t, writeCSV(filepath.Join(dir, t.File), t.Columns, rows)
+}
+
+func writeCSV(path string, cols []TableColumn, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	header := make([]string, 0, len(cols))
+	for _, c := range cols {
+		header = append(header, c.Name)
+	}
+	if err = w.Write(header); err == nil {
+		err = w.WriteAll(rows)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+package codegraph
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cayleygraph/cayley/quad"
+	"github.com/mloncode/codegraph/git"
+)
+
+func TestExportTablesMultipleValues(t *testing.T) {
+	g, err := Open("", &Options{Backend: BackendMemory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	var (
+		n1   = quad.IRI("test:n1")
+		n2   = quad.IRI("test:n2")
+		typ  = quad.IRI("test:Node")
+		tags = quad.IRI("test:tag")
+		name = quad.IRI("test:name")
+	)
+	quads := []quad.Quad{
+		{Subject: n1, Predicate: git.PredType, Object: typ},
+		{Subject: n1, Predicate: tags, Object: quad.String("a;b")},
+		{Subject: n1, Predicate: tags, Object: quad.String(`c\d`)},
+		{Subject: n1, Predicate: name, Object: quad.String("x;y")},
+		{Subject: n2, Predicate: git.PredType, Object: typ},
+		{Subject: n2, Predicate: tags, Object: quad.String("e;f")},
+	}
+	if err := g.store.AddQuadSet(quads); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "codegraph-tables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err := g.ExportTables(context.Background(), dir); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, TableSchemaFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema []*TableSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+	if len(schema) != 1 {
+		t.Fatalf("unexpected tables: %d", len(schema))
+	}
+	expCols := []TableColumn{
+		{Name: "id", Type: ColumnID},
+		{Name: "test_name", Pred: string(name), Type: ColumnString},
+		{Name: "test_tag", Pred: string(tags), Type: ColumnString, Multi: true},
+	}
+	if !reflect.DeepEqual(schema[0].Columns, expCols) {
+		t.Errorf("columns: %+v, expected: %+v", schema[0].Columns, expCols)
+	}
+
+	f, err := os.Open(filepath.Join(dir, schema[0].File))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// values of multi-valued cells are escaped, other cells are written as is
+	exp := [][]string{
+		{"id", "test_name", "test_tag"},
+		{"test:n1", "x;y", `a\;b;c\\d`},
+		{"test:n2", "", `e\;f`},
+	}
+	if !reflect.DeepEqual(rows, exp) {
+		t.Errorf("rows:\n%q\nexpected:\n%q", rows, exp)
+	}
+}
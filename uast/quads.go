@@ -11,10 +11,14 @@ import (
 )
 
 const (
-	predUAST = quad.IRI("uast:Root")
-	predRole = quad.IRI("uast:Role")
-	predPos  = quad.IRI("uast:Pos")
-	predFile = quad.IRI("uast:File")
+	// PredRoot links a file to roots of its UAST.
+	PredRoot = quad.IRI("uast:Root")
+	// PredFile links UAST positions back to the file.
+	PredFile = quad.IRI("uast:File")
+	// PredRole links UAST nodes to their roles.
+	PredRole = quad.IRI("uast:Role")
+	// PredPos links UAST nodes to their positions.
+	PredPos = quad.IRI("uast:Pos")
 )
 
 // AsQuads converts a UAST into a set of quads.
@@ -26,7 +30,7 @@ func AsQuads(w quad.Writer, file quad.Value, n nodes.Node) error {
 	for _, id := range ids {
 		if err := w.WriteQuad(quad.Quad{
 			Subject:   file,
-			Predicate: predUAST,
+			Predicate: PredRoot,
 			Object:    id,
 		}); err != nil {
 			return err
@@ -81,7 +85,7 @@ func writeNodeQuads(w quad.Writer, file quad.Value, n nodes.Node) ([]quad.Value,
 			// add a file reference to positions
 			if err := w.WriteQuad(quad.Quad{
 				Subject:   id,
-				Predicate: predFile,
+				Predicate: PredFile,
 				Object:    file,
 			}); err != nil {
 				return nil, err
@@ -106,9 +110,9 @@ func writeNodeQuads(w quad.Writer, file quad.Value, n nodes.Node) ([]quad.Value,
 					}
 				}
 			case uast.KeyRoles:
-				pred = predRole
+				pred = PredRole
 			case uast.KeyPos:
-				pred = predPos
+				pred = PredPos
 			default:
 				if !strings.Contains(k, ":") && ns != "" {
 					pred = quad.IRI(ns + k)
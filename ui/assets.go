@@ -0,0 +1,43 @@
+// Code generated by "go run gen.go" from github.com/cayleygraph/cayley@v0.7.5; DO NOT EDIT.
+
+package ui
+
+// Version is the version of Cayley the assets are taken from.
+const Version = "v0.7.5"
+
+// assets maps file names to their gzipped and base64-encoded contents.
+var assets = map[string]string{
+	"static/branding/cayley_bottom.png":                                      "H4sIAAAAAAAC/+T8ZVRcT/A3Dg4M7i7BwmDBNbgkuBNkcAvuDDq4JASXAMEDhCCBwSGBwQd3CO4wSCA4wZ09fH/Ps/vsf/ecPfv6/2LOmdP33u7qquqqrtufz415p6mEj/MCBwAA4Ksoy+sAAKhKzz8sDAAAkC13egUAANC8ZFX0sLCwsIKwbKIBAAA2RNnICwBg/e+Hcubi8/w0tbeCobeuu5039L2nLQAKhfI4ujl7Wb+H2PK4e9rnHEm+AABeAlTk3+r5fT1Y8c+kBc9c/3O3Z8NnPCAiQsWL4A5zSYz+EP8R8caQTI9ombC0IyOY7tKVSJ7Cwn/mo8QHnDp0jN/vAcTv0WzWUIERvREsFSr24+fHSSaMqTbNP5xLmt24d84kJYl0GvfOdcA/3FcC3QpMeazj+fuorL8ytgP5+qis41VI1BL/awmo3Rqgso7nL6H8/78h4307kI91WPXwUwEiGwE3f6oA4mGWoEx9DNUCrnI1fNkJxJnxm11ZFkU1zCnuYNsMQ+d6kMrCaF35PfFrQLe0z8a8M52Qn/VF8WXXEGIV0Y4ogdMsZSN+v4BqY6z2rtyXoEIBjWQmQ1NMhLN+MyvLcIIahhpKPvorMyP8V9PwOAXEEvx4CBdRiTjEpzNjUKSv4BezGUzm//jOiTe+e2XrElM6aZ84VofaDwtODW+a1wmR3njIyfQWEXGLvMs7IDyQP4x5V0kBuEK2czYKeXtrxPk99W1Fsij1Reg58VZg0ka2DB4qn4s0OZUg3ULmyEyGtHr9TAUBK6MJpOFyHmtp2Pz0zXiS8XdVpiu/Jy4U4/uFWS+4xARKFDut63XDC8O187p3UapQNHkbvlic0cYZKUK9Nm8RN/CegauejCYEOeLT5fxo5qKhp426jOrhJ2IEYfhUOFgqqr7CH/GE6tqbfnrX/nGw5Wi7DxymjwwNS87rNpoNhEccDkUm8tBcu6uTp9NS674ta3sHIOhcedd3l1BicQykRH0ycWEfv6BTX/UWEUnsYpH/gVruR/BDRtcj5vEXPp2ZL5CvhDCdlnoqSifwvRIyA8EW3E8y6Yzg700/1WgHDsYdbfcBw4bDL56brRHE+HRmhHcEPZlFtVsDmbZfFnEtMKu6nuDfO0UZROvnrdj82rxFRCjCvDza+BxfU16byynNMQRjWqmnPE/s27Q51YdAIZ+UY0OQpyDEsLZYchj60mhqZRkOgrx2ozCmMMX7uvh7YmZTgsg6BZHCZB2mE/geD8L9e0MiPVoKXZ2j4cuPWwJmzuYcmn5ganhMnlyMsQREWU5pTqkdyN8nopaofpS2iEuz6eZrcp1YrGkHsOBo+HJ8Rqe86CMiEkIARzFcgXL5nHLxlRUU7rOshVNtph7f0vT5gZ14Dz6Uv8md6cFaTriv0glcp2kHqtQfbfe5A6DJKz1Yy/4n76BemzzPw7Dtiwk0kZ9TScmfOlHODIMsx63UbnjWwos0oF6blGESZcG5rT8DCecHAz6rmxt52niWTZsbYv8FLUkpBvszzJfN6xxd0ikbe4uICCNT/hbN8cYu1r+xllOaU18LpyIVCayw82TLTPPlvlGLR6wg2TCBvzVknmeM7Cv8w82PCg0xXVpZdrjCTpW4LBbxn8Y42JTAShLGfCRjjin58JL9mvToYag8GLITx99kRamUyll82VWAGGzfVA5rC48LwkyGUK6v/miVaGpp+vdu7S0VKRuNl40nkyHJlvSk2Y24UxSlyZDBHQHIsTmHhhD4iJRIRMvCDRpLf8Hv/34wefCTJfHC0+f2X5N7h8GWm1ZqN8Fr4cwKUK9NDoTaltdX7ddJKEtLVr9YCQ9qswfj5Gp6SnnLAZXtH5Uz9bn494bZPHaS+bPspZRYz/5zczLL1p13KU48fAXzOiGj0JftPiIiVUl3VfOFc+0a6KxC3t6jV3TR/Bc2UkqshCHRLIoz8ahjZ3Tysl+jnv/352r12WRf0Gapc/6i1McwZ5UB12DCMOer5nVCuqAv258XOFAy9sHhYDwQofgcGm4JejIDauPCBpt1a3tKKeRqyh4o38/1GFfESlTHD3LtiwlkgZ9XfGu0FCv2Jm5vuueP45cbsyvL8xEmerPTdjw1KnJKczTtQP4S+gODlMG67pqeV1z/6MLY/INXgK9xoCHUqSrptFlcS+NQAPtPg7I2vkkokceemxst9sEfiUSyurNhm3QWJdZU1cNPv8Jzg/qTZTlRWu4IQHYtm+aN2PY/jrb7fgVjvleXMvS0qXOy+IaUiP6yuAYF8A8v7SeookDzqFPF0mmzKup4nsJzyLgaU/xXRhPQLVOORXVGxgT/G3yE/Rdl7JvUY1EdF9dvE1AifseeNnNBPo2V0QSFZ5O/VktUpwgmzikWNvT3n/6SMWfEWwjZyeHPshpMHvweHIT5GcWfWi0lY85Iy7c9a6R2a8BmAm0NxQbBasQAJYsXWeyB0lJb7uQM0j53VLkvEYZHhVGxLhEtbgK8Eh9Fzt/YCfSnMKWyv3XvF2/fHIj2aAeW1ld0tMMrSM+7qFOpn+U3oqNal4iOHwhIybqYo8wghp+NZY7UbA3YJPj8U2Lbl+I0Cg32qDsbs4llUWJ1PoikzK3oyPiv7Wryf7VRunjXhXQP3+7HpeZCWXW5xASMrCl3/9oEq2vOWlh8PWgV/G9GL3Ky6XLX/5cIogv+9gK9KUypr6eCMD8zGoXy1j/ebtgcFw+SqSWqz97PQQHUKRc05v9vHbQoK9k3HwP2uPareTV9V7PUn1WScIEhz7Z/bPvfnW1C/92Z3Rim0P5xILpi7aTu8XajDgu6+j/TVWdv+EKJ4n6byMRUlydp83DR+2c0MpHn7q3Vf8IoCD++PwkYCHFHmMDnHDH6x0T+MzJXwAIUQH1i/Bs3w+ite79z++YAQGJNffvZNf4SPFvksB0YrWJubi+18L90rnA4/+2gs3kBCuAfHN6vyFCAn41m/ievZm3xPQ+k41hU50AOab72tmfLfHn/NdArVE5prqX9Ix+r07NZsg8kwsha2uZ1zJES0aJC3t7cCPpK/Mhce9bUZ/36xB0DdLjck7JPLwMKbXayBpnVEtVZyU2GeFHGrxOZPm0nd6A0QJHmjdg7WZGJVRlB/9uTR0JmIqHQ2mLJKSiRh9Pfv9nYy4f/x3IYruqMvf90EbiG4pcyNyf1Tgp+NpypXrc1YKNfW3zP5TtVvtCatzGM8GnfPNlqRmdSNObvXnxoLyjcN1p7S6XL+8mz6UACw3qvZNrckNCPmOqPRHrR/lcPPPexpXt6JBWx/yRU0kN7pjJ82CDYeXGnse1Yc229qNPUMjUysUokCNNKvc3Q00agetocjiU6CKVVEQ5epViW2vzbG2qK9gpSEj1yQgkOLtvbQzSFAeRQGtqO3Stu07nJZ5PiaMSbNS/pIvgvbAaTB1t1At9zuT38AAf1p6iju4XeCa1anMTYPm0AkS5IVWIjqkBDn++w7jCGDfOl+dXQxg3M41rdqNGD6o1gy8LIxCrCZ1GkFjKZUv17008x3+Qci6Y47R3k3qrGI1gIMoBasD3KzQSY9Ie5f8xHPglml8f2CWZpInNropdwnaJREqZUzFkvG8+CaXMq7FZAxbFEOvMuO6H1qcmOjvFPY+6cRmB/zACuDwGnJ6yZHcL+e3KtoVC13qH1rBpvtzYmcdgPf/5nQeGwk2zWSP3WwJZiWRsEACk8Fk3ZysoKHAu4LwTq403YoJzoIk2Jb0o13ysogvsB9qhKKN3Oro3/dJNGFpE3bN2tvP4QSzmlOY1ntyK4NBzdUqwtDiJ1A1R5uIW4zdLvo1JqB376u474h4iD3ZqBNbZey4OjSvegHoLkST8uexzoIRPOpKxVcdyck0tN6VRZkrV5TM+pTBYFTn+dcmwoGUhyrV5bLIn7BiyCwYNySdiU3x9WqLCVT9wvYgm7NULiIFMZc1bxvr4E8aJ4i4hUYd3jzosvYunuYCC/RSbytBhlLtdsDWxplrXxvYBOLc1CufJagQeGLuwdzQcWpU6kbjWn+oxX/nSQ79GtFG+DgN9h+0AZDAKChXNHU9cfY7ZP31uaPIDI95YJnUqZqknAfMe/f7MPJYisN569oFgncJ0UnrNq3G4dsxD0rWxep71jEgMmTiZKcoXlOUN0l/dRTZOHJHnerQQm/kKUWpTYkFF+6gqymRzCCIrjcJ1eWYbj/saOp1L/yn5OUfO8O9hvB/KzuqXTZi1z/KKUxl8Gxp/TqcW367qwP5Wk44N9wKbc346B3bK0m/hIG+Im4SC/9dI9Rji/7v4nhwaiazFIQ3SrgdrH5z0NsIOA4b3TKFB67ffExbfjN/H/OlOYUp2LL7vmIPvVM5Kp7L08rxq+aBEuKYMbEVJOzgQL5yjLfkVKXfT1kZm+iATORTGbKYJlpp+/9z/4iIjkEX6laDDD4Qa0T/yeuGB73lfFsSixXqkefjLz+KjpzHGZKnsWfjy3aK0XA9NEDUEVinDQxwjB/Bk7kPNRLISoexH+IW8NDYcx2xfYHzew1Ukr8/ftrdhCcM8lz0lxZGJV6DVBD1U+l5gAxqtflMKorECT20QWq2MApj6NNVkkLBGQiKKJqY8z4c0KQYE0RUfwgEphTB8384TDNvMYaKEh85uXLFQfvZkWaIoe8P7b/Nk+C5nDotSHpnr4KS3MOTw4qJ8kmS1cJXhUlYYLbBbHIiX63omuLWK/s4mlp/ay+8Lwk9FcYRSLlL77JweDVEuKJZs6wdEnYeTnyMSqrGfbYD/v9zN0At9jQRghKceGGLt2yJpen/g9BXBjf8LDjfpACRSlP3ZgCnzZRg6hPvn0+e6R0q1iKlS2AZel9KP363VnOZbRXkQZPp1Zg3ksP4paYrxRJIxCmHv1rkvY2s2Rip/Z54/Z9mZKi1cs97/cZdjt6+WKWmmDmkpL4hNc5Mhqfd8HI8+djSFsPPzajjmP+i0rGuXllWVDwBiqJovt5meKRKrBYRO3uQfF7u2c2OgVrPjd3hQm6/QCWBRqKC2/jbBJpTdmDsXxe4f34cc1t7oYj2hf9V3YtS6I37xz/1uEzK+5iBxDDUEPohSNOrlI48HgSaxv13FfGU3Y9LCGtSvCwzdq96nFv4i8aLDcszOph/Qoh1XHDbxu3xRgVXnWDiWYG1U3zIfyqWPj99V8wAi3i+jtGpny1o0nJcSztvi+9PgNclN1hhJogb3gTb/urPmALjpob3u02pxD09h98e1TMJkh9I9seOPsWmZG42JiU2GGse5VBwKNmN0fZzSz57kqMde7kFxWr/hTIXCP72t0ATvi717FE0xry8tDsCiEntEpW+j5UC77FR3u1dwpeWzGgrI+Uf462u7j3Ugpcg3qR+ULHJk/+iHzy6S7opZcYmuMMllwI34wk7l+ayAzDOYGkCSMh9UMliO2o6oCe8bCAIGo1lY47rU3cNhqKODR1IWdLYCoEkaA0p4QuPXTDq0liEYk90kElPJ3bnVlWRTtmgMieuK/wZB6Tgxh+wZlOD/EDNsJw+dwkaCcV5cH5S84qL8w8rRRfwOuBhLA6gY7QZ4PGzJfFnGZwlbCHsJUIky6QPFX4UvhJmEScdx/pjUa9Wf4EdQIbTwulUzCezJRLXnpWIEG0eeYF6FWcgW8xNAvmypYRaRRwdHhjQWF+5uLmrAAIrdwpGoVZXJN3OWL5y0jNbB0rwrRBhZu0pL3rWxI0gl8H7g86s5gqftNFAHZXg8oO+pZ2TribPhCSQCxiCmA5XeOai45AUbzMRLJkkMVaMD3zTk0USj53cEGlz0X23jewR0kJ6hVmhPc+HRm4GHK0j2ZD9YGdTnRhj4uqYHPYQyX/uEec76hi1UKcQk69ct8SF/EtQ7zQIh3dvRf3J5BLF/wSV1f9T+ZubB3SIRlJBvCbrGXr3OqFWpaZNZL9u2diq+PtvtmDB9b6q47KGvOr7Y2j1XllAxKzYXAKxRXZtV68DOPZajURBJXQtl/tWEZDNBRUTcIBoE8elPJYc2oaiWXSYmQuXojAZ9QzYi7UXQwGBkIFhaTlReq8otX+c5n+5QwSAs2o2681GdhSElKm2cqhll04rvJ5DiQMn14yMWXZKDtj/B6cRRoNnjT6f9e3c3I0+b0SB3G+zKgKrqV1IDP8QdsqWxPJlwGuB/lECByUoxc+2XMcF0Pe9QF72vzvEz9cHzWBBY2AkLNqVJNnjNlOkwTkNeV8LQ7jIvQB6Vcn863o+NOmAOC4iAyzead3PKButXBdGEmDr7dRHe7KCG4X/WTPtnVK4KjNjGa3I3fp6Ij5ImzSCz0+pijLmizJFu+DMUQIzzSqcA/YEx6F2imoBs6p46SEVTj8NFANkVAZ74I4WP77CevKLT+0LdgrXczepVeFI3IwGDTNI3IzTFCa8/BgER1nrI9c/ji27RldlBgL7nWi7K9djh9noasEOemfp2MgnzJHCTeJ4wBJYrYbbSO0kNKpzHSIVUCFoBZ9fUNS2DcAJ1Hr7W6jqGnjSebX9EJc43RJw2KDXp2SQYLvd0CmAkgAyUwwmXxk8npZgEKrRfbsg5zAmPOI52ovnwefuCI5LuZUUhSehR4fsvf0nn/7Wt/66QZdfqHz89zG+fiN86DVX2HGSBnvXFTqTS6L+4PJD/3YL10aPSqyXdkzD5CkSyAGZQ6tcF0fuifSD0ZpWkId+fy9m3FXtBmmWrPzHgkmfbpz9tg1MREBxFZ6A2WwOTCmSCCX/VxJ7x5jmqXPmlXXVfBFJB6SD+O6o9zGVCP+poQdlDc91i2Qz0frqOvhm1drAzIsRjqfwq28RazXjan20EcbIoAe0OrnxGwIwJJDFMVeM9fjGXL/alC9XiAfBEz6Mcmhusa0fddJ/jcP8OTZjyioLXx0rpBQ9gcqiCrV8XOwFYkzA0oqfqHAV0LdcmoGrV0TxHBgkBhzJFGoWUCXSnS6bLAmmkgYjUaAyUiKGzRA8PJmGkCb4+RhYhZcuHdzlwarR7KNP5Hw/HkwdThssdcGdbjmGRYVSmMEnWchIc/G4U2piV81STqNwdOYqMZ5J4vKYQAkAeewQX79IpHpL/ovHrM5MjB68yV6dv68KyqdzO8KKGVdYP4oNM/8A2VF2BuQBSQcoNCsnIGdbkQ5pVyIipAJeNF4Ubk8euF/nB2Ik3/OSbx4KGCNvgptlzPfDBDgXmkUN1aZzCFKdUfJo7jR0Bl8T61CUEBOlV1ecsSDQt5IaIR0v9Z4xhBPqS+PNJuGXtb/GbeGEy4OYQvSRWsN/gDRrMRgM+J750fS6MmLNLwLf4f4r/OAl7MYH5piFE+osgAz/iEx4XJYRlYeuDwsMP05QWJ9XzcTVOEGOXrBSDckLro+0aecJZMmHlYuab54C7I863pP6rNjyxKrFN6PrRujnFWnzRKUHIUpQo5Bj/k/SQN0y29bz//I7fs/fikoauN+PZ35wlZ8i/KyLU9onwsWCUvQPWDDjYvYJPDRacrV/D9ngZ1KofwyyWblaTSPMDXlPqevy9NZzbPSqX491DIDPa3J4VYFJGzxKTJah7DX18dXUx/jw7MzyNcbTTFGovShbno9OQa9NmkX9BmjejMaKEQ6twkT3SEa+28B1TB3OJoDM6bPmscdyd0+kvelt7XXH0P7QQ8YIZrgeeL4iWgj3QWen09z9WgSmdvG+58WRdrExxevvcC/k9/jcVU6185IgbkGWyGqhb2TeRvPwKDMfsC0NGV0OL/gyecRR5fhoce9cXZyWbXVpKUvqCRD1UaKVOYjJ0Yv87MOiyA1s0Iq+DSK1jYzWXMzkgzHvBhrpH66GKsqnaBaPnu5juMnjLefqgIeX9tnabh25Vr32eT+DwPbJxbDcYfQNWP1MYZKDmRDulX1MbA3KYrVIjLC7sjfPkE0hes0QMV198FSh65xASydLv1tqbki7ir/DasfgVj8Ma9zsdQA5uEO+leeRnhdNvksCix6n4A+Y6jMHG6nOJUIa3tSuwIv3Oc58BhvzsaPZjU0DZn2sPLKdp9o0tiYBT0ad/Ou+sRkeTCWVGceFnDIBhrzvM432EiAEz9BWbBYQa0uE8hlCLIgAsijZ11+pZfMQMjAT3p6LBmkirMU0MOtqXgLJSvBPccnM4XMzX3YwkgHdcFYmud5wqeGnbrjzziyFnmBOUnceYFvOhRPRcB9i+pnv/SnUkIywfcRbncornxbwYWJg5igtsw5yuHWFviBqT4P1mrmxl52ggw/lXwJ5tv7mKNg3D2yVBb6E3ozvxDsOhZ0P7k1dp0m85NxkwjhS1GqCk34iycYyDRiLNe+L/j15mp9NiZ/42TIxkj0S8wYWJ3OlpymlNxTf9stPLJr1RlTtrg8f6EHzipVG249zKWJmrxGGx+Tw29bfnHkQ63LEgxsJSMYTZT2oezHRhHjkuCBGGCitS+mEAZJZ5ySPLQpps0gjuYn6m99DsxHsGjuFtTpGox9m3dc1GRHt1qkTR/qg42QBlDaSfEw53wfuHmmChT4kHUCqtKlJj7L6oemQ6PhHmnas162dQhXWCEytJsxTETGzi9oS9NraZRXJhASkZWegyg0/Zo2k80ZzPE33dFl3duLE9uSlQh9Lm6tqkzHjuF/GV2UkqsM3o+XsuGUaodq/mYrLEDXdffX3z9drL6Cb7X/R6HxHcTw0SrSs2yBATOjaD5uUL03Yga/kL0tQa6CtiH6oSsqtnPggLVJG7A65+CrfrPip2BohVbvdAKDxdjEiYwHTXZcg4qreZhzIQ6ktlSTS3+Hmv1itsjX67yDzEOfNw/FtYAc8r0RFlF25mqBQtTR0rwJqhwP6uqGMaNcAULO1ImB2/E2vPpXbxZ5vqp+unydhJ4T+BAnty4R5yBrQWeQ10Mcyc5wvzLCTdyFZllqiot23N9o5amcUmcfAnf4GdVTafN0sOhuA/KHtqP4gY0kCRTbsRxtFoGnP95M3+r9tTFffMv/2+hgPa7mUMPteQgVpupQIgtJYlUiw5YpDf3YxqsriNMApPyOe5P1GwNFKXA6JP2Hcu2dIjFpKv8unDsHr5G5dyalrmpW5j3cxPc/WhGswP9gFl0czvdxRCNUqyXjOYnbw2iwW5JkXCOnK8vQTIxnPTW360Gk1XowGakV+Xef3ByWj/maN6/D9KeYaUauZVbjs9TnSq4E8CEU8EN5e3mGIV0lWDiL0Tod5ktPxFpyCCCDarUN+bFwGbUIr9CE3BygrBcOEDu/ZRp/B/tgXUaL8ze/OnBQv6MYFUM3LC7MdWE9/zlRdiBPBtYFHeZQfUKWy7LeNUdEQ7iFFfB/hzXj8yqkLxcfdvUNI+dcv6ylPm8QsvtPnF480tnVIZ1nFIN0uSkoD47mLMTZ4LloH0jhdvlmJClsb2zxzuVOFgIiV/EUMzDJuISNCmYuSnPTpXjwT6DWX35R3sZ/v2uMGnougjWguxjcqWsc5SAmKb0TZbtESFl4HvrwbsovkTJ/RheX0saJofgzkOilixI7mUf2wB7Z49Hy0vXPTVwf81WPQ2wVeNkIWZAMr8WvPFaDIee/4Divp5eza10MwxcLsSNMoXtBu+UeUJxaxyq+OfgxImP7ouSDclfeT460y/edUl/PCjZJMleEjeJe+AZ/Aa7QsmvrBusAnlacFcXrH5QIrAHKGn7/3ayWO2rxHhaYmbxZzV+q/OPe3mk4bWckBngnkyUl12qCHYrhmQAC9/8iA6q6ngQ+pWmD7zsZDFuO8bcBxSWCwVnFB9jugNyEQ+5lQom+9wYnarogcCl+vMHS7pvi3Vix7XnD0h8pPmy7PoqPUt/Hm3ZXiwiD05eZYJ15Vcggwtx3xtF2BD7M3UF75jrmjgK1WAe1S9F7am8M4wLC92QbjERN4nTzVh5AyYsuWWK2VfYQmJZv/iMLLmiQwZ1rZxv12+V2LcVihHfw8y/yidhTZy7Ir2RcyrK38EX4shvHDlBCvJVJpehwr/S+oB361Wc+SzLaJ8eW+M4rzwET67GZr9LpSd+hlVhqncF+7YQHylZhkojLr6s4i2zg+pBy9AOK5XEDyg/49YQvAAPMgnOzYBUNww3kmR7a1G77Wdt9Af73qGMRtCoi1NpIaVbciRM4pLK93DgTnP7BvMhPG6mY+X8vXM/kJTnfxBscsJt+Ffwrd6/hQKO32A5SPqOyMrRCyq3vY45el3wCgCamAirGXSGe3lr6po4miw/WIYuk3B5ZH402ftDYelp78xfWw9IVQc3AggQwb5LpFWxT29GgUdWKv1KK5jXc6/FdGbAHh8vQpQlsbVfHyLYQac/d0R1CsoqhShEya+cGyZ7eXigx62TD7whVlvlSnoL25LEYoIN0enEy1Q8GF2XplJRLbUPUrel6i/K9ujhaIFnnzWqgZqnN8C34PHeiw8Xuxf+NPen4VktLqgH9BZ6wdlx+8VtNfW/HOT4nb7BTNYpEAQSvgJOZ2Gc4bjyW20BdEU8g8mwxK4VPS2WxX67jzMfTc7/qRP7r0D5VKan9V/EqNlpFd7Cq+crZTw5TJJCPmz8/hNgsAZgvc4cJFefkEYXDYe0OR1vljg8WZD8cKDp+PAvUwYRrKSPPeFNUPU4lySZR6gL2SSS0Tf+CdIaW42c205Ikih6bI3K0YDa2jvzJwM/BuvOaAF2NtKbVSbxrgghzu8e9eS3FdvOlFMLLSt61+EiAuqd9fBR9EdK+G99bmj2oio6kmhZNOtfSGGZC5aUUMUJqkliSDbxp6RqWwvKZOeNRAUwYSf5lUl1h2ugEdqrKrhFB/C3RnvZnhPcqkAWXivqjoz9ZG6SgYC9m5ELl0Ed4U3aylowmZCy1w0g8bEwG9Nbvq50ljCJG/zaRScINkOf6/wncx0a8G50H5GFYPhOG0hp5FkEG4WDy9gU67r9WpNy2D3980LbRH6lSSfdHXQlpv6z83uPkpMUEoldmLcvfhp9DLDtPf3fWIAQ8THr8j0CuOO8RJRLgMgR8aLuhJJtnTqYbYP+16dg2gtAFW0P04QSeIE35KYc88fISuu/pgWTum+0f6hWmgPxRNvrf/klHb8Jwg7gTuoczcSfct5d0kX8A00S945h5NKWOaGI4mY09fLzldMfYRDAds6Gi5ycOHNj97lVz8ebVSMpt2Xav0laJY3NbkhcSKfDqhL2+x4w1tv3eTfTteFlPOFZy+9mgJCkUWGciQq8e3pRsPxqhZplm9CvtFkq88ynK/Q/xGl79CxmhJAAe+d/smvhPdxUbEFSj/0R+HrUV1t7tMTUep6CpVvK4Dg4vyQmAWt0hB925pcMI1cN12JthHtY9U0iC2o1aqq4SZyPUangK+UAPDfaWVXG7LGuC3Xz7GlZe9bgD8ebHnCLS85IB3FSkfbt04BghpxFVRNgajj+Xu1T5gP2ta+9M//pPy4VshHZHDyXA4l0rGUsWh5QcfiRJeLZrfs1YpYDvmFqfctCMII8c47f7JWVVf7kcVPdImi3jnmHLdpa/8vP+osEeObBo2O625RvCDEDX0ieKozvz+zV86FbPijoxppLvSGpYjvbfrXp6MRJF31Xa1qJtFiURD1YWzC+rz5+s6cCvkKpYllO6igKJJnXki8hKPoXlsIEXiG/5qy5fUOQDZF6Ux5tuiXxOcDBiTMYB45t8EvIeQi+ILWD7/vOls/1wtMU/YSvGWItSf9i5F9CWRrsKIIGOEdV58jmhrf8bSgHLVVsDJEb6EhnrD3qjQwbm93oCMbUIYQFCFRFSaTJcd7yA6/rovXKV1d//B5MhtHHlBMmw7R9gRD8k1wuKYJnsXHuY23GJaO7LhAi4iZxXl3CeHaYuUBaFfjC26dejd0Ou5rx5gRsTqo7/1dVbI4rRBqUKB2AsRcDB4MlDZM48H/qMxzW9p2myJbcyt55+95ZtRO/yzn4sUY6NyCRJNkiwpGMQwynhMd+e1j7Y7nCFmTtrXxBWeVP3iNtY4pQuvM/4sjyX5cv0QWF2pPU4rtGfmfG5exC1y3z3GlIP1nS/i1D/Iu4Iz6XdrMaHXfObaI5p3rIOvmkNQXDvIo+1F8Ll38LfgoLZM6hjIpkd9X28iyCSaBeJT+YR9nmcoEo7wis+LIXVU3WS87tdv09ucf2XLjzltYU31LHDodzyx49VSNigMElruptaNayoXhBYjZT9h5Ji7e5BCvT+uKkV9YgLTtUCWgDhW7b5AdYXkT5RDqbV/h4zED3LxdTXU8yvPtAtMYut9VzJIZPsedn3xrhRTlEZLv1v294tNMLxq0F1R16Mxim1eNFn++CUaHAJui7CWpde1gzDc8/wpaQBRvT0cyj9se6sspzluXNfa5E/r1xToSmo1kON1VQMO6Nf+zvJSHLKGPWofMcWnS18HvgPHv2vI4j64kjsX8f9OV7oV9pkZsUxsszUC76UQzfXRdu6YbrULTyb/kEtBpw8G97tMMq1S6Ov33TIMMmZCA8wO4968RIP/65tIW8tPG9TM6iaj7aEaaiErX90D3Q4ciFm+eETpkK1sxy8vImMPb3khyi6oFbTEfN0/v1yQUWUK3cICxXnvl1B0X2ompsy6vtlX1EooXRQzY0zH3Dzq6C/PsD6rJs1E50NLeOo44KuH+jj/zhM4TUabVrRu92xcKOthnjhG/QnJ6Dx8CngaIXSvRLZ0ZSRsFwlkGhaM+vHDaorZmvpn9G+M1InuTJuaCs0pvsyCAf5Tkwta3BRMYYjA1/mg2UQAH9sQNfAnps1Zf1fFAh1DUGmb5xQ3IMbX/agEpcr5LmYdLJxZMTuZW9+bcE32VgTlrE6QY+kh69W8pgkf5KMImduBf911egjGuC79klDZN9CC+bjmXZVyYJISncSnOtFQtkSFHiprZERurNBNgRvimOeqntnb2d1tbughHshiYGHbGdW9mbEYSpkwDLC6P55p5uiKZOlrxzezvsBKzj6SEpbP/6wluLbQHd0Orn150dl7HWvOQH10tGtxfn8mrx6unoa8cx8OO7qJmG3DnBlJ0dF3o1U3ZBWmibRgUyxAd2LEri8IpnfFc3fzAZFoIxX21OXLwMQwy2fywtKqv8CbwpkyAZ6jqF5GUJ1k5aXAFwXECgjJL1iTL+jywgXPktHUvS5auO8RiM8ZGsga66h1UYEolsztFj2zwysfO/Q78Z8+S4oC1urtLCS9Sf4QM7bHoT33w1N0nN2HbpqlAYrpzxZ6hpi6ZlBB37YQ1TVctv0RGLoc7TQ6WDXGa/k/s7jrGGoKpFoWLT5dAs/0i8pFPqusg65sqgRcd1PTK9gky6oi+Ly8ZMTusvks6vQGlVZruJ8rLbDjdk2nFouUAtsQOb9N0CrMTyWx7EC+Rzd/ccCN36xmK2DBYP2QUp+CPr6AL8Lq3MZeflsdGQDrAO7PPqRKjGKD68eAZkyUnsvwp9CdBwLWZEFEbwXqar5ZvvPlpUyJzOWN+V+7xXNy/bK53bdoUsfBSEik1Cudj/odWY2TvzE7/+recjuPyw3Edwdey//BA0sfFUezAe7m4do10ZubH3h7m3wNBKrx7T/qiujEWxT48V5MXwtWm4pH1TOdlVeRZU5WpopVc+CrkZ70/XSExM4k1XF31p2P0KO/GM7s3Qweq0/mLD02j84A/kfU3/+TrzhjjtcgbBCZ3SEFfaU9BXWnQzyOuZFj41oyMj2+XsRVUagG8h4fidvb36h4Ub4tWGzot5kbuDrhyirbeISSmlSzsCdZLVxhrcHy/YsPNz+VVsd/oRzOsBLvkriKD7diNPIb+W0j2nPOuabD1gvYauiWPr8ct4lWxkaw1/PHDzIoB2Ocn3H7VcLHcubdmeOEJztUulsQAh3U6wOq3/Iq4yQ5Aq3fBFvaQEL4bbHLL5YFh6/s1B+CL7QJT0kVh2BDkBLV5+m1sgsFuSXuawon8688+CPz+kGzJna3FQTwNH7bSe6B1Mbsv6WB8Fu+X4adtxgrFTr+HKspakkKASjwy6rjgW1Rkb9hGDEx6ub85T18kgiMBm2DlVYoaF/04+uThxJgBSDNNea7RKU7xmDT+oHUEeUpvmbzZgHoXYYLhBwWaOWDXoyE25kRcMursiaH1+om6zhtRo1uk1Z+9P+pBV4x71424nigATPJTZf3Qqitd+DIf1cZFtSX1c73fkhA7yPpvv46H03SSCmIJaky4XFLpXlZ4fZnzhq6Vc7ZFOq9CvGXFedzWRyXIQFB25QjrpvMx3MBo/hAF7sX+2uvH7oYGd25h/TM4uzUeBpj4il5D4FUHur4S91x5JKKpdkyxGfG5UviB5Wx9krSghfLEGWrv1iqQwZ1GVE9pAIXNOF80v/fpXmn6fnYSC1a/3xyF0183HoiNh85mFQjlTm+U/dVgX/C3jczGTa/e9YAuZhUL1qbw6vvRU1jtvbQ5uvzptRgI4bxK78Ea6TiFrjDYxuZh98/5E1C3SPxZV5cIag5TeY9hNf25qlxsgkSCMrz17KeAbrPmrMRIw9d+lT4z1wVGfWf6PDqci37PJ6QWERW1KImu6uhLCXm9I2kVqXNGF8WNb7ryVjySnaAe20p69h9oMfji1Q5bgd2tGlrZygRI86E94XYvPwqc2JO0U25YWjL8eS2BZB+27cI+5O3G24VZsS6T/DAxeve34svknXjSpDLaC6POgFywsWbL+9fdaWeD/apkpLrHYxKSrCekWh/k7hxESX2n57iPsXdtB7YgDeWXeQIYEo66xKId5Kca2EnyU1RM6hf9v9nXHQ8nrrht95d0XgpjdEELewJNy3taG+vYxZ+YSfrwKggQn5gT7fhTB93OLjv4SPtheqNJkIL+FrdXfMO4GsQNc3RF0ZaI1MUDNM8PPPM0IjuSqr0V9I9Upkk83ArBEAvFZHtwGd2vrkQ1jsxugtbdUqWSdN5CcZ8c+wt71EeRip/AhPEnuMNQZt/dcQG89/HftaC+DF2+Ul0sJvBI+aj6IC/YFXhHzMHgOJkePYLpdUWvHtsX19wvkVvbSI/Ut+rWaFhBrz6gv1Ac6aEjmiRt+1XD7clILb9aJG+FpQfFQvEmDGF9jbPRxv8Bsgjhf6LEL99g/ugh+FdKvlJfd7y1bzZM+3iU/WNJnJp19Wx2coAvjLiAyJ0kZaqmx8AcmR4yPZRbVnTG1LsD3a90vFz6JMGRgorSlMBHflF5ZZGa3aqGGmKF3nB5kyIrW116NZDq59YZ4f/Jxlg0ofEpskclaXCHeeos4Lb/K+2Ritjq9rQRKiSBFQbogrjbbpqlkx598GyjWzuii+FVmFoyD3LGknvYlEsmMIloIAimXM0K2JBLJdjv/yDCYcIjhODGbktrLs19inOiuveWaXTAOor9OOBadtNz67H/QsYhvoT0DDOq3Utcp20O5q/btNlAkyNqR+PLzWgNCpxmbb0Ve7+wzWvcLJ+NQDFUUWFNfEGofN2C69pZr06WCptXCpOJEqBv3rB8oAnP6AdM8p0vlVymAkYJ/kcI9/N4/XnPr+y1ClR35ciLOX3BTLOePXdIp4Yx0/5FSM+IRI0k2COfcjH7VmwcM2ZGItkX/s8d7RaeUQh419v39J8qAp+qV8H69C1Ww2aiuYZ/fD1h52V4awhp+hhoWSW7+TdQ7vQMxue6pNfzpfAf6nL4Ohl4eG6laSCkBKAEM0yJ5es4aiPMHVZ4u1ScDIY9zUjyjEMOVd0jMZyCMDEyc63Eg/p8cOKps7wnAuzH2saDtKQRLSp320NmnSC8zvOWIVROS73RBp/QhOGwYmj5l78kMOn7/sYnD/508iYRGXf2va2JI+7FoikPa++P6zoEysEin1I9QWfBVNBOrdRBO+44Wsv2qXG/R7WOyrpFtpkYIDHmPbM5R7nzYe7vmwTyd9CHIXiwtIBGRy8U7x5Y3bYz2FSkR/eKeNnNDIpqZ/Z3d0kao00oQLtIdJm4EptmjSuc3L3X6CNPEeIy8a79yXtoIffWuyt1yVxAd2qbRTFqzMDIckMWRCsP54/mHN5Dv3QWKZe/fTdViawVmcuuA7EVV0rBQIOddIgs2zhctCb8L5zXgGFCfcMJbGoIOCTl+QdVXlAx7BngkYihGOIgDRTebqaMqjEt+71hH094SXevZO/OTy8iDL6iQAmsezD1clvbE/rBJDHAqEwC8wgAnfiVPY7J3byzfvi8R/VwKWKAtGtnhvSV4qK3/5UcVBkvsHUVeMxLZuUyYIBwR7go9TuFZetozjIhGyCbnLM6EN6Pbh7EF0CnKG12TXSfkfjJmFk9cWPrY7MZaECZJJOwR5zrxWFSSE4h5KSJZsmj9Grm5k2avSA5WfSC9bj9+2YWjuSwkiVHxIxS4ZnKPSXzzRj4RhRUVGjk/boyoDosC+2Q4yAmVhHw43u+GH7tn9vzVtPweTVsvyo8TRAg1/7OA7agWj7rWvzJ+ejBi3Id/teT1MxP2SH09ZFmoWlCG0ASdJh6/wYCVVX6Zs8B1B0g8L8WKn+GwBkAGak7k8lV+eKr81sXbHnv1ldI9trC48OpIl1tUyNfonwaL38oqfwrzrBu7I4lLZqslTOLKAmJ/58CqUCTR4q9oYwlDiZOzx6JCAhMDbihO/iI2VCqNEEVCbIrhDJ87LHDrwx9gLIqATnxLTlPCpSjGyyewZSwsrxLGiSepbv29GMZZurcA7+x6T1JI6LHTqT9DwhSGbF1MuifPfWA7Csg27nxwITl6TGdL2jjNzJ5e9kL7BttHMUM0r2+q5n5CZMjb1B2/+f57Wt8YKJLrZb1W22NJfPJrbHbj4v2Lsr1suADWGuMyYdScR5tyKIGXpPX3EphF7wVFMEi+nh45h9gFnfpmbr7+lUaA+igv3fe6HS2ouf5X5iXDMKB0jxmJ0ZXQpl7+hJoA0jnz5ChIYQKAzSjh1POMpi5lp/SQz9HLhxKfX6FjT+Ij7IP7dZQYUC2oR/wA4AfmqsnrHVnhNgZRY/nT2W4nSd2ZQ0g9R3diVAuhqKI8Pc3f2o8xDKGMS4LWT9hB7JwxE1awZgmIVA3s2HDvL7FlKPGNe6ZiQSoTZKUBbvHCAndF9CYPeEme/FxMEGq4FhMjnpJH+ZV5Ue8UX//SBxP2PeCsvXyeUyPCEXSqT+ZBpRsDq/qUE37J3XXh2x67f9jKEyv6YZKxETVHvrYj4ub1ycex2Y2gtfDpjzC3+LsD4Ss6Nbp77OvE6BHsJcibwQkr2GJsIF5ymuMjFGAxKieVU9IwyY9gsfkhcc+DRI7NWhXZBn1kQGUD/AAUygmZmZbd4xAPbinMXBfCmCC/YjUx+yIcxGkabztZgE3v9aam9Y3R4QTXsl/uLhyI3fSNOZyW8BXfPe2NI8zbrWOUqa8y4a7fJKj0PNEYZBpbI88pY1vJRPm1XrsITNM9CiLnx2Y3ugLoXlK5Q4khygMlK/AsmUZFhrRBJvAKJbQNx6TtwRi5xbfJ0+XkxInfTf7iPvRCXFEd48FP2vWDsaEMRuIZ3fDLUVQCugO/9bdsSgBYM7kbrc4LzLsx9JCXoI4LumjCt9BrbgTaWm3E66Lf3dfJvBbQGY+lHn0rWTC0L+EQS2L76BiOsz/+PCoQvIKa4RdhdnVMvGwIx/yeBHNfazmP6VDPbZzolPrDp3VLYo3jVuLMwWGhpAHu+EQTwD8E5QKekLgFTUx9XNFmHiQOgyX2PBg7sNB9bKFA6cAcg+3wlglh9TEGM8wpMNg54vPifFmw3NJr4JB+66G8C7QkJc4invsH/gUIInUAzhI8Bx26knr5Hv1/Qq+1Z8BhXpAdh8wsBfeNqv4O0uR5CgXdmZlwSjWp3lqtjIb1Gf9N9h/WW714y4Un+fY+QdqVH/JOvcLcPycWUB6vV/XLkCb7ax/w1W051Fr6R6Z6jHenGj/9ofg2E9LLW1lWKYQhSixK8SqA4IfWfnxm32whg/3BJGfEk6y8VbVlFYxzPZ1MLB2yr9cWVy7+pEZc2/tSI1/P5xUEDcJTYxxdcuYhpU4Z/XxUqp8Ae6QV9fyXL2MIx9EaW03fPevvpVOtVIVf7ffnVvaOJIngM/2tdOme+an6XQyedx+XXrYh7ZDMphSHrvs43vnQpam67S9zlBXosjLsSKbXIYV7zYNdFl57WkO47WIQZPPpddSVGIoLcU1utCS1lyGiv14qRPYad2AK3k+XxUH9HoM30yoYveqNI1UjoP8u27UcZrIZe8gNHQ0N7DSWNF58PxaF28/6R+lMdjmFY+fcgEv4+MM6GiVxu558GxdqSNT+J8LXCh36PmqJFLozox4dKVe9Wu7yhCe8yH7hV8LPpz4l8HE3kpqUKKIhuMCVjNhzvuJ8114+1HAVPXDE3zvDBn5ArZKeTGfMWUV7Rp8qMaSpkIJXsETizsM+a+R3znDCfBOnc2Gr+EHtk2sZ7EHrz+kMh5bwICI/a0wwfBDRlFvZSylJK/PrwyCADTWf0XQ3uvQLxBZzjDx5Z8//WzObUlzZ3qtlsvEHj5jJbB/JZdPl6aOc8EFqw8fu8pKGSWVLaHqQ7/70P8hZeCAkf4U7t7J3N+5JFuxedKuxqQbytKaz3jc4ebWlvFz4mc/6eLt2S1t5deh2Jw9WNZCi9JNJTiiY8Aq4TdnOMAHbC4eD6tOWmUGYPI9yuiaOdKbu+4/wCI6Rwt2akk03mAgQCm2gYKLOSDHovHVJheSvUJYeIw8bjU4XVlhAXvt+FUm96bQXFOrUy9RXfUdK1+xlwf4McPwrjEcNeVXigymuwrJKPYB0b0rcoh3xwSUT0nfZHqpUvPPYWb1nXxZ05oc+LwlD8f9YACvfoOdkUIErpU6F64bL9IhFz14T4x3J0MI/Q2TEKVA7EFL6ftX0tZ+I1Qf8CeZruljPwrg5LhIxNumuusx0L1lND0tU89iuk9HvW+p/Oezo0pU6EV+DjuqXMA1ZPqz4bko35oRjBn2Z/5dCy81JRd1Bf7TBx3P94yq0OqYlXHP/Yvzc9vE+Nnq6cixh10TXxJH2qH5/34sGs+LTfl/VeZMGpvnzu7PSP36wxUg1eg4xV75PHd9LYCbP7+2546/43CQnP04o2aa8Acsk0Bj8tB4oga7TF0iRBusBScnQ2r8NsVLGKjtz3z2HOExhqxtnlWOZarRWhT/7QzS5lb2OwAB+O4SeDxXP26rzpoRulnb3qSt7ETo/JmzBAdJkWmrdcuS/bly9xzQp3MyXoJhmPtheCPzt0nun8DQjHXg1z0cCjfsPgxxxuLGR1F5nJG41F9w+2C5hej60VcLvimURU+Eahx61Kql6+n8FbshNLcsvaCujA+WTX3Xiu+KaZsUZ/zCgDJMLZ4cFBPC5jRd9gSXqzEx5xPQUTOdRjfYEuzcoG1uRwT/vcTJ/dYpJVqF5PhYnjZ/62zXqu6tG7UCWHLURB/OFNQtX+Q6IYM95nXC46S9Z7BNnlDGAHwTcKIwzFeKH4btDlaCUgbJtWDPpkWnFuVQIFpRnMaGv6Jnxkab3i9gvbIjyD4/lDrPFF7AP5nJmaq6c8AOmm+Qk6YSS7fci2BJSetNMTjgYcEUtQxKsN4EVUiQJENJ/xsVgtmzEDWU6GHra6KiBqzHazW1TuxW29uAb1K/ADy8g/G6WgZUBEPQDzUYd8kFSdR9pBgPl1yMnn0TCUuW3tHywuWRhAVQnURw599ryBHHPh+I2g8kqlZaW+2QclJS5kTRY2dd1BM8H31Vb8l3cLWeMVTaIcfmiEurQkhA9H1G3hMH6sDQ5odyPJsQPIOIss6T572TqayEoInelvCzLPVg5ypY6gxg2OdM4PK0ysLpBURjhqiYecRY3yH59T9GM8vvuEKIYoQtrhpp9EHyNvfr0A5KvlNnzV9xSkFiMEz9GG6n3jDmTqesZKMq6oM3Sw7WDSGHGV9cMmpq+Hyjz6CVDBz9wuVUMPZ9lAkX15UvkGdJ242CaCLDIGqfNVD9kM/aROljvohC26bFjWryQquuxY8qVFp9QhvlsTQrTMK2KusFXy1wFethumRKAtW5y9fIZc7h5kRr4tfrxJ2wVsIH+jJpHm8J7ksJGj/x9gueta8a74KZoUm5+Acdn4hep3oU5bBzrtjV6mUaNj1h3hh9+UZ+IGhnlcEsAET/hZPhzVc9h+Pi3BY4y5xzh0Ay4ZnebGJHOGFARB6/gN16+Z3kiSl7ZiOnLvH1mtcjy2d5qc1xFSqO4ZGwzvwWL9IIXVtlsptggSe6PxMF6N99hLRv0UDfSul1X+N5smRm6tDIOQ/Gp83FnrrW+VVdRHosSq6DeRUaY3sKWMvGNrem/6ThYCLFIn+3ZZ42n2MAt/ten3wR4qOzPrJHDjDnSUTTAy8aBj7FZMPpwk4OGDVaQp7zpPypdrWfiFZbTcKvynyxZwTAN+a2P199hSZbh/Qyo9kBrOaHcOBrB00KhL6In3Jl1ITgQ1Jn+Ba594LK9ly0FRY/+TC3cy/kCJycoWqKxYjt9EE0tUb2kdK8WXjadZsoD6u8j12p75p/4w1Ha5eSEHniPSkI1Usqj70j0QqXJDDis/juYMUCxjx6ID+jZ/ggzx6DVtU3dlN+qg2/wl6w+Q51IyNYDcTLfPWNEZ0GnQUHaM/kwN3xTgiBpmymeZb8i+Sq5Hbka83lyPXOKwpTAMGv5rcG/hadKYBFgXtGpTRg7Qp5T1qmuJ4UpVQzWTAkJTNM4IkpWp1DXmwF6JI0K401UxJtgbOgRZ5lnIHTegOc6K/lCRdcp0ELQG1+eJPhgjwiC23L3inEuKZIlNmL7M0WfSTdlkbgBcdF6at901MBKqP2bMKpqqu+7XEhUpABxlkHSfMorv45EdE2m+zsfxpPUinaLtzPHlxw5NpdRkYPPwF5WdHBcPqg/CVXPh/ZoO5hqPUDWvO8iYlkvA0EiDyZEXPx0JfsPBzQ3JVzJE87yJiGplM34tW+qwrPJcGCUBjRBwa3RejStudiwW/ZlqmytTy4BIm54o7oTSn8yYJo9CROLUjZToZt9Dg8St6UpCuCk7pkZ2JYAsRhPlV98TCqLEmv9hz+C5kWe5JvL6jIEdhbBdCJ3sMUPBcPGaPckorryq5VKLh46MzNhXuFMWCIt7i/cfNcYzvhaqiPrqI6RY7mEm3FDmdfP2tAAo0O9quEqeThz6D6BxuGbWAaNO8GmK3lPatYred94pgkh1e/TiDR2NlTlfv4p49HDRwcnEREncNJbb9gNJg/+fJEhOW8uT5/Ey/H0IXY8A2CBs3BuZNq706FEr5dbukcPj90wiXAJALodzEwPfFTlA69QXBNSm8f2Zz4+j89rSLi/ypGz5Vs7Ysk4wRlWKSfcRi5it9878DE2Gub2gUaw3n2gRGujj3yFxkfd8V03aKxlQTyYdZ9LTKDpG2xON9is4qPC1i18Q4UETBdbXhNMn5YR9Xc4aJnjbIfFMc0fTAf8ikKLHo814a34+4iD2EgvaT5FE0yIWlVeN2ihsPUNvsHfR6mWqM6DlpScV3gqmmMQPipv4+bylkW9JmTWCPEEjPzkcEtRpfjbPVjaCZu+IATXoNyxkkiDElUGODbY1RwILIYVhu2E43O4CFDOS7onUWtIgwNKXNRL0CjP1WAudShuHhPLJMmEH3x2ngtxh2aKE07ke/BKMU/nBZPoUfdb2vckhRIevwISR72syETDn1HGLOlUWa01eUypNJ3qlex65so/0dSJkp/6KMGeZU64biWzJYw5j9SiKvLmSdKw4rL/V6HtVjvtNJPvJQee682l6cXJuaeEum3Z9eUNijwziYlN57XK6lSI4a9KICS8yrR+I2Uu/xsL0NDFrUBSkjdq8Jx0G2BV75Lm9XzQwVI0UDnCVKrg6EApEyOrn1/iSr9SoXy1ikkeJH3ukYRMLihsiLMqI3w8euDjP4W0IFgzK0T6hK7roTSAbi7ufAfL+oajqvEv6X+wTwxNyuTGwlgWXL2LKURHaWuPOmWNF32sxYv6TBYlVqieD9syesfkKPI9UtdX0ND8HXAHB+nFmPOIKyL1KPNR9PKys1aGtG43uLPWCzwMo+hM+hQKlNCfL8ITih2Qe0ZDh6bTZpnqzKyhrJXWDYoobF3xf0oVhwXwVV2VLAJWbS8AHUBjha1Kon5jXAdguwrcvNzAo01/pqRkJxsWArzK0jsNJL6xk5ygSqX9n558IB89DSpqBr0tdYmzyC30dkni2cRRRBKdvaNLGNbpJ8wYQWflB6FoECGIOB9rz6P95uznFnn57QiHDE5YM+iI1vBHF2s9ZAdxfv1dgJXa5U3DYDHyw5q+baoP5FegOjpIQEl+DMgKmIt0EMeY2z+Ph10atYlfP42jLX1Sia/qHYWJj5MUhuQq/unBWg7wTKfKMq3LY0o1gYmTNOrPK5ILZ2G6MIJ4+ynBAWV7Y3D+txIRLrfQ5QDXcdgeBdzn9yVWU5ISZDOWHmuBJ0PPh2kZGmrzScO8L/hqp5cyjX/wvZQSa+MH3NBfXUlMZeaik+ckzKwS6zC9Durvu/QIUtitqJv2FF/MRE7nP9+6nldf1Eq/UoWbQOZImFJNnsl/WmCJ8JVyl0lmELSfXIuidE8V3i/c/+6BVfLdWdhwOK68jR3dZb4RrFnit5FtaotCEfsz2wH0H0ViTx7Jb+nwSSMPVZp6xqpoZTTBOnwdER+ZWKWeHwZr5j8htezAggbiQlqjW8nWpUl/TJu/QlKgmr9zYVeq+6lSM3BiDWexuq3DujIL5YjdpE7V/x9yyRPKWnXdIKHC1tPtkhbUa5NoOTNHI9yjU2af62wtrlyRFyFFkU1xL9g2F6qF/jjzewLsIWyQXbpHCZGSMiGwEhkH9EcPmE3I/3lmVKMa1DtKBocazPaxTAC8DG/vJu46LAD07A1f7szODcChAFMj29T8uAFCjBPP+GdebzyKns9rpIJlGk5OK64LCHTMe0e5MppQGMaLDFC6ZFnu+jEz0sMdH4ALCZRTmvPs1yQFm1HDE08+VnbiL0obyndxWaeIFl92cSBW4cdDeQhV4sNbbqT+MvRPlZWa/1EdTekeB/z42on88p/0kcSjmzw/dapxOi016buZQkh9JcdNWTQt/u+VZbh4DSEf9jVofe8er0fWtHjHJGwQn85Mdz+odQANnEmIyVN0O/3vUR1TE/3xc8nen+LLLr/lKxsZU9t/732kt1yQ7ggbZIOVmj9GGMwchRZcvDIBOp0S77dT5zD8jzQUhaKFmmMwb7MeOS4p7thtz/AkDAGk9b/qXc9HsOPTmcXkFZBXLPmgp2X8oIqO8hA5la8ONXDiPYjnVLwxSnrPheoW4GQ37J537zBpw8FmN45iEjfQdS0ryNr0P/ytB9CR9qyO4kjOcOS4ZB88I5+XcuiPqnPdDUSQRyV7Q+Lzqp6P2bLqcdQ7f/yWzlebONJ5XKCnyu5HzIpp88wwtdjLK7phK9vILxZcwVUn00p3fI0/xtn8A2MH1jYShzOL6p6/K1G6VwlXssMzg3++sPDLvvgh2fv7E66kkv6E7HLsmb2It/enMl4yMTBdbwqLQixj9ljPRWRwOmQTosplxGHT5CHswfGLchZjDKNd7oEhF3+g61Q5L7cJc3zUye79zSYObmOMcn7aUMIgJyeq48hpIueXwO3qu9OzoNvE/YWTLKXUr1ThLcQMKN5QEZG8qZVlQ8c2eLPOzMewnfBn+D4HsKMXH80NRRj70XS/usEK0rrzcbO/atrcsHbaXBTzb18wQYx6PCCSfLv2ItMk6+fsrotOaxKq1Fn4ZlC/rfqk4Xgy//BfNzdT10tVThnhgO2o/BO6YYAP8E7Y21uKob9Tz8dkWejBTE74gdLt4Hfo7fzOJbxWNR+7aTwUWXInQ1+3EvZlETdn3S7mdY9Ha2nwT0jlXD9e4H5qb3rd6DvB/z7Oslvc0uShtjzqJrdWizcZhTCgEW9Op5EhJwebAUUZrnnWAwrWAJokyTIzkPjqdYp8M96ex8AwC5QfcQMSjIufdJx4D7DvqQ2bzPujBzkR7b3pp4LPFEd2LjEB1mc3JnT77K73yz7IBO3r9O8JH8dnlNQ64gk4BjK14sO85ockR48QeLROIAYRMVzs34ziCMO9wvJjlIvGFdQOP21CVHLGqeO0s7FpdVzY17b/n5/7ONruS4X4+NWJEhw3IpK19Zb0Z9rC1cKxGXMe0edMzhdfJrZJ8zDeqA6U6IZtym9dhtMH1n6DXfBC4lbsNAghTrXFQbXPdNbRZzrrymgCU3h5WHdQv479FRBT2Nsb/y9/KkwTwAO8xFk451+Gfw8l2IKTW91wuUlO/u+KCEPv8tu0uSEQTjxv+/JQd8sJGdibfir5vzmtjR98frv1cd8kY46j5rv9/XtbOosBE6cQJb2icvWTybm6FIa8OKEh3oWOVtfo+WCduCJRLUeJNCgBMsAx8uRsSi/uX5R9AEJUk4gYwV439Mtq2zZh67qBFKZUk5XRBJmuBNvB1CUPwQXY6vmHrc1AuOOpc8T8EyryB3yrcJvZ5ZueDx6Pv0QsZHBJAkGNAD+wuBdfdhGZ1tVcvdlUL4uL5f6jG4T5Pp7wOVHuubndix/9e0v/UFRQuB+hGlr2XAmZuJJ6djSu9122imYiMWTBIp0Jnxb/p156eNR2/KgTuE6CvH4bkWEXRW4yFHpOF8Hv8cwE14R6WTNBePnq8jXqD0zw6cw69h9k9Hwk3YQXMuSO9sWWDemPh7XQhjtMFf9c/OO44YR8j1Y/fJBRO/zkvoHxc2dG0rLRSq1pGPqyr+eZ/MO5Mpqwv07BY7YE5cobxfyPixwGo/AYDv4KiMepTSKEDNuaZhWhq31EjwiNjnN1iS7xClcLY4hRZjcUvaS3lN96WzKcg0jsTT99/hoHK88zp/CvmxttgkmHs8RaLXPPUlKo2/jT2mpw/5K2/h3DExcWkefLyfI3YUBldxziz/SdgUVQovhYQzjlg1kvril5r2flvMk7caxJNTSx821tYrSiqFWJiAiKlIu7zSymnMyv/nbH7IF2oWvVcyEK3YKBs6tigvajurNnh5Tp5kcQyxu8/lE0VhQLqa3PcnmcrD/HNLKFHLGRVEiiuhmmgKAF9KPbxwa1++tXdRqXbnU54YTn+H7ewRzJ4rqezFLWQxH/OgDSJj60K1t/WNK3j5fwbMS2OLouaRvJyXSOWDWyf9K/D0x1tUq6QaasWQ/eHPPylAbc2q6FMRj7EIFs33kyS70fivhXDoT+7s35oP/ncrRhc+GPgarz5cuTXjkCsa1lElmOniKVVF82vJwE+OsRHNzVrvS4h+av3/b+cFsetqN0NO69kY+d7XFCgLxOXb9QjU7AGtciRtu+jgrJtn1/vf+VCoX5X7XKteTU9nRfZi+G9Tjn66oX1ArwRPkXWlJKk366rPFrZL+dcoiX6//ZSbYPBRo/DWEXEyRKPiuklBW2oZ4t5CjW5cuPiSp8f1V+YTbMGvdV38j0NX+7O+JJx4cIpKDjyZwgYtt17RzGXZ5YmNMmFoAX+pgklSV2/CqsAxBF5znAGFopWtH1rFpS2YkhDaWFnIPW8wwjyGpGOUH5ktmSCNIETBehnCxUwZogbNt1TVjY82rmvrpjSBW6cokDITp5NFeOB6SM34Qgv/2ibN8iZ1HUbvgqvVBHsURWRn5Bq2LNEvhjBaPCzIfrNT++YfHKqOM+14LeJYplAcy6LEYi7OLnVbZQWkBi0aCvXIw2BS5Kfrl4dGwQAYuiUYUGvF3+hcpgstDCERjznVzbbe7F3s5RRLugIxd+uQqTRhZfCNn7nQuuJSXlGsUn5Lvx6bos6QV9zKMy8cQXAdiGde6IFrt9quTW0h3lbCfOL1S/nOdgwFdG1cGrrVyy8DH5F0qDyUIzPGDMd9Zt6VznizujqVWCjswsSpN6EgZ0bxIS8gXe3wuAMbUjd1NdqS2DZjymM//XDaK6rPEWDMorXOrbQ39zLQIkpnQYTQRMHG9tTDr2XsqflmwpZyubPeTupxWUmN98VZi8YE0o3FLOdjiDAR3CzG8IAv94UaisFQglpjA5fv9TTvANpU8zi6+9eu9lrFBw3QWKdXliIYp3m8rmK7EU1slRcYZhMN2bzuzHm6Hv+xr/CT70BXuz/9utor9jwOnv83GDdIUSrQZ+MCZjChuL0qRQQOzoeB0Y811NSWi7wG5zqqK2J7NG4WrYvdBDi8vtDmdqu5oj2X83fhjVmgpdf/1wlqYylysUm8Lk+GKl6GzqfKtgN79avfmoSPsLrcoUl7YPAL/ObwaFrOL/bJY08AHgnwb9fzSbPzfL/1+b/1Kah2Q/mgk/j7VW/D9jSXVu7wzCgK8Mp2QssrxXVApfiaWUTGiIy2gc4zs8C1ol8Z+grx4+ylWj8hrQKckJSfk/XxkeDgY+Os28jd569pLkPKuzifP3P0pC2+v+BqT2Pc+3KMdnx3yXgTZb34dIYY/mxBHBCe6fADSvFnl8oVUhfWjaA7xA9sGAr1Sqg8ZAu//rKe+3MyhCiBsw5ruU/8N2L36EQv7aISdgm6X8mDwV4tEppsrXrZN5SncY99uwj0SDOSlMjjDzmz/mER2XhAQXPapV7SihqNfO/4fb6O3tuVtUB0PbLv3Pio41//PumRnENOxjaZl5TtSUc74Iry72XJbys9kV9xhos1FMdXxoX3u7PyF/m4ExtUvaUTpw4Y17ok5/U9pR8rWfl/k7T2YNX7cZlOl31SH2bbdNEgXH6i/UnteNGGIFjKnNNBXGYEAnrxCifn1PsuPWdcEyqayaWFR7ixllh6qkR6eUcP8tFPJ3F/axVHYtTAb//tueqNNWdDtKvtFz99qezAmzMqLeKBC5GY9pxBSCsJzgu5UMcxChQB3NLYZp+XTRxBdaFV7R1j2AE3JfY02NW6NubnGuPLEQw1tKZZNdLKVvn4H2VjBbUjwlMHwTRvBdjTZKvypha7kdZc7cadJPNbHIgBa7FexDZLY38NjJGCzyZi2sQ+HaKDn0x7FiQGQK0y72w0e5K9RUWGIh7spvGdEi818UwcAQnuwfwmyptximbU6TpM8yiNHCi/deNg8IIh7BmBPFl2xBMiTLH7ZfqBBqezJLFYZC/oItKX+CsJEalkmPnQVKMIIimgv8ayYEfcRx2ZwU/sGkCLJany5a2egLrYrWLgNtANk8nV/hniHH5cJjZ8F+OcH3nAv86wuEfXlioZU7oOUK/7oQjAnSYWVRmvS6xYxyQ5M286Elv1V5Qv4+hhF8J7jFuIcitGCJhbL5RzQnNOUEskUuqolFbBeEBLlYtCY+tORwvGvZmbfTI5RYaybVYg3uCK0KcaxkjldiKeaXLMhJ2MdXE20I6cEGDhL2BVZrhoDyx4niiH25IjZ2sZS8NGbsFqC9Pp0a9iOOy4qM6LnOTPi09lpYB5qLxjV9zpF6lyQTy8Rk/chKeeLbIk9d1vgnAQieJbhfJ0w3XOuSkKDN3Oelj0hwXQKr3sSj8kwtGSK8+hL/WqScQL7ISTVRnuQ5D5GLohuW7hl6CsS9e7gNBGNOCk2xLWWEPRWKtR1PhQHvMO7vYUDiXQzVRHlj9Sy+EDOkrKVpb4G3E0dN6BPy9xYM6AjbTXUFiNJRmfhw0QVgzTe5I9yrxYmS3Qq2lG2En4MpqijNPGzPcFEIfb4pFPK3CgZ0bN5NddWQFVix8OFybcaYr3dH5Jc/PzP5hed98vI5IYEUpotW6adzvd51G/5cPncnORbFP39pTlrC8LHX7s5juwc32LvGry5V4+UfcwMS5RX5bLuupWVzc7p3V5uU3VE6gO2zMqJ6P2yqtEu0GmzDqlHyAYpgmlFTDWA74X2NO2L/y0jMF9XBZOUIdtI+XnRafFpZ6MOGBIPo91DI3zwYMNny1sYEeE1Q4zxT+9MPNxMtqM0d8augShmHRQlE/ayJ18glhBuilTzSk46RfXKWwMzp1mYp/jNz8LcuPjvUHRSlC/zrYPI+Rh1O1UT5xc/qi6sxvyX4UAxrkLpjaFVs52Rmv2VEzy07d1K/que7cAJbiEL0G75dF92+tvx8Uu+ZvE0WY/NjS7lITpc1ngF48Ob6z9V7y9qvq7evu/LMd4CUF4QEUvlTd6rPuaHXX6Ls8eUNywwvgJI0RP9xWgRZvX3eDpJSGUxWTixRp5LhrRGrEa8xPTVu+BPKO7okhUkgiSmZ3JrncrNGiDJ3Tkiwm2Eqp5HvYo31B9THv5OfxuLXYnWnjnTcvq/35IV8bLgjGkxKYUomec7N9JbsltLIssfOAmryKObniecQGmJkokhOiSDrOQhUiuxfiZEQExM7FoA+g0AgEPcX2pjo6Oif7zyZQSDQpOxgcnR0tDIui9L/LS7GaYMxX4Imyewa0CFO8lPV8Dt2hX4m0KG2JzMoJX/i+SVJHwVddPRPrW6n/99D/DR25RlxobdUsES3HNz6pXdqz/AerLrvTHFiN7yPjyRH3iyYawEIW+7UWEJoiYmJY4q2sPjq08I0wvDDZ8Jfh4XITupOi/1AJQQSokBRGlmrA5cfKr+PcYJAIKb9wa2BEWGAL5owE7OCmgFRn6HlytYv3eG1Dje7JzQ3tCpgldfVD85oLXn5IrYLeU8bu0Lswd+AE0bLGyemFxMOyLbBfT6AYAshnASOBe9asajmkSQmTrZzdvG0scMIAohizccFGBSZcfsH4/jNjPBg8qCN+VxRciaoy8sXvX/RaDw6ks5XzwskRJ0D5H9bjZk46ANIADvQ84Tq60dc2ARzqjfg8vLyLwr4B5OzKh3tOlDoUdrfLj8q+jQCatgKKHc9BO+qsioNPdRBINAv/butVyqxY8AjAAEKRYVoEYwLOwjbDzEyJlxPqEf3OTpamW5GWEyg/s/ogSjS19IAwUFQZjKCe4Bi6mKHa0AXHx2tvDLtJtuQNUpIm9w69JclG0fycys9M5lnELkf943X9QUrn8z7Umc435ZVq9kbT5Wud2tOEaAUhbvclg6dLv9nSYv1B5OzXFHUUWe5kIf2xJHbVWzTVM00fr9X8sfPmAc5mv9gfWKdY+RaCGUouLYxl/IXDM/fOjcHgUBSxRSDyVncaPdhfD9eja/Xd6BmoIgAQn2vMryCjsU8oZj0WuYGBE/BAmjC9PP0mQCXN3f5F7TQUHj3OwV5+QCdOTYluwZpCGvBDxbCRhR7gH3zHVCszb4/hbGmc+tCxq6BuQDyJw9BELHebNi/zVAQHa3s/ndva2BEGv8+euxKNJDg+jUku2VtxcOSBXJlH64bpjviwlXQMPEYIe9YtN5Is/LAyxLxCgSaVDbLos1aQEX8i7D5RpqEKoOW6HVlIEFQATqSJhclF31dZn2ugtyMaFKr161Ojo5Wxmx2Y+/I4kYxfWsfMyEVsfUeDH1SySUtXGi6Yzp6JO8aamwL6/h1v4MZfsaJ/ewZjM0cYgL1M+GMlmfCa1biJwqWrlCZzhX7LQrTDI+rxuUHF+ga2jzGNdOJNTTfyMPh2fizr7ud7BpQIGI1fjnuHvyQ0haZFAu9C+SRAdDAgDYyOlqZyVvB8wuzogFRLKvdBRoksyU/1V3vecHFcWgliqvHVwEufa52ThNU5eXlA8DFXokpYooZU+ZW51KWmCyKIBAo5cdQQOKIi4elwMIOlQaQ1YlKQ15ePvadiueXaX5xhr/jfn83EZgrD2P3hc/BsKxq528jgoI6yZ92vm/lmPMlPTExMfG3D2JKdr58J8nxUez8JMTEyTpfsmmzFowRWoL0JT3K8vLyqrrGnjZ2uKihAIoRyDzuq//uIklb8Dp3tTzEO7RHvfufJoG0FNqshbuwbgH6SxZoR2u0n7y8vPx2ycnWwIgiSr+t5JzjUYjLW8bn2DICppZSskCbHf/9aIdMRe99jiwC32VTmQRNXlx7RsqtAgggV3M4OCry8vI28gpXtMHof4d/Py4dhUC1O6Ojo6OLnl9x1YPD5ARoPdlOcj90Pc8lRsdmfEIk7Aa0JH6iAg2tR/wngQ75s1xOiHZqgpu0ljVDea7n0YzIfv07oIzRai0BWnC+IiAmLmMUpMHnQuPCo+LD91PA9S3Ca3r2YDLIu0i5kPWj8W26KKGPWaO4FXgVKOfAYYAaUI2lWtpSqObNKRtiPyItqRqoG95vbK4blrPyMHEq/Sl6ZGyEAiiMpg/Ud7/xYpfVEepf07ITjoOYyaAt4y5ntLqhHnlDecV9HdtBAYkEm4cWOo4lKGu8LzHKGAVNqOax/NxPjRkMyTM7wryklErLfMghMS1r2Vdi4rZ2cSQp7ULjLXfx3zfLyouKoouFvKChzfF+AWlZ3GhBgHlhf9c3gboS2ygUKolrlg1Pl1+zzHBNgaZ/jwh/0UHCQaGs9k13grY9ukp2wjI1hvJTcysPxSPH+fYpuWh3ojXyW4xrHldMXrhvnHpHbsO4ww/DsRHiRWt8sadoEPoTGmhH23eq1c6Rdtwg9u+7O8sPPxT6dZUGZxVCExwCclvcs7pvGjAxmLIff7Q8fZ8685EQFJQFefPV0HgMhph/PTnxAkESWzqMN5qUbOxC2SSxSoyWVo65xPPEBeqZsAMbSm5gLaFM+3c7UoyB9hukhY4IQk/pDm8xQZPVkcfhkSo0c8Aj1r1IDc8MiyJgGCUKtTFrwceuwf5TLZ6EdN1KD+hjaVOSQsOdWYH2OZWgSddIO94yoamm4TddOyu09ZA6bojASVFWcPcIBbtYZNPEr5WmTOdACkaxMa7jrb+b1It5yKPxnaCQXVm7hq9ZwRMjj3iSOPdk85GokcOedeiQ9Cz8rAVMGE7XyjGKlb6Hm4PdPjmd1gozacBVnIM4uWg5EhLwIatteCQRhf7lFy+jpo3PkQksB7Mn37KCWai7qid3I8SSHQnUt81UcQWXPvApcY9atpypeakljrj4Iz9SE4gxVnupXfHXa9s1xGWZoUnKZpoI5zkFRtUtksFnRzT566FgMrkDUjsXQhXehQQ+EZbRrBWhLR0lO+HFkcSXzAoWjBV8+4pxgH4ANGshEYy+fsSLuqMkZIZRyjXGo++XBMmTUsJEraU+kll+SKcd5zYD7bLcshZE/Q1Cdvc8mXqXJwGelPP0h3xZC5YVsoEjXA1/nap/fzbGHnFh6tqIfGJy++AiYkgP7xkJSVUnq/4QObv4eQS8IvF99wyBfpjHNr7lcIXnah36qoh1xIVUUCKhpvUyFFtLUkOAAD40QsB1VnQknQBnzGcJD//QXYYvaEI8z/N9l+7oMeP7piNIMGdu5JGSCnW2QjxPmE7W76QM9MiJ1bbn6Ctc3xtHUKJ4CfoSoOzlxNbitlX3ZW0knYmrLfEf6/tW8IuuEQJUTY5db16SPXKpvPsb0PuDx9eyroU34fyDzuyWLLJW9ai5hLsJqJoC9QrLD/Z07QYU/aRX56LJ8Kpb8xPtDfrHaedpDEETDFHMea7+xlwUSeVSo/NX7yfGzrdsI/zlrMMVwsFYXprpI+ut5agi2Qv0RyG2BMeUvSv2WQsxCAPd7DGtTImrs66PsKj5plE6s6Umrj8i9VooHYkuIQ0txzP/VIqdmTnf0DOR2YwMcyGFuyK2QlGhRDitUnNjOVkLhpbWlqRIGazUKoldsYefLU8lHzcF+esDVfLDrilqgqrnFFXSk9a0bm2Q1BHHnI2gn201X9ZGZgE8LJLe3lY4EDqI3Gnz+Iq9XbwtsaXXKg9mnS/mvxGA9J+j4tGo1/Vp2DLljoRuIi13fSwR0zMjFfmfJX80Da6szQ02nXKgNqlFNlBue2vukhw9pmTlogY1dzc2A+FU8BfwiZUfvi+WarbaqlTN1zRiaw6BQXmX+tP3yyNHnP9UztAmnQPiUQkAIXpBomaunBuPGrx6Po8uIy6CSNBivsk5EQQDokj/ySB1IyQ1lXIQNvoR+wyNcvTa9wylQ1AQekUqkcAd2SLjYGdArEoQb8MkaNJo4lQDdrCyaUkMjJDI87KxwyVKt+2HIOs0x9nNynJBEZqlNndiluRQi/6VJMiV2pl6/Laf1dWP1K6YcmK3IqOMwEQQ1xVDhpeDlxcJ0ZleJdiZxafl6oXgHd/7PHWJmAluRF7E/v3Ad47JljvXoxCqE7cuWJMj9VpX2Fr2wlgTpgjUOPlF58g2I17GD3/86+AfO8PWspclPe6UnuWq5yKpLWsKyxBUhFSEytLmVWCXJwDwP1iA5WBn6FNZi4yhqbfXzpHgyoNQV8SkNXa3fy0FU4hoE9PRI9rJj+iSfdbyqAAdgaBmJfmp/omPxkKCS9k1fC8vzWUOzC3ZoGsDK+PszmuC0NCZlQ6V+aR/W/FR47hfHe3oDNSTvHlOPOVbgCMVpaMb+5aojLsaXg0t/UH5TENMYoscckuZOS3Tc8ptSoJYw5MyyKNjjyv3lsAR1QQd+J039An1/Yxt+o9RupGDsA9d2bl5fFOHcpnx+ds9FWcmAeAUF4s3DT1/X8uOjzYfhXgwhisoB0TLqBrePlhFyikBd7KNRng3E+GBLlr9OueXsdPz9Oum+t2cYimSD8l8g3UzXhHfnTeXgy2gHe33NRtNd+gRo/z1y8qhX6ZXXy0qRBwLjj4OSke1vWLl99bTZI7NqiQwlTVkodjXtARCn+zY1MrOto7siYXr2pm638eRBnwe+lupPVpInLlg1TPq2p7PBy60pmNRUploulISztc0TH6J/+eo3i+waVcwQkO3v+luYrDmHTf8TiYsF0urgz8dTdrEVP7tadz2dHRJHPGl2AG73SX9xpGSQcsdUKFfFzpIQZOKLUVRO4Yu7HMVGBcDCx4UJjEhaST+VzMavbT8k+noUaQLtiwEtEtXuvnQvJAPb7tJHHf+2zCVV/grer8rsoasljMPVPSOjUg/LMHr6rglUN1np2rzSOSzazWCN3Phg0LvlKxWphelxNdS80LRDuaRhZgLIHSNid10MW35AV3W47eMvehBnF16QzYXIb9MxhmxftFldqZzIKvY7NK+kNjiS7mlUG8/njIUaP7ekYxK34Tz6Mr4yxClnWJ2RkaV9NNVsglvJUGThr9+zQp1YjtviTUsWMl4vxAzpyFlUtY/HzffrSv0pv5g9l/6VENoigcM0e86WSyLaMpyDHDSLMiqJOzhErhJbZHJm/NPo42JP+gImydP5x9WPyM6ixDjXQhpmlmJil6q2z5nZT5SUMU5tGV/dyMFlfle6hf7ebrxBcA8VtKGu6vtG5+KOn/q3JxgPX5cfPGwXp2S1Y/BiVvqRQ6xlEWVPIEO6oUgXUG1cqvcqDXSBnWVKTWtzBc6w+PYiK5k13F0pzQqfK+rlkF9+OXfIj+X5J+gXwp1Ncx2VP+Muj6kLX9Jox196VaXpSxyi+9BIy+LfYaSAkPsKAiZTY0Is4vnwVhI9aCxDgE5Lf14KpcVRVy9FMmj7ugEHOW+2sTLD+obuBjtuKIwBORlBV6QwHez6grlFWxD0PcV9iN6QWbqysOtgREKlnadPbPseG6fNFPvjniXxwKQtWMB20gj3ZvM0mxF8lolSztclLU4F2n8+coqXB47r1f/5HYwcxY2FNBTpldj2Y2bRD0I0XwMBj+/8J71+mIrA+P6xSLfPOEeQzO0KGqEavaTMyyKafHQ2c6o/MWcxgisSyRsKpagaLsdh4ty4fIEBlvcGV2xQEtMdJHGEK1AQN5w6qAoU3w5oJrwHlg5Hrm7z2VWKrdxuDPE3CEu+9oio1XXZBvrj/1LDycNz/Yr1YQ3seWcVWFZYpYrXgXNxPmHrbV32y8X6B7PkkerX+K9Fhk8DmthWnq0T1awSH2hzf8aBSpUn6mA5eNDtB6SXfLZP8L2gvZPQ6tmkSz/WdBLvXme7pgIp3FHVgoaxeKfgy7SBGxuDp4YJ2Xi44LZpNBQ3GtPm7PesGBcPMyNkPWk7wucOtaR0yVAezKPU82UQg/qxdkS8boBLB6M1ViHRs2JcAD8Tg6RRqzBlrlwih6lKy5oApjn/b7LhPiDHqVrMohTMd9El7r88AjaLPvtj5BLdr3i5SMIkyFq7N/s+bBZGpHlilFBPHHOBQ0tjqYSV0ms43LUG0+eNq+b8lJXif0Vm1isMEK6LdlDrlyRsxKmwLSYm+niyuR16b8hnJZLEFSINORIvY+/56i7mVWgMNJQjf5KPuHtKhhooe2hVzqtYbdTlLr1yrf4xSuxlG2qWId6HRWTmDelgqS6A25WnR56jL6pC6SxBEVVIy5cJ1kD2x2Qq3mwmQ95y4kVvWX+B+vdQfKywaCqNqbs1f2jOXJyJbZB7QNPRbYqt4/KdD3MSmeAcko6rLCWDk3T3PSZ1Ko9Rb568rBDpmzzZDyZoa+HWqVrqXasCzC7OZ26q8Xi5NErDtH0/fBM/KArjcjMHCutjdjPFMa/f8XnFZbBPJjxSUxGhHxVvOkD/mGfFfasdOgRB9rUKqjx+l7Ny5b6ULZcrbKyfDmM3r0w/WJXqZzQpNWmYCpV2pA24UU2pcCuQ3lF0ihb8ZOlQWVmZ4kp+8hsA2LxfmQkmoY1KPmaPFKqf0QfVTjJZfXavJCCEoWf8LzwVbtL/2CEeYsQKW5iIZldA4fH2i7LkfnfaD2TQW1Pr9i2QQ6xlD5lYe4E/w88LY/K6oSDhpZJ34HsnekIGPGhplokne3YJdOBaP42doDDL4u6E5Avz3ADev8pmkd3kIZRsngyPH+wS83r55LXF1stypWvRY70CQ66vp7eHB5aLaS4iYV4izbCDLVyTjvClVXaEftMS+TZtCxWIYk76ARc4p9BWQuSEeunQlqkBp8bmWyZldx/+hMku124yOcINBDdUEJDE+FmZY0akbpO7FmbtfbyUybLD+nfN8vKi0C+LlJVmSx/NEiXvH66YS+dmcovS9lbdXqQuQZafor2ZWNTkMAtzmNKPrBy0wMf2FOcRThOt2xPrL4ZmcWHRUvVHYKWpDSCeFVT8YgNTnzMLUdBQBXhdunX33df2F0+gqzZDQ0usDjFSAaPPkULg9WDHkk9Zv6ysZ5h7L8qEV3eR+wqYOp41Q5OvG4zfDO18gN4pSCctLBUrZuwGj87QsFC5qPh9bGl/yh8Ltk3tTBzFBk56nBGC1rhsCuFn28NrNijsikImX06vQwdTsiqROmZdhLnXXA0236pEm+9M0Smb1ONYRWKTdlu9rnlifTaRH4q2LkZ5CPzskRf1/EMa0kjsS7+ynbXlqTsY+ZWKey7S+3Mt33Ds1fCdvhlCS50lmUDDaqJk2l1GaB/nDrWAZbNGupJJ0JdeV7FDiJisWUtfBUqaRIKL93Ahizd+kchDB4dvy/Vh87nPmSNqi2blkVFL8U3ZvTOCgdkwW+NNMhzaBM4BaIpOtjcslUr6YNGXMwsN6NL7FkDx9K+x+cVcrCWxJKVnaSR0MX6d5lGf2iUGVhIfDOimM92jqw7q5iNc4Ga8I6JOP2D2LTowNbZLjLGEhyaK7MqDb0lxWBBqQtijiJiQwJJylJKoD/icb/Rc+2sOj222Lcd/SgWbYQVD9A2zI9ef6jR4e1rUT34lKBCtF3Ayqkz6iK9see68udIhIJOq9w3tdAgZkkWZdqLWQdmU6wxMn+fbtegOvHPe2gkHeAb6yD+94gfXTzQ7IA2az4YLYdDWOzD6aVOawqf91fWkkwrkXlsQ4HvZrvoZkuqiSlVs7vJozNezDrt89lKPvAY+jfLXQTgHs33dscYFDEuq1OD2nUb/L5fiioKXmNqxjssutv5GCYvkueMjKmw0Yy3kyxoSimBWLyuaMICQdkhPzFeJ1m5fRsH7lDSKQNtjIx83N9rTyu5qEQr4CZs5Goyu23ZWdW+9+4bSUeXJps4t4KGlvz1s5tT8RR6XbdiKEgz0+kvXyTFf+auj9rALv7ZN2tBEXFLrKHlc5UJjmjj8d3PFAmbDGX0KZg/rFalYfS4igrnBGUfrfzh1CFkrrKzYikWE1dJtIKhEeZb7xKMMYEOxbLgdTSkuImFpGa/it8AewieJEvAoW/uCQhttRhizJKblSbCvV9uL4aQwIv+vtkuSqXP3RH4aUbh1/rRKyb+FbtMB9l65O+M1r6f92LZEGDOknPRk/RdKI3uZP9edIuMo10GgEHTsTkqi27vqFbuTzFfPXrYA1P26tZRIzmdllwPq1L2PH3PJW2aVPCWJJJlcicpOB5ne79I5TIsUEt+KjVl6KmYU1CMxFFNgEuCwLDyzy/UGvVhNdKnnQO/ydRC/gh8s5bKunMehBXxYTP3kRtFDu2os10LxQsVqR5prLSAJ4+rudEDb2Q9u0ajQH3aykOnwMbQt/3AiDRaFQP+en5EJOIu7Iq1Grx7YP8rwwxPUu3WcyFpIlhFUd0DPoeV9pFF08XzHMUJ/mT+CTbPuu83mVq4Tl5mwSmZrTwoFZIfXTLHSv7EOTH4tZCr20Y10SZxxEVacGlo5HF85FEz82+o1AlTF8nR63dW08LQfJSvJBPeXoImlX/9rFjUxtjkhaTYymi5PNLLxMMiOYJ7f7Q80UVTax41kJcFEl2GqenuSnZpphQqCfzkLn+TPh2WlSBcHzx6ENTV81gezhSWEG3S9nKhZ9WGlYJaXpGB5ehx2rXNbKUqx4BXxZd/7lLUct+qe4C5RMmPo2Ay1FZcJdHqKhfl7zFScpLIrqEpSwM1D4T36UA28w03uueVRFiHopDUwIh4E9uWo5V8zl24M05ioaMetMpIk8mN6SAGvZ+YpWygAyw/Jbj8MPN9k1rFaFA7vct68qWdcHXWYSpelhlhEImfQe/7i++ZbCNQ4BIlnfILwaUUOCaHq0DO25TrMQMf2hQGaCgBnO/7bp5dA2Tin3ym8jKRzR+pLlc2paNHyQIO1/jiES67JzJHLEEaW7t8Vr0FqY98NqwzYh/5/MVGez5sRhPXp9iyeiy9Glj6+b0+y4o+qA8QSE43oJyyJWnX8l1iWarkR8u7XE3vttRrcvx9gIGPn3QZiuASzjX1v5ZQyl1yWanPGVgSM1psLl8lgxvmDkJFdHKcwy+G7olyPa8yRg9okUzsGlDBeoXlh2KBjezcLG75ZY17raOqRccFB+XKLB4mrs/G0sUr/aW+KWe+iFjiQ47j16gsSilMgiaxNUNcJRALtq/vxC5CkJ1boTLJrXnMByUPoBMdYvSeERf91WOjlE0VrURF0vSFx5JryLaD+wYrPrQX/6fdtNf0myPpqRFN1ERVx+ZPWRctd18ENrI/ZtKvi8SYmJnANVMLJQR+zrQWYgjWu2KlAs94lk6YIYIQtdNmTD/EinVk9FZuFStzKuePn3dUMyxK/CK/7cPjYgmKwuskXx3ILstbYB9VXd+zfc6jfUsl/ORZW3AvIWiCNs/9fVdhUBI25JNNu/K1wHVkO1lHcFD01CC8+vcC2tN7KHFIjIXcK9RLG4Jj9rCWlqxcAG3qpLeuoMnUyjEHU9V7uwbxGgX5Kd2UW40UjQN9KsBcU+BB4rkOPzTfym4O1aRESGpgpH3vyPYq5FhpucZt7hwLejt8QLGRryh8lLJwmkIqmJPEN3HlsPhklK1C5fbAi1w5ak9vCZ1dGdez0otODcuk2ez5sPl36+uiTYNUbU7phz3GGe0Rn1rU90daC1YBEQzARz3vl7Xiqokp4mi1WhmFyuNOxawjLm+7SCZ9Dd5Iqr+6h1e0uO8fycyhLW003TEh/hBrEArVT+J4su7EGg2eZhS5pdpsHbmTbO/3lvZuD50S2JNSfCvKMoTQHlva7RM1fFT10kGXMvZK6+cbeBfZsLQNkS7LzBolvCeer03ofsI+IKjYOFrzubL3wte06pF8z4N78M77Jbe0sinLQ9Wub3XZfMHrC9qZ+BYtzEeiCW8RQc76WVP16uQpW6kuf2alOTuH8q1S6hCNS89zLRRM4H7Lnf/KecTvj2NsFdtqgrVI0bo8Z/H3VAaLlBI/S4YuLshSLbmUl/uq6QTrtSOkBKZMD48Iyei0KGzZlPZzFjbEvupyWNk9aSynvinHDfNZeYCqxsJ2jtwBP5SEVsSMbHVKt9h9d6JL7FsCR2rCR8wOmPeLcRNkcdaPREgaPXnssCQIJYiu6qd9pLronlcm3z+TGKoZdYxU2syFPsSVAK0ArujICvQw/U/WKS+/VibuuzHS7dVpFxa1hN6x/Sm79QsfWfmc0ZGKeCktwliHMwGI20S4Tdoca2qGrUMLVHPnCwkLqR0bSm2q9xrapc9VPjkl2LbZ1mu2oeluFFFJrDHs1xXfFP7fppxe5ayXVcl/jjP19CteK/kEz3azs8nkPu0UU1CAyGzl8D6rjJjcEmGGJLX66b/8nuOkbUTIUYh++D+9MvYlvp5z+ra1EX1OjjCbjKPMRUWz10b1ffRxLj/yCrM9r36EqYGy6R3s+HMQDeWzUl3+LEoGRBYaPiiWSV/HOFmUL1vExbsZ++GBcz6p26Xsw1+1mI6auU+xBbfb1kCS+Go7VoGJk5e7KUcUZ3z8iVbD+z2mS41Nd2u48QWuTcxKR9L4cIFuRv76ueJAWjyuFwuXxsh99L5JfIoK8TBJ+N1ZxOCpY2xQlyTf91EWDqzsnPkth0rZws/fNTDyYhwMBmqasipxXOgnfnIGJrxcayLtGP/t6yyo2+iKz6zG/2Rhc0aNsF+Wavhtava6DEN9hHRb1YZ35fPQSANAP8Zh8ZfI7xfNbHUrdcZGphaDl+L8NPAjRzuF84h+yfNWlK/GiCavZHtPb+l/r4W2C7IPsZlb6MLJmZYeh1aOuQQyYVkL34Xsx4gjesn4z97FUhnH+Y//GBT+oa3oS7nOyBopjFvdmImZ/ylmiUjYeHKYNpXWDHnEu+V/JPknnbbpTq9upUc6w+Ut44rrjwHf+rB8CmrljGgNMmfGMgkfHfkpqVGuRnpBQRqN5aGZ1BUpuz8J8soNRDGHUsaNA4ZFhoMf9yxA2dtSP2ej6ddbM5ifhOWWisOSdoJCBtfe2LSoLT88yjLtEiSoJpKwfW7fmZWNcLU4rcMlqncCl0+fQk1sWhJwE2SHiLweXOzt/DURSbvEiSubtQL+bEoZKIS2DqF+Aj8Umj9nVardw9Lq4+KL4+LLxcNaWVRc1YvvJKNmrVb3FaFJZZecCz3TjaiuV9No9oDqkp9ZmNfK9GxqARHWwkwyBUtQtYHXYqrSBx7UBEbY87zjVMwG+woNXshJEHAXtG9A9+atVR5f4+t6KDQAJGiSUjPhkl115Ftdm8O0VJlZIZ6SrVrS8pV0Qqi64Feu8jGxL7Ln65hBJojj4RFjQOfwdKDots47/xfT0koyGf0+ugadkyiN8lKjC/0pe3ct7+dZa8kEOF0fSwtNtIVk4WHeHu92p/2oTWCpZu/5G7P1I8I/kCrZP3m0LL90rS59qRtYC567m9/6WyVb+JfErgGAb9a5eVRN0rgZTL9yzH/sYacjo+GZ7jIDCbY5O4yLV1D1wCeq3wxWmvPVuV2XI9ZwnmAynabX7ifR5+0V7xv5maF1ZcRYZ1Y9guvPuytF70NxFtGUmPVL6memi0Kdimat8p3VVTetMseWmgaLz46VONflIYTM3t2y/YswL+qODyRxkiyFloVUsp4M+kJNubSopeco/ApSoO2dZAYj+KZ8JC1vymvJJufM3796eCTiCyFQocyhTWBcA8fqJKQqH9cYRo5FalsH5Gl6XYmIiyo5xi6b3TtsXJl8/PzLKailpYdVydELnA2as3I04yjptwsVdL5aJfYCVio0Bo6uYPS4DaYMq7KWJbbI4OApCrnury2O6GMIxzkY5zi31Gy9yoK8Zt983zFS//urt/qgdtfILMpteWIh2g8i4QC6wdOftbnRWQmYk/oybQrLDZiT4Talf34ObC/9qVXV5qAU/NlgkrMU03OOtbZx9OSw2capbTNOHIGvbDHyO8Sny0lkl6D2rK+52VxBM+C7ZVlLv9vcxBip3Qg/JRu/8j/NeBcRwpMb1M3Pnl8Ji1znaolqnGmOV+D067KpTNBQgmunvm4CvvpxMuIkm3R9SZd5kk+K2rVbKnvW4H7t75kFv6RSPrhrj7hIW+ZHl/xqDp/NallDWSZbuCcSNCka8CsyHDTNn2LKtsvWdbA7Rp9VdrwM2GpqUZyQUgJ0gNpNH2oY2CzgepwN5uDCVCbL0G8ZIfz3yTXr+8p9JB3H9AiCoqevktimKetSxV61tgYUGATcAkTsCiMfxzFHXcrwjqmnnL2rWuypFWCm64PJLeNo0m9vJSP1z4D2OQtWvfIsyeaJLo02Bdp6rxZWt09gm6906yacR1rzueSc75R+irE3WSj7h2i9glNvntk1OCR3KtcuP1C8dF0df7UR4K+S/tj/s9LMMVEgIBFylRN2bCvpwoJMshNuCPvMPCaXfJi97HVO3WUKNyf1Erx7NwJUrxsAleDJHrp988mQHH0C1d2QIJ7IqRK/MG2Lx9EZL0W+Q7VkJkQ1UGy3mHvFIqYalHuNTxjlqqr8WS+i4gWhQoPzFpGDQP/GX9hm6YeWNXS8YqGcJPgL0RaivT/xW8bQDl5W4cEVwrDdZFcZRzt+BcniwFyMopkXi0JGefmsJUrXETY+NpVfnoNiTsRKwA87SW8v5IZwBqWq/VGSS6N2XZbD1D2RYeveSw6ys4gmd/mcTJrSgASOFK6vN7Y2Z/5hFmykB8WRyw/oyA/RJUwEGSxL7MVStKPfMZ4LzSwpJWatSMxGj7/6CHS7BssxxsGBR5KFsegl2zbhERfeAJubR6yxl8G9G7N2DefLj9Z1azqL4316yIr4WpX2dq2g44nIiFXWsurMqOSfTfLAorkuGnWcd7OaZYPEYS35Cpc3SkTCb1o1ewdUfUj5mOoOCRQwdFgFTZIP0NVp+lcsUMY0HYV5a3awnoJ5J69MxJkWrvU5No7GUS2GIQPv7SimnF+tOBIpqxkL89Unp//tP8eaaxBS2h3U3lDeaL6wa+SHpGb1fmHPIGBpgfbPvl34hS3nPIgahrtbWXzHtOvtoCHndPGPCXlMvciQoZeYke1xZRTxMDs5Unv1qkmLoIj2TvLwIe8YJ41ZaVBmBAUx6krbLgRJywoGrZXa8IwluDTyQfSSw8lGR7bZglYboLnu35xV8gMbbr4GeKfSw7l1Etu6V6yj3IvfqFB/+hjD21JHBPFXPf7gXU0a9jNm0ivwZIFOmRM10ElgDp4O2xPrWvkBlEC9+ulxfY9wHz2AwhaN4TKfQ1Dw79RPcWt9U7BLfgicVk1XEwvntxV/+db8pm6IufLdN5TA8fXjK5XHJWWnpcNV+cFVA5kRyMAkIs1uFveOQDi2RQZgmjrpoiFo4k4Q2mHRw+4U7GqQTtvZ0dfEBkj6eUPBgHkkXF/6AZyS2yKDasqwEITiZIjZO7KKbqqaySAoQkD792gntW0jcCVX/vbR30stUVEA6CqwlNCOs5xZ8OAZsRp0AZ/VGvl8KVHlKmiCOi/8J7Oj6e7QVWkGnUMjEZCSdWBCjKPwy9oRiSeY42LH+Ycy66eosNzSw+hB3CeFlPot++2ASd7LwuWHr1uhayYueiu9KwxAHjXHhpgsbpH70BEX8i7azmTTTC6mDJ4UvE8HKJLp6zVQoEj2wtcUm13znc/m5vaXCQ5QNr0JepXDHWL9PFqU53fLmA7u0a7zoQBo5gJU0diYy7WJaEDn23GgD1yFtdzVDX2SNS0PebTmePPUZljBd2hn3+pV/Ssz9MkY2iKS1SKjQCUbItX93jpMMDUQwrT/MmtB0k64c0S/UjxdkrlcflzZseHSJV/kcDEkoYZbo9JHwRMNeZRESlJEI+6XI40MDYsaOaC0E+aJ+h1gLhAurVbsANQUrj/ExSljsmvIzMpVh4dN7XmbAoOcGjr2e7f+onbtpSYfLJ92TLl9S0IJ3XsFYterO5zqj8n3Ej0IDTMZdSm/L4RFZlXa2a2h0rOef+/TS6qKyzTPy0VJwXds1HQMbGjpPxpe+NXMKvYhdPTtYiIffwNpYfg46lXWwqBdw6esYg28SjpBk+GRx/ysh59/vZrujrE5M6y4AfeDI+1pLqvGYoImiJF2gDoKQbzo1jhi7Eg6sPIHexPm3J+UgvQpnWxXeztcllQvEXXHQJ0UbzVKtao7rc/SOy6+zqgGOQsxPpUa9nZqWQv2owcBXe9utSPWuxVV9ONWsyZd/Nx7VrB/jSrq3DG+/lu3hYVYWYNcAcN5iSzCIG6KxCHY5piSVnb92QsluX2G5IL7IvVJbCJflcIksM7CG3jYI+RU1lJ/zEhG0Azhg6vs+OulBe/wICIFde4jYxtzKAzeV6E0SdArR0TqjaIRrqNIGzpW75upgATJjbYiNfgdNkLe0o4mKpQMLmNktHxBI8rWNpTPVz8cxoaVc+pm7mFoq9xC36gsnKXZ0nDMFoxuKgUPnGsP+xfhpj5+cwEoQC7QI+rAoWNNQZe92B0Oj5SiSZCCscOzZX0rU8zGm9wO1VLxNs2Y2ioFZXqLIap98jcWQ4EWqaf0BgYPgnq/obdXX8YfoF2RvUy/mur2xrWRV/XBW+PzBeD+xT77paR3wLKVuyP1rpODVUQmTEZreGcx7IQIahY53hg847FzT8el8DeealcwP/+eJnF6uxQmo2bOq9aeWZU2zX/L7tH98jAgusb7QhHJEJHmpRXQaZGYxY1TgVWBVoHaimnIdOOVhefu09IyXkahwDhyILq4xOQ+D0aMY98dtaZo6X0+aGvEfh8UZP8tdxU2HGYmpYfJsGvquNb5+qjdh2bn2MnO99X6WhUpZnPgD7kdM/YsexGqiXOZlQdKhf5UEcGcN5nlc7uRI1PYMZM2lgNyo7ldWUYMNcQnIV3dV3YR5ONGGpCr4bP4Sic7X/qndgHuuCyEKv024Zy5wQg6dUxLBxqtjd0OoIPVZJLI2fTUaWUPiS8YmPlZVEr5b1OWIrzUPlRsveWryI1XiOzF/CcTczUSnq7iFJkMrcf2RGkg19s7yknhQef0rIdUvkZ7Uf5gFL8JueTfYqp7JJVfWvuI8+5XcSs0bv2ulUOwg1afghMnvp5vFLmETWEroFI6nF1nYA9HFUcuB/8eEQbd07Q3fmzcY1p2v8Lkq7fHojF7+vi7/snydGeTXkxkdKSPqOHB6MtJoNXC1jg5FuFXZ5JtvNduj46amt/qt/5qaajEf8MYnOCDdiBGqpjJXt3RKid7BAEAuOjPWIDdHCNfJxTLiy0W7uWHyqKTbf8IEpzDbExDXv82a4XMrFGAOfM0kkrh+ooKn0s5YOG2whxT+vCIPxAf/xkKu2Khnse3tOJ9fv7TXGzX7egxOssV5R7v2kbTvYWsgKxHs/6hLWfBOcL5/NfWn9aHl+JB7lRq/zpIs4+SELo/11AmSidpzUQYA5mPHmNbGAgq1Pw8d4/4OzHOagIcDZDwD5r8DOuRC1p/6YzTT+QzoVbnRDVGfT1YAUJiql9/d9YmNIdfchDi2hMwStIWH7bNYTNNaxnH/15aJaxQzIysARWIX2284t/LbOlQuCPfz0fnGeL/OjTDIfZi++gKfpcXUbuJq52ihw3NT15+SVh4lvbJ1gvZiOg7Wh0zd9T9u5LfMuzoPpy/tCw1XHU8+Wf5gaQLcjlhw0llZDAOWCto05ja/7YCLvohr6lUmmDMqtS9/MBw1I77HjxZjc35PUC7uKNo5cE+ohLkBhNIxHvEcdk7yve+olz0B0JD6fyE1mr2GLOAKb9+4E87Tyur8E7yRzDvMu9dfSPbVO0yDz/2vWJaanP3loB25PBhCpX3mS390kyMbLpbOXqk7rJp6cYykRVawesagslhDUdx/nmf2vkPsYNtVrHyl1/2bcu/o8dG2tXquajZOuY/7r/9PQx2rY4ew1q0mPWSHlE+L6lOxy5jB1Eb9q40el3JCFVCbXjqLunxmwKNiQcXHeU9hUZWLLyv8pvuMCIYG92JcViEe9sQ1Vgt/byHRxLwOw96jjiDsRX75jstLJH+J0bvt9CPnNivK/ua7v6JpTv8XdgaWGFAqxqDDKBrBC+mQz2v+lObCjiJ6NMjS8eJzmWwu+1o4HeLR4+VW/ZtGV9VDD/RlSejm7W0j2nTp2ic+b500ajD9yBPKb8UeJ+5x4p0jzho1R9mTh1lk1EvxfwJzOWuH9+j79qL21x+qAJpWto9aRjOSrMlVHhND41Iy96jjdEtP9SBNncxNKq9pnmeEVyp7wCepM32Yg/1EQKgDNRQ7yvf7cnXiVm5yqVueXbiBZ89VwKxnzS0mJUqR1zou+av71LmFCNeUFlzYtmzFoRMMkWYR0ukzOUimBQoC7h0dUa8JGRTfuLXcNysvsxnPHjwpS5YjXTzRBesB0bTcASiGYo1mCcImXjyUvEg4NSMRhzN2zmk21irCvCOR/xrNaNetv2+Ec2XLtSp2/LQJ8POdb3vOymFTfz1ttgzZQbMI2bGee+O180iuqyOQVxMDDOn2WJRc+WtZrSexqcBeSfDgdcXZOuywSAj6QWvERfG1SqPnyT/D0bONSrJfP/iqFOCXdFKLM16AMfKUqRoNCWnAIn+k5Vm/vOGxiIrNU3SETKiqAEUmIc0RR0ttfIydtETGmQ9XuaEzkMdzSY5ipqWaQlDWpo42lm05rw/r/dee//W+u23n++r2ZiS/V5pjVc7E1p+SGr8PGIuDUXV/GZae+649uZZNoned7bhaN/caNkwyGWsjYjMVsv2zk+fFsaDR9k8l1cxs+ETb9cIN1+IAbppPPDu9KthYeZluc20PCwivP9qGJov2a/9TmffN2e5sLtaqMCi22E4fF23iFHUMCpE1S5b6XZFxHkKkx2KMZ0fYzJiywdTNj6vROmBg4UXRRp+1PU2ekW4B0118jVlPI4ho1S4Ea5suy74FEIp7IDDF6okCZZVxhf+NUvwwYBCrEza19OyXpctnJumVKxdCiRfh/Gm+bDO5rA97aCItY1QZzhumqeBAhGdDMP75ebHCbtUIgqxniDMCyLc8CZxpvkrXBWi1xCsAm7fff9cmBS2uqnrSGAwoPgtuQ1Pa/AkOFbl9XCp324PJNhaO3Vt2nTpn+nOxyUVpr4SUMcb+nf4aRxwQLF5nUqaYPFP4Ta/EtHPTeFW9P1f2kXNQCjHxxDUDoraJLVJnBLN4wiOD6AoEoNGbtlL5Nb1kRRAqc8K4lOeGw1zkrJhNPgUf19q+jYL9WUrUxmjv0y1vqUDVq3bQuAiG+t6Eii4YHf8bVWnskmBESiwaPGkWJv+RKMup55Yr/+YEc+aLHE5aLekRkx3IK7ZW5m01ePGmBezWLPzyNkwoGsDsRfdiCsbizY9Cu/8wHH65CqmF87tCclBLMzDiLs7mkHj6qb28eLKt3QKjcXm25MkCep8TWk0JwDo8iNGOvy4uMD7xtjiXdTxYxJ5Nc/qG+mAScAWAnd147XBFApup9OekJx1uSOjVW3IXFexVrtUmpjxUPPMc6sjup3qi559Dur4oSwvA6P1BOAbzFZ9Y/b08b8x5nVtwODuRd6IHstVGh2KnTs/kpnSmChBdQkWrSwKAUsw3xeIatvoFWSysz75oLcH7ZpVsSMWyff/K/WBaESj4cGhlaIwGP7DLlmaYFkYB0ylLfipO687DQek1bmnijudIOqCJwAwMxPP0qRxt339Ut8iVt3IAVDHHzadWukiF71uhcPXd2/O/pANHB1LZ8gorHSezUnKP3hunw813xPRz8m+GZEmTDtnPL43ylQrrYet8ytejvKxRaVDpmer/k7AJfquGe4BjlbZ4YOBrndBTIMofelflYMRFNzOAeD26HAr5LfgSdenXFcxEsEmXU6xk6ZWaQYOsQIAxTIizzsliDVZqp6FgeGvCb7jSOaMVhU4YNxy0J7NhuQ22QnklZ+T7qFfXFRg0S79u40i7ch29ewb6j+xwIOZgLuZrN7Sxtlu4GLVopWCFWOpbNLqRlTEjomfjX1zHba7HUHXAn0hpGs7pOajI/SG2C2kau7+Z6DINeRsPCfPEQuajrGzbZ2X52Ns/goSd/Z2p+G6WuHxk1Cny1J9i+GUp6crul2g1C2Lckh0ytTWQxtN5NrGynfWwQZWS9tGA/A0wKCDLchIS5sHDREbGv9x3zs/xzECW4UwOw2Guiwtcvj1jUnu7JQn4nTA/UuKF+Q8S+kpRQW21EvE1SXWwt1wYtbgKdbeHIQsLB7X1QQnnhok1eFpxPMhGwmWDIaMos5V1iazi/CT9hM/3rmC2nQ4fiOzQe1gvO6hWEPsbYH/YJxYfmaCzGb3KvVSSAmVuQwMYTIel1/BFFr3F90Bk7/31E75x1XNGSE3yAA5QaGm+aEomduiRMRD+V1w/GbS5Q/LzeQM/m3dMJVFYk/xmLGDmYN3oJ2CHQKe4Nb5W4YsS5QselUPsgfIlKcmXU7dcvfWJlRB+VdqqYrmlCyMhOfd4cSF5tXrBVy7AnfMIq+C1sxbNjs2ERw3wEPQT4qXOwr074XvLWdazC8xzLiWbf1VkYKLuRjdLwosyC3yzpTqZrJibRb2zU0VDxymDOV4PKIdWJWB2AHXHmN8XbEmX+BmyKp8F0TBHW6w7a3Z/t0vHqxseg0P9Ts3DyPe+vslKJyRM+Tp5wjGvV3uEful3mrdThSUn4++H0gPWFIj1tpBzn1zVloRjIpGnd23LEC/wc9xLIZ6B/fA/bOfeVMGv3wwTaztlTxS3VkgOzO9yIEccrTqKUjP963PPC8VoCB71H2Jf3TThKsPstghquzGQ+ANsZ4AXRWe5PGYbv6lI6fdU1DmyFpfY7ECCxb61B+ACoW2Ke9ta3B33gjIu5Kw9jH48UtTvuZ1PvhMA7Lv11pbinpv3f+PW4QneT/0P1Ll46J6J+3qkXjSTVvv5dxzf2K9yho7DO5eOydGDv7Ne9W8sGITR/6L0L22QiAVlxRYNBoNrmLI/mfxwxebYI5I+nNz3GIEAoHYQ91Hub2LeeE/AwBxWFtjuIsAAA==",
+	"static/branding/cayley_bottom.svg":                                      "H4sIAAAAAAAC/+RZa2/bONb+nl/Bl8WLmcFGEkXJN8XyINtOscEWyaJJtxggwIKWaJlbitSStC311y+om2XH6bQZDzrA+kvA51z4PDyHlMTM/89xLjwPvJZFpVi2NgAjPwQPawpek4rTClxvzFoq7YJrzkHtooGimqotTd0Lz7PR71hChaYp2IiUKmDWFFwXJFnTznIJ/kmVZlIA7CLwo3WArQn+dGVTVHIDclIBIQ3YaArMmmmwYpwCWia0MIAJkMi84IyIhIIdM+t6njaLZQJ+bXPIpSFMAAISWVRAroaOgJiWtP2tjSkiz9vtdi6pCbtSZR5vXLX37ub1L7f3vzjYRW3QB8Gptgvwnw1TNAXLCpCi4CwhS04BJzsgFSCZojQFRlrSO8UME9kl0HJldkRRmyZl2ii23JiDNesoMn3gIAUgAsDre3BzD8Ffr+9v7i9tko83D3+7+/AAPl6/f399+3Dzyz24ew9e392+uXm4ubu9B3dvwfXtr+DvN7dvLgFlZk0VoGWhrAKpALOr2RQR3FN6QGElG0q6oAlbsQRwIrINySjI5JYqwUQGCqpypm1VNSAitWk4y5khpoae6HIvHGdxMf+5zDnYNu0QQ99FEFCRyJSJLIYfHt46Uwi0ISIlXAoaQyHhz4uLud5mFwCAMudCR2kSw7Z2xUbxumpp4lFOcyqM9nzX9+DePdm7J4oSw7Y0kXkuha4jhX41cFbpKoaDxtgFtZM/m808hD2MHZWuHF0JQ0rnMFRvs1OhGCHk6W229/w6r0jLlBUyZb17B7hablRCV1Jl1BXUeG8e3vRGB7mpSQdpmPikE1LQg1k7sFkBklNdkIRqr8Pr+DW1Gz6G2Ef1eFA2vwZ2LDXrvZ2lMdTbDDeDNlPURyE3nLohULNZMKtdOspRKhPLIYZJfer8aymNkbnbLUefipaFVMaxB0Pj/yF6XMucPi6JIp8etUoemwyP2vZh8mhoaR4PkxbidNIyLVgM8QidtFa9dXEBwDynhqTEkAsAWt0dEoa1BwBzla6i92/eNiMA5kkSfZTqUzsEAFgHspQbE0O46OF5mkQrqXJiFiwnGbVN8Zcy53NvbzhwNlVBe6BNq2jTIif3SZrkzAZ594ZxfmMngcA7SsoMp3tw7rXsW23eQNzc67TXo76otkLpltFdk6MgGU0klyqGr1b1DzaGpVQpVZ1pXP8OTLIgCTNVDP0Wlst/08QYyamyj4MYtv0HQKZYehLfsJSeMvR1tvT6iU5a9ZqkchdDfGzcMZHKndNvhslzHt1+8sNJAPet0y9U2AXqtdxZKTFcEa5pi66YcXKiMiYcI4s9ywHO6cqcNKhm5hOWZlvsTfXcdrn04ey9mM/SuofuGIdoOhkfm5Myhr4/c0dPDFUMp9NnlqaM4Ww2ecZYxXA0ei4yJyXL2WeanihbslGKCuNwUlE1OJsGLlqQwsm4XBLe6W17vHexlWiiALD7JoZlZTHYgbZQFghQMOlBmhf2TK2fa6Me3TLNlpzG0KgN3fsK+wKRHqGWWutvs3MmqJaCV0duUrGMiTKGDg5H7iSYBdOiPLJWMZyEbjgLJ+NxUba7fe493as1ntKV3renHYVdiCXRmEo/hn4nrMS2RO2g8mM4xi4a48lo1mH4KWaT23xdC2lT2ZXRRslPNHo1q39XzbDZXRF2R88wmQ2p+C/mMj0Hl9EBlwD9Bhd/6k7GfjgNj7j46Oxkpi/mgr8DFzRyg3GI8DGX8HsU6TkyZ+neAA3IHDTvcUG+1Lz+Obp3ehYq+By9e552wfjcVF7eLfhlrZswlfCWTf187enYh+q4G6jBiWxnbMJw36HdaX+YYg8Pc/WoKgdZ92g1RBsxK8Z59CrE09Gqk2kUEdq+tNpnFhGaE0N/RJcOdmfBBIdj/6dnJfZrbGlh3x3PppPgy0KnJ4UG6An8NOOL5abLMOyf+i+XO/1mtSdkDfP8IWJX4TJE6HeL/ZbuDfApmX/y5j2opo+mboB8PPmyzvDry3mc8Xs37+Fe/Uq942/YrGcTfKYG9r+lgU+fSn9QAzcPkt8vcPYNCsOTJ9E+xZ9D4orL3XspTfsYz3lUX3nFsGjvswe6Ot8A4WMKUhhHs880muCivGqG1hQJezXDG2RLFCPCHGC7+uP/ANJGUZOsO8y+ILR3BJGPR/9/xakxVDntZ2SEivJqJ1V6ADRrgupfPXDaW4zIb18nIiEFbSZckZzxKrorqAD3ROgrp/vIdRpC7X1vfYe7d/vyWvuj9rXwJ7hoVplmTIrhx/EeDRCewMVc0cR0Do2LRQKEZ3APtxcqARrVL1iTgam/SfGnLg79YBhWxhBj5E78CR4N4CqGDrKJ8Ayj2dBysrS2bebenngj7R9EkWNhFgtQ4A8ipDQLAOb29vG32u3J5D5C/2uNVdZ3w+NwGgaT/oPDfm9M3QkK/OFdmV3SAAVP3mSsxP66xWqEi7nRBenbsPdU0q639T+4v6mdAxTsG+MUq5O8znc4BAg146MCWtUO4SwTkTZEmacVbf/P5OQypRFXjlm2QSJZS9VGnSwVeGezfLlgPxx5XzZ/foCL5t+Ec69evsXcs5MuLub20npx8d8BABE270lfHAAA",
+	"static/branding/cayley_side.png":                                        "H4sIAAAAAAAC/2z8d1ST2/MHjAZClRZAUKQZqnQk9Kr0IiAlFOkIQXoQpFcNXVB6B0Fq6ER6iwrSQlB6J4AYelHp7S7O+Z53vffe37MW/7CfvWfPzGdmz8yePLFP9TSobt27BQAAqLQ0VQ0BAKJKAIBAlYwEAACsG3f6AwAAIm9lLWMyMjKyYDKHGAAAQA7XNPcGAHj++SP44/bqZjaTj5qZj5EnzMfP7qUjwM/PT9jZw9X7uR3cUdjzpVPOrvw9AOA+QEv1sbF/7vaCX7AD9PL6YGqmZJsuUOM9B0Exp8RtA7LH9zUNnDleE2qESYKKW8JvgzniyOLWPr4uf/DhAYVqagtH3L3i4waC4mMOUcG6tYsraZRNumNKq1SWxS/s+an/T4KVVu+lLAyXn+Jx7ogXmEi1OMDl0oPPVghnhb51GIa93KOP4I3g/XWWfbnEtrtOpdIanWFJdehTDfD5Pbllvzs10A7om7MR8L4+B+YB37z7xWatevNwcer18ifVVx62HwPagUEAC+Bqzn4dDWEGQUpEmO/fqCxd/yd800/1p9p6pgbY0KW6gdNysbVpQ7KkJJ4uo8/jYm6e28q9/EkGyMP2hIDK9brQb2VB1C3JZu9b2j5KPRk6CR7lUlGwWg2vjqlNWYiFVDT8fVCPDmCCu+PRuLdvR3g5bp5RY36T2OKnW3704SIEBaQmwg++iduDV1yf8ZtZqyhYxQP7AAUk6UIPvumga8ON5EhJCoQfvPmHuCY/v0ks19Mtv9VwYIRReNqtRMicDzrcSDbwK0QtOulFeZnrY/uHf6RtB16vYo74991xGGzEZ9N4as5/CIvRMVSq3is/bLeK8ARsMeQ53q0FLBF2jf1gdlGLdjY9XAs9B9qQmvBJY+H5PIyWukNAtRu5FVcZV9ExqVcvuRPkErhZ+KQj8gn1iEJLYdun9zY3ZsmlWafr1gXJdYkTt1juXrj/ePyPtFV/ldqn9Vu6XHrIew2ML6s5MZXkRCjIBn79qEZa0VaYXxMZ/ic8JA4rpm0KPPelpuwy8wHR3jxJM8ZVdFkq1UtC1JaU22Y+6Q8D702jPkpdtK/fh0qMjzXSeU11JsRjxdSNImj8qCkXTf83kTbcS40UxBf/rNuC/m7xIQUUMEQwNX62wBtPbaimomBlAZD6fhbJHy4R/rkwv4a/Y/df7gjFUdpE5YftbDg624MilAPOAl3P2mln8uMx0ra8bIserR9Orubk3JCFTsoDvnG6w/ove7M5cdDRI5fL3Xi0OfqkCOWwro1bZe1cTit68wl55hFWRtDHmMf9XIlYcUpGVLpz/f6/us//RPl09ln1Uh5lrkojBRTQThhWedieWPTmU92f8tDCWzgQrqEwvwbZzfsPleJi46rkJuiW3zmhjYCsRqIkSajBll/N+v1qWHnZ1l0cusj754s7K4mZ/6zO0WpUrz1rWL3krp3LN+ahSJhr6BNjIjY+dmj3oXadhVg6uLxsC1ek8i+Yzg1G+J3lGkObCeGc+zVFqNsvlhIX4V4/wIMe5WWuCjgbNIeaE19/Dpo6D/jm+oHsP7NiPtEwVBZXj58tEOH20DA1J75pcdu5xEX48x+PB13Ky1ydcUpol5t/o9C/buYJ/TePVAiY6uvNxm9M8IRIuHJELKWV8tBnmMYy7hYUMATIoDn0EbL8nyadafhNtObKD9tFAOcMeR9VTYiE+WRVtUKA003rIO4Gxf2P//EQ9F3skTNFY2jz3X1AnfEPc+0XtYmL++1FKqm9T/WngsKDwonjsIZiBNNF3rb7UUX/M6NGZ1jap/jnTwtdpEMaQ6VsfoC1fxobl2ffIQ1GrYP+kaRhCL+J1trE2UIcelkN/6BeIlwlntqAREXBihl4zpj3saeJeXNjtvW/t935TbTkpLOmn1pG+dlXL726zVpEhL2PvZ+8nKc4PlbSzfrPa8lMIbj32pWH7a/C38RhfxCmAxMFZVWTogLbfIna4rE/CCUBigY+ZP/IoEJYwizj5t0BtIca/gVtLWGl398opzjq5ObCfGHSeUo3Y590xgOyOhgejWNWI/3XdjZ/YPgrqp51z91mQI6a3ZVuWwcRnz7gNy6pF4Nr/gD/i6ls46pk/rnKdYBQuVUBqjOe+vvrv7mx/0iG55+hznWA0Iu1Z91zLeuggHubG89A0xXrLEIvtgrza0riqMH/MDPx0T7N2LB6qZq4CuqTyNio8YPg5SSVoKFPDKM423Tjf5JR4DfRglaYTqw3zr395HsemqO1B/lXybIMlcVI4/C7nVQul7sKatH9KiF3HjFYqu+pqChY0f8/ZIrs04z1qpeEKNzMfRIZp4R7u4RtT4tUPtVRr4hp/8t1qUG9NvKF6d9ZtkrfDYsJ4SWn9NmSOr5/5sv8IA2sKNqJo35KYDF1tkCoFt3/+UiNtBzQufSvNCpuWH5WsQ5gEGUzK1wHyXx3ddw2EZQN/wcrxvFxUEO1PX7ZR2rGZwEsmxv3aAQtfBJvZUqPjzWq/idR2Zs1XKKZHgeUn/1pYTljnRaEhlX/C7bEv491+Usm9xhJGPlkNdSMA2EME9dXv4vxhv/MzVbv5a+4F/LCIx+plAH9/xsQ0nD5vweG91/8nwPZa3HUT8HQMOHKq8s1rZGSfzYhnhAHNZSovc36QRl5ZWlyeT6Ukq/zrxbuMVQWu+Q/kH0UaXzZWnV2nMGrkPGvqxLnN9GaWFfx/vN/Dsib+4TfqjjzNj/cE9EPFP7XlzobVyUjAxgzrX1i4srP3LKQYWEBo97/KkL3Bi/aGp5kaCM1fHkM8s595P/vDtXOcPMsaaFscqQkNsAr0xsxE8tb/WcQ1AyVxXHjSmUys8sBbtF7D11HfKgpsYKyiH8VFX2zQDMj6wfCq7kfzLN2k0nWAs/L8E//FY12L39F0VA89VOCDAbW+7w7v0NgWntS/4qAn6Gy+KVs4FchNKca/oXXjmFP6y1/1DpISPCDfdq/u8uKgxpCzwAKgSPAKQZWDpo84BsRYJiQrAZFe4W37f7Bf/6osdyMGyrRug4Q2h5MXPyuR4U6fMakoOD3r+B8bmzBiuKTypt9wU9DdOgZHvoMlFYgv8m/xjdWYcadNv6DuRQYJiirQZFNYmnlEyP/ippSkfyibJ1FaL0QG/FZ7v/L/rJgIcV+rzQ8/avXQcQvmmcchj8cZ/yzFQflXv6KBiUADQNrUW+uuU/464vb/q3rICEH2f3L/7YrcUNx4gfzIlGigKxGpf9ZgkRB9/4Be7ehTyLHxata/ZHrk809Vn5ZDYpTOzwaB1SL/sdDJ7m8BJfX1hmbVi/J4NRtV4tQqea2LJf6YQnXw2Z2jF7pPw4Wz53isWkSEAuQNGGmuMcvTGRzx2azoHQ1q+nj0t9idYF4NM77P7flMorhr/iUuAgK/xiPNfClGW9aCYOYhJ/IfQYaDW8PwSTD6gcansOCCP2AIegvqG/v8IWvoGNPcJQMyH9DO50bb/3qbxQfuiAOa2AC+UI4fzUYjb8aiSdYItgVRVn91k9jhVgsYUoB3aSLEPz18U+k61MLn1j7141RvOGo/0eopY7ho4wulx5AHKPxh5BoOZuZyDnrxc4fdZXhIkF7e0NgQx6MGx/uNg6CPln+JnT586MbfOGRR+szTGEe8I2S0H9ovIkOJF0udwlxKWr4BylSMCHMzzK954GZ7x1OtllxekeL1yJzDsPPYX8ICoB6hHS6ObMnnmEOB04Fg2ImAm3M0x+8beGw/8VSxbPiQ6qzAxkRpPHUBhyo3WBHfHxZNHktL4uS0oFMrgtsEaN9ff4V40vkAcTy2BXest1Ut7Bkyw3iQ9lW4yVwgH/EVKyd690si1d+UxsUh+UbTdS+tjMqNpcN/EqB9kALqDnxxT5vvjzhPid0BXbreM2Lod9F5AxvA7O4mDkhFl8xwqRXlFUpK9+ZI6qP5c4q29tBioSYfi7brVNjPBo3/B8yjj44ho/6ulx6mNqK7VhVXTpqePrPJfcHLm+42X5NfXcoh3uwdxmWk+o+jnEzwPnjRG0jbkcrEgcztKCDrsO6b0cHtimS5s6Nv6/Gb+UB39gI/CNRTZQM3qPkoHGvE4xo/NPPyeFp8G+kwwrMtfZJfG1JE/zho1THw+NrA5tZvwdw/jqfuL5g1hNJeNtx12RqKt+4d9gtaSoz4d5CT8ASsd6fxRHvbwtP9adoIkYSZRxcz4D7P7ARjy8LQbS0SYMG9drFHf8LvLoJj0RkNbBspJI761ScvjRSqN6IWgKb+0dI7T8nfYDufO8ow3SMG/PK27oEIzZZJunRoGu05puowLZfpDUkitLI8OotX9tKbMTn+fh3NxALePr/Wp0+3DViOB6bnOKMPpcL/KqrRSOKOiZcIvDVC6gJA0YH7HlqBljaw448cJS2S6nRi8Qsu53XOqPAqMC2dEIPgIlOQI3JoWCdOR6N+/qvMQ3ExUE51krs0z41Puu2YGyhnG5YF2QKIp+u/CjFJfwdM4DxBTNWT2p7mQFCCYIngq5/vsDKPUmP7Zmx0ADSG7GcEXo1Tb/rOK7wa2Te3NgkquFa8P4exwb03WK521HybxhrbvgfC6ZbfhSEJMENP6YZXyntLycuwq/N2Howv0i7NAVPBNNXvpvGdhZjfy92f9Q3GaQ8p3My6pTrtYNEsBPyBu7Z3B06jQ5sYwQKkyiKp/fx1T+2HcFGfKbNiI2LSU3w9jCw1V2hnHaMlZvCXuFX0IizqdeHYYmki7lbWxIrCQn4cPyWzQiAZqo7nxKMGdzJoUvCVJhxG1rzm8SOT5wtGPSUbkFnueGA6faPUh3jVaTiKMcYqmka8IL34f16jXHHj9L4bz1dxnPF8n9FerjD/EoU8PCowLZv9DkD7ItVfYKxNgTRPtSUiRUfHnAkc+PnpW2tVuiudCMaY9WQDC+lL31wBrb88DkWm+NIEOwnPJTWWYyhUnVQp5c/SWb8zEpk39X2RxFK/LMMmpa1E3yY3MyFceO0J3CeS43WAzO+330CT1yLQTUoqrV9mQb2fJn+mTpDx7y5IXv3hKDukQm+f0DQ1hCPxknFU3NwiDUHerl4295ZWcrRDY+koDrU8wRIEUwRXAOumJT966c1OZIR/1P8P0lXHpPdFxu8d62IqU+6QTZ1cNdHqQuudylZM9bo5nCoIYsk/cnDfYm9yz9//DHE4V/DV7VMpYMjm9NZurJWWgyYNjcCleB8XllaQ1kSoRc5qzDshRkejWNQc6KlzRqWaQbVMdcJeM0cjvbyaAsB/ACXaAb04TPFB4Z6/CaxqRlxUA7vh6hYisSgwy6qO50XC3ms1+gfhlMZ1yTUe+tU1wN/imGSBNK3pBnNHvQWihDyEir9XRwpV5qMjoBESBjK6wFCqZ/8XsTPejqXl7k62rNXdxJk4Fv7Vk4ZTOw3oywwwe5JvZJuv8S0aJN+wLEyuDc9V0nsxIx84k+9FVQELa0IKRHvh9860Wrp9/In/Tas13YQhvkSwm+hi/4oDEfFW38bpPcUloaqHANtdtapukXEEFnVqtvnjWGDKnskWMIjQt8nAdvd4V87jqVK5WsqogLbJMGM1U0iYhqcIiKtlCrMbt90hgSVzpUue4OWjBx4aJ1tsK29AJrgfB+Ij8WEEToSLaJM1nyHVb144T9MYgqt3VrftcJIhTrRKh2eP3TMfT9X9BIxL46htdD6coFDY/MTmCauI9Ss4NcxeRKW1c7rV1F4TffyMlde+881PyL1a6wt/A3YLMPo882dGwMul9qD9kRG5FJiPong5/l7EvipS5+hykdbsqZtfheBXks2g/jCbzABLbEaUc1ezsE8Z5rz9qz5hva2Wd419bugfhrFbVRLF2+cx9q5rOHIb0zgv3dN5fbY7e3itmPAWHgt0DWt+inxdxnRE17b5yuG6dEUicD9v5XxIqeHW0p/k5i+3kYCsFXH5PS0A6neuwvY1gJClR57aDsj8v5V9YnMfoz3rgPesvJo8PZNuD9cRVcBQVWHhyWKOoCmXa4ZlFbDaQDtU90mw4qsAAncCHoNzcDauRyZi5XjgTWRwVkOVAZePw9u0Qn/2Hns+IBQQnx8rBHwXSU4L/iuWehPzuxfxDjtFdc1JDbi8xRmu72n9PCrzLegMHXhDdXiHWl8OgkPQcHz7TAK5KYKGFEZTw0e1RNH/eO56AgwGIJrAklWmCagreN474Wl9ZYf877JsksKj0ZQckvKdwdcLWvn8iGPUqgHxu0VLrpnRfeZUYRKx3GYpwfygvLQR2bFDbUQ225ZPfbTSF4zOO8JSc0Wy91gC6ANxOL1ibywBOagFuDqv1fg8VKY1vk5ttULfSfcaCCUccIHR7uy95U0IjqcN3zq14EE1V2aJ/cZR3hAfgVWsMRaPwLXyW7GEelzKzDiUEwxOgaj9j8QE4mi3oT/0bj7m2LvMiKBrWxyz4QL+8E8wz+p3f7SNcC2i5Y6hM0/19/gUnrfBueCi0pcnAxLyZ750iO3qSF6Jrd5h9YJqjD7yZb43ubGJpfHUBePZ6feIrFg377OJ6CXacVMbUpfLHv/1bSMqL/TR+/smWtgqOiX9386jkl5L9lUVdVXcucAJ+R1ASQ01yRwTi/1v93hc+EaqFnnEZlmyjrJg9Lx0p78t3ninnemVZTJlFxHn8dqKv/PJYwebQ6N7zqdcD+rJXQN3Ctg2ZFxufRgqRP0ZODioaOg1gfof/r9tiJp3KkqhO6EpE6Znu23xEpikJP/XpnE8oG2U3mZq+KKq9eRqyOLJ4NYwTbL3VzF2T5J1AFaCH32lTKL2G23s9v5Ex5E64UdJs2lZBnul3IZJespGnewj9gsLcpJlsOn398FzAG3AVWKg9GBJGat63saxaz/s1CtOQhqLOK5+t2XucddIrXyUDuYVQ8rAarl4xWZV/pEL5O8b0nWzBE6lTbqYiyoW9c6QLX42Wb7hRWRj8QG2JMkFGjJovzgEPMMP7+J1gjvZOqqRnfk3laWlskx/rf4dnM2OLvCjthwHlE22UeguqZjaz85ejEUQ8RYxKVWs3wRiU+x/MIrtN3ieY4R29QkZvsYnc9JOTMSFGZwQ+5erncQAE+g8/ag8ErDsiE4lMxuM/MNA1qm+V7dHbioV+sx3JrUhBwsn6IjShvAfEPStaXrAcfOyhP13cK/DAzI5IcocpgvC5x9nzz5DtKasOvJBRwl5yX3p7iJwEMUVZA90+D8u6m1FnTeSYmnhORkmt6ldXYX3gReoadvQUdA58w1dMqZ48hI/nDQFxUwAqJM5kqbccuaskPmXeEd5FNuDo7fetnm3O5DmLaI7reiP+/tXR4FrDk7wo4e4izQSD7qHDW3jqCO46tWGTZXAhwc5z+6w3Iotv8Kx7J32aEI6mfBVNGcbJKp6B0tFqQasdA6G2BbydHEPSWeHw8f1LMvjyiTpW1rkOFyLRilKU9YPHeEW6zJlmtWDGM/SQ1X0fGJMFQWC00w6y7domzW/fvE9vne5c5piYsCrAm8b4V7w0dzyQxvOb5qomULCvhAIx3jM0ReRqjhv1f7JKuietzvRUfBMvOSPnASObgbzzEafMj6mwdokfgbDAMsEehRgzsmP9ly43MtiE4o6zzIlo3U8LTOTv9BPB2z7Y1u5aQ8TTq+ul5LK5vInBlFV6ALiy30LmxuIt89w1xXehyLbaU4U4QS0OZocSnld0uTHYyUUIndrl9t8fzyZRwnh1CPTLM8nGp/0UCxRyliJAq062bNT5vkY/w/9nYyZ+h6ZqR0qCA/uW0TZDJKtRxgf4AFpHo/q7kptcbDPQFOgXs27Gutx5zC9OZ8vXaQGN1A/d+L3dLZf0yysmRwgbYo2ij31c5rJ70k1WJiaXw6JyNS/c/yDjfuwUqykhUiTrPw/0VMBn1H4+5vx71Lvz+DY/wYN6eVlwnifj+AcDC86vjqgMfBjEw66uQxry5wHx9BD/izOMLimsj/ECUUC2GJsnAJoK9rPQ41Ca1QLa7a1LmuIZhk7V32IwhCgEbdisEcyUz/mYYArIkaziy6JKqySzx5vDjSLwJtzZrJRpujhfloLmngmcehom+eCNAzt5W5rwdpKIxJDGPHBzHbpugrTkqZ6aAw6xsjyc31ZkUrhR8nrOzrRLxRJquVlEqL6V//z8otHqKUgHKJog5Oe5cFvweNYLCmu/sSK4KfTo1Yzri8Mlpt/Pcgl8TPYXukWJ5L0rIloM3BotL4hXF1H8aEaBcoG8X0W9zLeVvgtIQuScwLm8ApabQksVaICqdXJgsZ1ufmEJP8Dw+SmO2v6DVOyo3jxbJOsloWCDMpC2UuiWDKynepGEid3p9FvC++I/94rKdjqFT6L9VKw7cu+T/paVmsNMEhCLCT/967AYF3Manx3rtuOB0+miD6aTmEqMwbTQ6O56X2aZoBFWbc7p8xjIBdosm7sH4fW4W9y8vTEn52yFy6tJHzx9Toqwhpo43Pv8q0v2EYubFE53QbTq9ZKS7EtrJsHWEw+pghW1MmWH8Oum0x6DCb1hmCbR0hVHoSMJ2D7lMmM3UAaakW890goVbcAhv/qeHr3a6nxPudvsLGWTP8MF9yuNDGLagfYGHx/N1t83LC/cXu7c7rW3Vs3wtWvjOEU4Q/hsxxpMCFPnTprBpwUpoDpCFl0hyGdNhWqohPOYnlN+6C43lRFtQwANbEAleqWxdVyec+Wj21Ca0YLOxqX6zv4ehF3I5W1M/t/bvasFvjBJOjzeAuXejcN+1ZoCjo5zIbyJppRDdxUs78CFJ6b8pGy6fXWXimi37GR21UqK36LAp2owrYTXk2YZkCCuhrdi7cXO0pneV93/FUAnjCVt9y/KaBEQnYTPg9RUpNaq3tnwmkBuxKoVY3dVZnh+16ovq/ygxhouZ0jVSRa5ajDEjCfa9/EmqHKnGUNv8wRpLEw/bky3Y1mmfxPEkx1zQuy/1WLtAt6/f3yoibooFHKNIta2a059dyNOGqEcuZjb1IjQ6qH2oCg/XgXaRt/i6GKdXKcyQr4ed30Y58NHLcCLg9Y4CqasOHrH9DeuPsrEvgNAwBZv+7aJNjms+RNfMbLRUOjIXEJN8jTNxfbL7IewmZC58OcmxPjb6iksYEhQWNqaS9zxICPiFmZIb1U/TMvBL5maGt+nsl14KohdmMr9cuAxhEZGUWp0nxX747KIYKCY9Xv/vbYu9yMWCNLy5LSNPylh9LnWacig2JHjH2aLE7S62pKHNGI8KToLJE2o1nnx5HtHfpnUUkQAuRe53EjAxbCrru7fgdoxma640HOC8uHRNaIEDW2AfJ/1SX/3UpyRg3joOVgDW7qA7qjqmga3hoxW8nWDs9BXVlmbQb4763bfLx1Ybgy7THnewTtGiuD5y9hSIEvPTxEpCGfhMSmKQSnLful6gKDMAcsLc3KJIW4zAs08wkqvm7q1QEmBMJmvXVsKJ1tvrHDO9V6UBZh/cj+cM9TVufOsK2smcA9Uua2coTShHDncekpq1PiXGdYQtBSxqC3y6Sp+9Kx/bGtlMuGix51SV3HI/F9nYsYzSC2VSLn2E5Fg6XDfiNY28qEs6wNOPErM4c6UqXQrsoeeqqtc7atQx+dlgTF/zWvvmoBF3YbenZIKXRhgFtCEooHBUhZMg2q7+9AR2gcYX5MogaGnj47V2uBazRJm2/Lao4a0T7bhpxc1RrQ27cIaSyOK5r4GbbzytNysVQp5htip7S6QLegEKXe15ac0Fz/+y7O79FZYU3gteQZZZi3hm2JIlavpfc3CBCn3FBhX8CsbhfK8qRTIWf98f9vR3dQe3/D3K5KPhNtEoyZybRoZyUTETCGD49YYwbKc4EF4RO5qPuzDQrlh0Iun7Gs/zs7bGi/14YeRXxXwJPLnmfUJwmLhQiN47h/9M+ERQmAt8lU5GNpbpQK24MuefvGXdQ9uK04Kb8qDYEKBHDYAikADmUEC6A1eL59c8MfjlYk+w+q21zavQiJcuvzu6a0wpkL8YE2EUVzLVW2AyIDtq7XrwwPoWgemNRR+lssH4B9D3onjePIfTQHGqk1aWyyYr+BkYMeoiBOXYeDwHUbs6NCkrIufg+ijbKEtep9KBMOushSi18NRZlPirYHUgiTS09EqQkEO8GdYTNESwRS2oGrHOjDRbPNbIL+G1gR+a4aE5KmZGgMPjN4RGQ630OcE5/vcem/LRWlokenwv8RQF+IiIb/0mEofLbNxkpajP/xFq18NgeE85a7MFhQ3qCzjN+Ey3ucQppo4i1KKbf/HWlx1cDF4YSpiG/ph/3rDkPlUn/1cWx4LYWzzMgMZOMGDd5+IP9V1EFduIiY+GeBEtua2vph3vXsCiR6WGOXa4Ao5ujkinXW0rsoupn6wKLsqasyexbbw+OOhIvmctn6LgeV5Tjlk08kD6cOGAryuK+cHvYmxOpP1q4+rOpr+gdtC4Y4fTyXL3Yvdws7a23B62951Rj7N6BxNa1ZZZDdWQpAQ1IqVaXeJfkilbZXzQFbUh+w0HmjFTER427YgNB1wkdv8VzstzV5is8xlKjrbXmKzxc/hg1lp0lt6TEJGzjJ2tf4//rBdDxWn+Ofrh4buOUJEBzyAQLY7XrV+o8nvuZpqH6DSvTrCDK3QvOhnIDpPGMvIwyUtVh7oRVt1re6p1K73vi+HH6YIR4TqFDzVVwVbbtOgUYkV7glceAn9fmauc7gZMt9/Lk6vciA+BYITI3oJvN9Wyqz1SnloL6vPmRCLoEjDAVk+7LlMan886oRvSpkI3A8sqgMnjZWNTevVYRhCiVcVXyrfJjj7ac5YweBaKqnU79WcF2cYwbN45S3eOeksgxwOZo8br1tIIIjZkkwRIqpv7+HkYbFLUwfWE8A0HpMLVGT4XudKGKNQW8sJds05Bl+DDS5Tm2NTLcPYJ104b5vZUdf6xF7A57KK3yg+BfLd4ejisSTh/NeY6jQZODYEOdl9gF6qoC2/V7YEThP8zISOMliagJjkjT+0//1hJkECqR1vANNTzGz+v1cARwhpGBi3e85xhvAhkjcWGTTqIqkLI/6w0joqiBt/MOFx8Xz5fwaWVMGDcO+G04Zd3TOBUbDuyZzFRQ7bdmGVGUVWzC7pSml9n9K3D+z84w/nhL8wqpvO7oFsRzazbiyd+L+kMiaTFvo7w9nHHkK4mlWi9MoDleWCENQVhoqlm59pgARERiRW+kjEHh+YCMn8T3FNv1STAizDGvbEIaL8ntkT7ev6RCxv5iVGauy2wtN4ddGnQiOJCBcNphW3HKXmK0+oQ8Xgu8a1bx+fsGul2ZbMSxANkojacmxOqL/iUFI0ZGrtjTZqytZlOydtD9kUjXZ3VPG6XgInCxAQooJHa4dmp/saBaGRracWxKuEVoQ5C8OPMKXRZ+Prz9AiKw2nstH7DHzsGIj/rjoW6dLMCxduCVfAfJBqjpF41PRXh78OHn7+R5h/EyQsI0c7Wgk91Q1nDSXT9snoFNomnqH7pEuQdDIKFtI/K+hE+FyVw/c5nxuXLhJ62rvKllT4nrpSZ70dXY1oV4SjrlTGLL97brFz1Mzr/1PgZ6usLSPlUFfsXPxUS+Dsgid9vu7FboNv7oBNvjPCoJDBtUqSWg9kzbQw1i3Mxx4rix4namfQC8+Dj0Jc/nbAyGkWSX1OQurF98ZRup8UeHm8NZBu9BspgBPzkAI8YaMJy9FXuYZiBctI7060i+O/EIgDQaVIM578G2kvcQ2nxcMxIBDo3sDnFUFLQzKJPpznwqtXIykcErUuZSVxn5pCOESYPJljc49HZzLfKm73b9GMVbqJBpXdc0RZ55ZzgM7LqDEQm/Lmiyzbmrt68fBhfewvFWP0U/RDkOb/OiE4oFjBVULVdaQgSCC3bQQddvj6+e2tMPhhVL+7zCiUGYRVFJ1mMluM4whpscjYcuqDpWU0sGP8kZUOjC47XRYPzW28MLP2+AfoOeQCt+8i28tVLzxlQaj63e08vMCSxIW+K62/aA8zjMTSPofZXGqNowv3EjJ37eHttqA4pXqjYsf92S/EsYP2+tliuP2AfsHxOpSCGPL5Ob3wZ+K46DjiZj3LRWxFqXO4hy3xvlGjxEnQ5va65QpqloXoUGM0gnTot3laRLN1HsXQotnrtHhESIX0J8AR4SqL0Jm1XHIwqcEufF9Xn2zGRAJG3S+Gjw0y5ZxL4/TIvLD9sqkeeNp/NbkX3j0D7dmD1CAMS29r5U9BwtCddSQjdSCBFgMr13gegdNMFNP0tJuE6ut9JLrGM9xI4z0LPMeO/4le3rQk9QEE3Xpb8QMNXEAbYE7BL98v5r57ETPo1XCLLFfXRpDum1IzhhgFcddxnPSQztf/sueE2Mc0W/6ziGoPolTWCTavML8Ba+H1IEOUF7TmsZ2qrJcOwCods43PZvPehLifmvpAtpPCPP7uB40kEF4b50/f0jon0ur9jFyp7SdZRT3xOuqxBLSrOSqwnyqvlilmZC9ltg+bHiPtd+GT9mr+aeJAyhiUAbXzCTNH6R6iLjm5THHiWYa4ZPdLhVoJuyrgtz7LAyvAvTNQlIaBlc/XymVEBqDe7qtuHsKrtTHdYZ30L7495hmGdUZUTn73nyT12cm0ZoDqooRQ23T4oK58Zrv3LnaDUb5C57wYiQJa+OOOioy+ZQsQfwGw3kp7XtpZgkkxTqmNCJQK5E/m8gTnTv8nBYnztAFOUHXCI60glYt+pZ2bLJLnDZxmxnoHnV776sO1bcyHtAOxDh7QFdiY7nyue19unOMr8/kOe9W9ZzZTwslmJ2u63maqQ9ol2FrDbxiCDPe4gwB8jOtavesnn39wKJGyRd3lkaTw3syrFdJwcjjFBYI19s62pELe/dQFWy5SqegvbRXn78PGEP0+Pn7heXXPt3VoIuZHH5P7ZH8bkWJNP5Ldm2d76ZdLTRT8sg9qfvsnEy4HMJonnuniWBvrA3/LNcfMzQEPPMQ4TofuIqtCrZ5DkM2GMD/zozE3Rd1vGbyw4WT99scyn/Hja7SY+uR9d2HAeiTB6FOM/PFC95OYrVk58Dh/UlAmFNHHD2r0uFYdktOmv/pJlJ0nhq3kVprZUvoC+axM7Oj7GtVDHEufUtBS0qPwH7tSDl0m58nncOyGliT/+3lSBenGnaW5y9buwlVkitI8r105sVIBiBrfqTah4yOWJsf41N6iCvuqvsXzOAr3WrR3ZBfrHHEC/98Kc9EYLTwt1DTDtKvbAJpL7cR+DFpG/DQ/i1UHvw2QwMxpzoXUNx4ve6MCMadGuht+4FtpU7Rg2rHgJiJ1mE1E6ZVsRBYU1E9W1nwivCRqjrEPMVLI3+/qT4ysz11RPzjP5PKbtds/Ydf/BNhHXyXsjjXCXUjNLXstInm5oS5wMYX05rN8qypaBu103P+5yHrBQ03ItCz2Rv7u2cCEMWz58xzhRLw37FbM2ch68ZspzxHAB/OTvyX2Waf5QrusmXtf5kLoRIkAp+nQsrw1w9S5buDdztJtUnHNHNGjfIV3JzL786s47zu9o2uX/1xAaXp9TMG+7U6CDOjm1VImDXlr5IASNsYFkaccu57YzTkmt2UqDmlG4OBDzsj5HEr1yLJH/gvu1oWIuCDAJuiIIMx3vvNqP76CgXJBHvYUbp2hXHBmcWrwu7KcDina2+0HjoYVZsmOPP4fKoP1z1VgPvXuYmtIDrwxYTP0pf8Ni2V1H8Qsngd0nudbLBQPGVrUJKwsz4eXy4Nz0l69HxS7tefkjOo1xWFGn9bDuHqN1tGomUlqtLmD3t6su9ELDFs6b43a7ZCzRpjFI7a7hfSs2dETdUuFOEXIxS+4ARzyvK9onOLKFHuSaK83kZG+jAxXNl4fxfMBg7F/aD8zjK1qObtGulU79NVeVZapYQIQsNi95qe613YFwL8xKxY6aX6XVIqEj9SH9HB3nVVie779xs4OegO7Z+tgL2hUHiftgyepGGK3JLEksJR0MN51tBUAcYa0/JcBDIT9wqwqcWPwMDOa1Y6V/MHAbt2bww0Yvd1HW2IWLUE5UHNcdMvYISy+AVb10If/5+Tce8dCWGgNuUFhrCse5qbk2Xi7ejdUklycHzSbrIZmm8L8lVpu36KRih75RXVpXrrcRrDvxlpUxm6gi/GmSo3AzZuFI/M7+oJeQlxEeBFl/41nBtKiS+Xa7li/5jupKMxmRUTfk91W3YOkwCI/Yc/llDavREQjpeunJzOUBRPb8XeYapsF4h3tFzGJv2YhTV7PjeiQlSknjj/Slvt2v2CxrbQ5PciV5hytvksV/ebOBLkV30BdvBNOiDrijxcovnnJDBtMysapLg+GkbcMJcbGdsjXMoPx8EssWFLZ4TWiuUogvKuqw8rRh9iOoM11G/+7L1+Mp9rLD93EWAths/IyWkbpTr8pNqhRjjiF4z4nkln/ZZZjYxS0jnYmZHr8P5YXaObgLmvge9QtbJccdODkjqjbcHRH1xwFuvOQq0mOHIxqcXQo5V6clJ/PNSAk693yefjk1QDynrXiJU4KM+p6lzRGWID1dfXSg+6QArJZtGrKmQuUulLMo0kx2QGH0cQbwjqHQ4gFQ+qx+UkVL6rt92TNSTsxa9NnPtLH24Fq9EcqQXsH6sTGYzeH907qZq2MXD3Ojbyol47zuqzC2Nn+QNbXJ3uI8Q3XZrw8RDD2dECwiPOLDfBoJyBtpj2Q2xE9QuGz7HE9+A7KRHxG3OHYufZti4rrZD60ZG73RpW44ozu9SjNCdr1yI1MK9K0eEM4lYHrfReIwQ8AKUfiRPHsFgCj1RVVvF8n+nblIOZRFRzLZnz9KfrxutJ62XnhxHPNMp/s2O4pfk6gIgT9gF20k9zLLE6JrV74GX+VC6uKdGYbEH+GjxJOL46jR3xIoJ21oL8izn8gokW640/5VkL4NPJO562BcWyHoiA9cjW85XVi4xLhDyB28oTrPRKZ+YqyddvfPeFV9ptOMkjQKFJr3Kb/DCslJeiH/+bqRCxuuQWijWiwy8rqXQas4a2UI7qZDdcUhx+ZvrbcPR1K3p3Zmk89wbP++QvOCwKI6AG00GDH6T8WP0WtPkPI8ECd878mrgN5kIMtkmzb1VlT6gNZqlHlwfNhZUMMeYUY1sr20S8GI0mqUZNs/sWiF8p7fgtrw1ZQ6fr4MYdh5f8QZEZVdfPbsmgstu+H7KfGzZF5R8fFUi2KC19RB1GtObmxLBbchydu9A4sR97/KTYIOEPYyZ1kmja7XI3uRu38LYZNBZzdSkTbjPkqlJPrEemLF6NtR1OWncJoWUPqhH0MbtHuBKI7i6wGrctTNNBi9MqpgLPzkCEw0fVdcFcu73xbhLhBLlMin7N0wbb0w4pVjq/V5IZyeavAV2aPP+5F2T4o9qTOqOBDUNiekwyeBrgE0eooKAPDCCFDL4tMR+grzHNaeYDczop23xxAu7Q3WR/HOH2Jpw8Y7yiSesEICf3+3RYkgIVkLs9wqiUnUvjw9WexRLOAMiWub269j3IXwmWeZSXqz4b7ph+/VBidNkJxqLQe5f5TN2u2YZewqsZqNysnJGdJ12Yv1Qkz1BTHuLvu5eAnVatAkBw24U9jZIIUO2Myfc0OJ5eosr3ykEVRJDXDnyT9VOFJ56fNWcReSKxoRSnsxcy+H8TxKgrR+bwLXDg/Zrx5kKABZSy6Jv23sOsCCQk1XxBnlKRl94LW1Qc8XDIGVsK29MSO89crR9gERV2oo0/ghQ43ok7BpQebzRmvatfXA4fcitFJGwDaz3HApDYY0Ysa0Sse3kUi91yezOKb2Ni8zWcufSp4/ub0is5qGpq7+nfJdppvfC/ygW+FTD6WH5fuaVVYg6/rn44oMNYvu9vqYKOWn8EVeN/Yvi5YbhUsZKjBvQvpBzgxqiFvj+hGBtRUz8gfAzP5vqlJMIroEa7shsaY97W0I+cpW6/gANwEn48dVeAEwU5UdbUAvUbH7rzA9HHF8NPnmttZY5E9hTErHAR3MpV18wUT2sLyEPsYg6kbXZrtgQgFvshHSIf6Skr7jXRisdOFhMPcxBgHETWFn6o+7XMYTTw5nthYT9eDyIlWmm9DLK5mIjA/vAsjSKZ3K9LQiv78+QHsmpkG05sZDj5yN6StIS/rlBW5UuWc49J4G7vS4sIANbZtolpouYyODTeRhdmA81cnZMPpSl3RrHtqrEJkylDHbc3ck9Z9xPI1I5vgXmmsEU0eV6mwLaee62GZAtN9GyVzL18kMsYqbDD4x+Ln21r/Igot5fXLLwec/hczzxi1O47IRuRlwKiylave5TZ5XSlzmgaN99PyUz7PYYp7mi56bla5i947jyad674/jZS66KCaeWXPIO3l47VIwcNyu+M0wyxfbP/jD7xW7xnCtz3sn4X64UKW38O/RNmPNiqDpqi7nwrhB81Z6thoD67yJNe6Vbuzf2EJQD4z2IBn3RaMBwJhPj52UejTpKMUeDPAak9UnrY0/gIaZOQWRmnVXD2zkVi8FXtPeaR/xjW548QHEvVVtXIY8r2l63EJjQ+QHYycAdmVZIt+IH1I/nZYKqQcqwnbBcb1f68+dSo+zKmoYdzgHY1rUIDWd5it1fIOWvC/x9lmZiqLQYP1Fqj2+rDLZGtt8xvsKymott5y46tCLP3hX33oICREYs33Z3dyxILoQ0vsN7KULOyeA08HsDFFBUTALnTcpeoZz2Nv34SsyeprWWzo9a256lHnxkDJH7ghELK/A7npDsPCvJo3H/ocyAFkZTLYQ0qt+pSXsO2yOuKZOggALmCKQyZ9LVSPnqEwzfsh54lGnG5il3aGX2EJiTEJTv/fbzel1Y+fA3Dzf0Nzj5MOBSNz0SGScd8zMSiQ9feFb/FHoMXVFN4UrnPZrFPAmhj/tMpOKHvOM1+5mAy6Im68Wb+IfDVUgb0FXEN1cJi8+CuBd7l1lqpAMqfjbuVvacg+Io8cFtH1wLX4ps9mJzD2aS96hEe+7kN3uPo1nWYn3K0XPYEH1GIZtmm2P5MX1GIazB8sYn08AVRR/G942YU0nbDhYnYvxS+YCQOfIWMrOqdUHdfZJ9873LkXhqQ0lsqw2dFJGW/QhIOflXPUfJaq5ckgO7cQEHdn7AR9IoJGClm47yUo1sGS2ISo3w3m3man+YfKrnUi2PGpRpvnsw6zFD9LWMPsh4bVa2ZGYTleXBM4Pd8iSsjATl94vqj67FakSDknd75hpyvf2ACYsCtfTn/hLztm7jvfwQOdDJwzpcOduHDmGA4mone5NASOXWbmVIZku188eN/upft2yvYe4aNp7wy1f2qHVxqhp2MKNiyrwaPZMEZO6tmaauXMpP/R6Sa13zq/kFU+8e2H6Wm4DVB0Cw+2BxolE8dTI3RC55ugDJ4KTx7qdcz1IvJJ7akBHbGkZowc1ylQ/6omL+i3YgztvDfMUwlUsuRmI7Z5QS2xr0osNcjcpMEfHeh+TVUMXeo1OihDDJ5kjQKIaE1T3fRNgk5I604PL+c2UyJ4csXhFs68fY9knegx3QFwMmmHlqC8avkERljxpclQNNFINjXxFc64n+nQIjLukVYLP2aVkJnNbjbFmb6Ekxsu2NzjDk+v3hyQVTufSWt9Uu7NqwdorpGwYMPD0tlkov/6BjH2Vjv9fSLjE7vZB7NRCfJYNb7VGa1WJ2Ve746isMbzjuWs5LWPY5npANtwFAd929afc3+FJk+Q+7ao5x5w+9oqyhl+TTU0HX3UVvbpTolSzUGcBqVs4aiNd8VZKp5e9PtrzN8ptFrUPP7ecYGPEHhTW6jZ/PiJGavScjiIDrUQc8azBby7XImH6gMJBcuRVRrUKW1hKwmmsBarljJvF53zQ8LQWzwqSSTxGuz3O3TY1sWcl87UG296673Ot3H+QQ+1Oq77J+yfZgGO8Ln89xHzyu3npoS7oXkiwE1alV/LOM5wribGzDR1jdZF2XQ2iMIlGu2upGvPc5FfztvXNtA0zVm5ZKrKmmpERgEz58NaKgNC/CcC/o+M7o4WdnqBShghHPKwff+hReiAXCrF9Ldqg0bYHUJ2iPXURWM2nzzvGYZpPPyWfQl00mDbqBVG8PDdwIeZQeNXg7SSW/OCH6mlZ5WnJLXs0Lu6C8uhUlFTMEsekH61cyVtYQ+mqL/qXChRxeaact555Le5U8pUtRJlvqFx0iDe/mvnv2FfQF3zBowIWfV11p2FRjptRtTFKdnZHAj7JF8oenhQtsyXFCP/N90ii8Zctn+9LPxr2kmDRz5h7Ml/jgq0W2kroUwZz/nlKNctrtgSCbP4sFjyzt9+duRwtTXZC1DGAUM/9++Cej2nvxTpmhZ1haeHe3ssSczlL7kGm0u0jhFhTgSpCRZc4QWJHQO8i15zU1GalQ8dY1pn1AokF38jFAmUyfwJT77lko3pI7vhfbmqDpuwA3Z4tEmkDD2FL+6Zh6VojclULvkPeFlna52ptcUcu/t53vOmTWgP41uHcHCcDqQ1zlvOoHMV1ELMk+wWo+hN3VIhSu5oelfX138jidhoAZr44n1C/g9rD47JnmcLkIcYs4Rd15Am/vvUslDXaj4taOY5qgvWMSRc1ctvzxKLQE7EgOYiHx+WRvYuSHni/X0WOXV2WE7QysHE45dDnXzSio4VtovfYzazI72f0+IpWMl1huc7Y5Sjw3i9/TdPCtvxuI1WRF/Z3NTMSq9f2Z0bF0IxH/YKnlfWj/PomVgk6y61vucIyTF+NPI/JvGI6av9qCBvWDGN8P9+6sf/yh+1tK+SbqlywNEfkuZZe5t4D5ljUDRhuvNFRwUUmDd/kPhGyp9i4DFs9L9xQfjFIunrvG7kWMAEZKpf9+RCvAfF1CnJflfI4nup6Gzfv+Ldnteja02PIQdRljodT8MQRTteAxj+Eb8DBAOTZ5J2tik3eDm6xL+RZfcu8/ee1VgPHn0dzN9aYABblJ/iar7w3HvPHeVUBbk0eHcSKeruUndIObUJocA66egsL3GZ/fk3mwytLqja2+5ZZhuz4BRhgLJN1jrMRU0bcgfNoLocP44W2UWrRmZXttk7xolosbp9Kzc5/jicScP0qZM+cRzOEF73dXLm/ZWu1d/lg8L92WfpDMvXcpuNdJ4AoKqtfV918nwknjQiHnqp2xcoCFkEbWzXcT2NDvC6auraEcsCa+fVSR9+3t25DK3zAAp7lsr9lwVTJSswHC8NO46hYYAwjNsl0fRB8x9Q3MefSYsCbksit/H8Yth0Q6FAwVEHcVeKUrR4KseU9fDVtjWzEmOpEgNmCNnuinPpkMmEqxCRw0MEtZnP9Qxv7NK4hFakvQo4/uJnOfKW019i4l1Ei1itprm+57VYfOCu1UPgAE7aL+RjaDyr7spvw+aY/42nkM1NXnSeaEWMRL60i6731+jKuLL5i7VltZQiD//maOx4cPlz3Z9C9fv6+VOXOrhyNkQvi9+kcSt53OkeDKMzEvbB5h7nv4SRcYEY/CcCaT3lxOhqHlnW6NLPOCEVztZglZ3h6sK7sTCZZ3lTUbcux04NhL2unWz3WAKkZlf+lozUeuEmZ+WCFVN7UG+OvlEDBi9R6Nqzu2lUpr8YjjQNjnI6ZB6v1PT7ypClmZUxqn/iHTB5ofLRNob3QcGCE1Jh/6MQvarR6JnPkFDHWCOQcq3t4gLX8qkcPcV6Vjnd86HVIsYNxJFkw+/TSSv4Jw7zJk8dzavp5/WK/MCvIl+A19n247LQMSZnGvpcPApkOs/NjqAshvPDxJYOkB09rdN9XKzoJqZUBjUp+R2fG8L279e8vcKUsIAZc6+KbPye6F5XY2sww8zLeV47kryz2iDKWYTXCIRGb1DHvziyDgzPu2X5blHLIiW4r4Br4BSB1zOfXnUqp4f9WOMiJtEfZpWTsEf0tD0HEWdA9CGD611zZxDzDfbYo0f2uBS5/loaejLtASPKpe7rzOPw5Vj/qh+hLf2X3LjF36tX9fa2FQWfVq6XC2WWz8L55nNNJV6yByFkI3A5+YZPOsozl/Lt16l2cwPtXffp6vHw455a/sJAtGZ5Ft5716n8ui2VDiutNl3Jl0SZ/YzuHu+YHx+ByxKmb1jFnZH9gi+vl7Y643NMs4ubhe9u3LMUTATdIhv5/wQ96WeboPIyksqwpMmz+0q8oP9CbOz1/cf+Zwqy7p+OrP4jneO4bbkGzv0mfv0rwn1OQbmS7YpkAi4ryUQfG8sypxZ7cyzTdKr1PrKlYN/6BihHv+tIH/ntCb7JQ4qCG3o6CJFVFHuq3lKM1rR7+nrx+K0DkRDMlL49MJFVPh/smvtdL+8rI0O1OjGOZgffr4eftHyYofpBCg3bvDMlE55q9En11JsWjEOpm+Op7wBTbxyWqYL5gELcGvp96gNTqO5fz39jAPkzULO45HAveUgG6UlnK1D/06mwggyjAFPosF+x7YX8dXXKdhZK4MFICy26wcGlp+ncT+CqjbHKc6DXbol1llmSpky464USKV5pfY6qxX+YIy+CaS5GCaO5NOpf0dO8SYAtt5cRzyLSp1+JBrTvWF/PsNEhkwwiLCgo5yoU/G9MyEjmpbpyFhXl3h+W/Wm/yFTNr5E800TyBGcEuituLV8YQ4Z03EHSfBAU7PDnfzncoe9/mpg8Utj8RPQnuX7ovnNHQiUVdy593dYt60bP6dqHKz6hXvhZDG0UOIOMoxtp1kYP9ICkUVR52UIt7LP1yVzB8g+TnzNM/beZFz+LswRDS5iecHHTppJxs+X6BC1vgkJfUJmd09uNGX5RzHrBLhgaCgh7YjVW8cBAcwI1PhS2+PiP5kmx86vnQTm/6iV+Atd5wsTWZWtg5y9OtzZX4Y70XQAjAbCwprO7b2e6kK2LvcC2+MbY+a2PV351QuWngxN/saXaEWnRlNH64WR23wcvXBgdsGz81PXKHJ9gw9S2sBbekOCYU0cKzE7O3K1FxvVq6t9lfeIepxmDfeHorJI2QuUzl7U9wszdalfEU2QC1FC7ZUr0TMuJ+E7ijN4ejZk1GDgDkRRYjFPenATzSTMMlCVV2aLTnDPEufRJP9TUzk6ZaOwtLBIvvPzrCGdXkOx9WbNpGwzUYRz+69j55Vlj65JvVhfjbuH4tUMDBYJbCAT1YjfnoGo/jUJ4al3Ix7Z7MXsfpSyCQSRAgPfV0okHQpDDM/vTvgW8sM/bMm2SAz4Kl4KTF0nzvdYYP159OqW+AOgGW67Ytm790Fc/yA5mho4PPshzJ+aVfSj9oVuP2edTKFMAWTu+vkT/+BpF85+NkIDbDrJzUdjucIsJj7RDGuPJgS2zbSmpxTLvLNtEqDwdQ3d0rUtAU3tk87MgiUc9eUtkl4vV9MqG9ppQO69M4liPQr5ZZbSFXI3jlmF3ckuxUDvm/k6rSpXJhx8aETgYpZr97DKkf+yt3//B2M23suM3Vt1ZI5E/wJlwKtmxho0bK4pTWVepxn8F8vwBudT5T7y0UqMalQPxt3CdwbNfyLh+ybu5Wdleugm0a7H0XenzKFIZVn6RkQo7fDQTKpmunJjDbeIiaRIDZiEjrKha8yzf6TAdkDMs2y+1dEKmHsyv58W/JCws71LvnMa59iz8Pf285L27fHV10S+4t/bnkjhk2KtNJs4us9PWQq/iiJt2o6Buokrn2eHWdTI13frazWbbCS/Llz63omNjVzkX1DZtCAaIl4GHkvAdn9y6n4bqV9mrHWzHkyT1bEw4iWPyYCMmR0Ep3jdGhaafy3lCop0FsvRh2BSqk0fdlKtwnKjwCQcibxhfzn73r4eUmmPucmLoiCU/L2OfuP3F4k0qbY0fG4CPihYOHFzvyCqYD9YLOmwPe9tB8/lhCT0EO8kG49/ztuOo4ArQZMjWZKxB26t3IPVll0jTzJwmxfF9L86FJIT3725md3ylynVkhy1hNAwPDra5kBWBBrqvvbX15YIYq/PHfPykBf6J8rLOX2IsUsbZuLoJjtEGKn8jNiXxNG8OmnZ0lNPxZMLe5Nt62zCLmcc5wvZ80QorFqed297jBYpMgq5vvf288lUNpcvGCEVEx7tERDpHcNNF1gD8DlzKLwy1HZ3fYSsQojPHZgKiaWz4NbCodtqZDREIbpiPp44ueVmfqc0/Xfux2FGsGeJAJFUQnh0OyDcHdknbJNp9IgTC2vm9C4mCGgzCqiwY3thwsjkS///sVcSnDf/QA50m0TnxjKzmz7KphJ7E+BDdL2Nw2UP1S10sm5rXgmdpN3raUarU/cnEr4TSa4AzxFlLf+3tP9vRhK0lLExJujgNKGeH8r7cd7SiVXk2YEzHW215bi9X/u/JWyc4sT9F93kpKEhl5Zaz00gVSqvryt3BLpoNNCXX4syi+En6dD55NHUY8zY4b6k7FVbZCx29WFb3uROhEZ9P+o4fmv3Lm3Dr1jNlGgUcwvtvQ46HB9YVb1s++thyjmnDWtxU9Pi3+3nbtQEMgx5n3sPfQ9nvg1dF4MMznMehwwjcUwCsmqqo/erhRHaXOFacFsqx6dXqvvaTS8Ph5poDakjXUyccwukuBagIo150mokI2F31yH53nXbAssJGOQr3k8skWxGBNCSbdJYFO91R9Fu0uVNd+AgubXL2/QO32E7ilS+dTCHVFDiG0nP3jz9u0iYer1G9wL7laU3NxoVgkoadlSsyGPIiXUVlA7OXiEnnlI57lWYNjj379WoEeBX7wCRtgUlT7f1WjQJb+1nJvzSFBVSuyucku7OZ6VtGqxitTVW03+3R6PtdnQ9LrC/d+MnezZH2PwBkrtv8o3/2Q6NNfPGP9N5R8IKd16gQBZ3zcncJLCzyeb45mo8PNn6FLyqCt+xD5sMuCZ1SU+16d0i9Mc5kyrFeS7JhskjYArwE1fF5LK4gdn82H6ycOarptvB06/E2uhbh/IbzpNsQSyqS0WHat4r3sqbyE3/5xYxtVDQ6iLiTbZv6UD0oGMxLK/Tp6VX42QH9YSM19ABjJl/JRE2v+EdzK/NX8v/XV5fnu1ON1nMiMdwJNS2pwMM+EWMWH4kXEQjJuj+tZBOs3YInkuXcEd4DkVHp8TMHra3/zZLI3h/6Rdbp/284tMM+BAxZS3Tv3SFWrv5SJja57sHrKYSBKjFq2u032lFHTM6mQKFYJfNBuGi/qoBtJ0vrF9VNz7AYQUp72tOiMZfF8eREJ0mypGPIS/RbWY7BbZKIf23Ue3KTs1tNc7o8UKi6uO+7oiQGLHuxBvrMJxfntXwUhwkUNBkAnsVzoKO+u6oxPPXanq8MCrKoSzOWsmRK3PiNZ88V0TKOmQIr5YwS2zpeatw0QA+kA8dGcug1HHJLZfDN2PKLmTY55+lUkV0MCvFGo32nVxvj4ffXH6niZM/4UN9FIxsGFwrWayz8FqRW3lbW1aeHq+2RQS49YWkwtkmbDdKFN10IW4lpDD5wjiuaqGqk9fkvb/fnYR/6WoPL+0+cdDVIaqg2BC9McdHo4lCKo5h9a0KESz8ulwp/1oCvTUXB1K4/mL/A5z28T6ndIT/ACLTwLLmJyNvp9p+i8UFprWzfvTdvdxJzMyf2n8mMh2laegFok8Y+lvUOtRsmxRjO1vQ0s21tL6pxkvXgFYtNxaLEe60NeMbLptqe7KFSk/LD+ZzRAYG5uHFGKLvBqQFNMM0u37L+jGm9z3cur+2kHO/Rbxx/nQUyZtwR3XbBmSAJsvLEJ6TVpa/9J+hHB88Tvo9ZAutAA4AhjTrPSv2DxGH6rs2DtCbEXsD99eh97ux7DRJ4URWoibtTXHsHjAjHJAJlYcDXcfBsz022C2E4pWK97XNADhrW33/CO+afWHOCc5MBw0hkUiXkUaP6EuavMDCezlbShaKWpcycNZPX9mLtoV657BqU62vC1ydKB3BOViOiaEXxhVVjLSJwXEve1rVKQ1h6p+A+gDpADnq7FImFbrvkXqJyIDSHDkp4r4LmLRHLFAgdbX44vRI7pmbVkxxVwx2XbdCAmjCO846VbeytlMG8q1wQJS7Omf+1Jj7qC9nLBvbs+hIcLy+S164RJxlD2NwrQzqqo1AB6ABmAolcWQtw3xKe46hCKREzrIiNZwrv/2w932H650tKZv1esbV6g6WK+k96hfwWAit2lV5BJevsLK4HO9L4/hUY2ujIyRrlocDVD38xld9FHq1BS359gQ9X0BSA7lhYzdhi9HA/zHwTgnB2f4BF16viPMxEeeYXUAGfcQFR2eQ5++azT32qp0IaKVfpLB5KEMkT+1WWs5fcsKJfNfak/9nOZtUeRKawMQXkN88uc+7xsHWe/+IMfo5bNa5EgkKaTq0AEaDUpyjEiJsNKmXgvBvbI13NnS/XAn0/Ic1fzueunFMAHaWffC7+ea4H1xMwhpfca0SDxNmT4fFDr24EXO096AWF+JPGK3UdvhBnQ6+lVv9FhZqlC4COB5/C10CtTdbfqD3yB1oTYy0G7FsiF/t8ZPPXEjD3FcfU80LMTDdS8KZC0iF/NaPtWumreSX081CrCAXn70o5M7H8gz2ZVPkF972Yx3uT6XgAj8jERdrCCuHqIsVB0I6wLflJwbGjZoq0YR2GibndFAgprW/fn7BSqRGxy4O+IeH7pFuG9snuEe7zgAK/Sy/ytohRnB3MKH/jEsv3w+hr9L5TaL8TbJVKLJjc/vS4ECYd+M0Bu6ijtXxbpuXofQ00tb5ajG/CqkKK15XMvwNkRG6W88WgG90FhLGq4xXCWF/+MTpwRQ8qyDKdCaz2F23dUfoaaJM0XsFvbiqYuLDFMA3aRYQbH3cVmGvwaGt4VoWzO/KeB9aZP2smcsdMlcTc55agR8Xnx5O8gvBdePBOm9+EUuwjImx6BvslbYXNYuUBJb4p2HkxkN1G+R5dHfa6ue2SNHtvHkVw2qOCrdyZpJ4fjN/350FfaQ1nwcYy0oKzxlyfGbGCePo5eRn4f0yF1PAaeyZoxk5EvPv7eiD8TzPx1L4M57oyFzfyR/i8Edf0QYG7soRZhi3IILk1o68tRWEd/ne7oMdHWP4vptcZXkOp7IQxw/5j7/RWHVwOCJ4tmIMPtViM+uylVwf3Q376daZBj7Xn+db3zRa/gaKKmW/jyeksvgELD/3JYVYjGLOXqIQikYpClBgmI/hYoGxfZ/L1rNyooR1NiuGOsPgJm4cOswB0l55b2L8YTAjkRWmtbVDem+BJzLOxslUegX/d9u91pxMd1uxyRSbTlbT7VLf55/vMAZ5wl0ArjmzGTDfJ9ozWFlDQYlc9TCf6iRisWHaOXc3bAAnItImKeJ1Q835qfij5xUHUq+cJ6Wp37b9KQP8mRwAU3sDn9/4hjMfumUQXOtKHsMDlP8+XWJftZ0ovtds4PZ1gAyDpSkEmF05rW+hS5EJ8OO3CHtIkv9lq+7aXk9JBo8P3sFP7KfOguhS0+UQgl2/p7XCHCENubp5KbOaA56C93haBCtC/kySpHzIx4O2n/xg3cD5BjMXsCTePoKuDpCgFf8ORIdnHoQ8rfAShF5FXJxR1TBawdOa17ab2t5oOpAinNiosm5lUtZ9QLGm5V1fCSo5T46GUCu08yQpBaXWAbztm1FvIqUtNkFVChUdjQgqSZ4HKxT6v5WcowWxE1fTdNnctpZGfHzkEaC8sF6O3CgNxSlPz22IJE1/X2U6slYmC+2sW4rXPlbtN/7qXCxe3mLjw8rX8cdKTqzs8ylZ+kSNrgt/d5ESvJwuBLiU5qsXKNIIxKGt8OGtw/tObyZSrhCoghthGXfZ6dqv7Ne6dGaCqvlrrGo+v7AMskwf04nZZJdmIYvYeLaFXbNJ0+nOI8KNYfomdgdI0D5Dzbmr1DMmXf6m7i/tGpXcn8WCKlfJHoe1NZu2KeL50HYohmGFT17LdmV1xG3ksNgZnLIugeWhaoO1Pb6SQFiLL60dV1ZC9ZPjGuUMNuP76+t1O3vKHOISdXpfBmNH96uLlqtaHGinxKyfnG7RtMUUMtV5frNO0jC9iuiRLcy868DVHHw4sqKwCwW7+mXcezjd3o80vru9zEigg9i91OpPWIjdW9JSaxqodDXa3N2xA7twjR5TTA9hkGaWOWUIKvSSizv15hWlgqMYtEd5FvBFDh0QANyBbMdYn/oymlzO0lQiu2MswTpcqt+ysSIkpAMPE8lP1OVYvJ9zTGvpax9S6ZB98iLHTo7gm1l9GVwpmJf6Oskvejs+WNDepK3fbVRPPiJWXNpV46m7rlXYQtHTD8c108ZfcF00pZVcgRx5YU6K4aARWXsrH4w3OW784kmZ6b7VuJHevmUFg89WvPy75qWSYZ07VbFUPEjnWreyUVynVoxVEZc3HMTiNTm7/ijqwAxBNx04KHPYB6uo5P4byorwru6tKx9iwFX2rD6wmjq0Mev02pyQIVsD2ia8epK0s+CbLm14c2LqGdrGCom6lPtx4UtKxdkmQJeO0LoKUwVMu1XmqbJRNO4VW6djwgtk6UqJUcD0X4rosQamvbujlZ3QItxUkTLSuZMZ0/Xx4TbWTGLyZ+WCksVm530TAK+fAx7Bh7AK7VftbaVtQ+V8clKBV21jSpd5X/vkt05T1/ze5UaE9xQp2lZo+pgbnvcs9aJ9oAdeUSA06q158yMhG/fKWux9g7682OrbyZLm76ZHJqVpct5NKVr8PdI37QqFX/ESKy3rluRGZzIswTKO+39ZVOszwJn57/86q7vNxUWET2U+JEq7QrlXRN5+odTDlgjKEeG8+zJJxTO0TvP60b4G2TZk+2XED73OpXNPIHoHDyHBLX12z57ZDpD8LMX40HEwyMohCTkXHt95AL5M/DpNOCB18JspPpynC/B9/XSiKePSlAxxR4P5cvSH7ln0yRDwUQjr52OH8Cfzzwe9291lxQZu3cnDdtYnL9b0zfQpNv/WcklhEiHQdn/TWYMfONbNOjKwhc07ACrBUzBpH9uqfWu/ObkUNHSN5Ynzn1mK11tH9sA7J9hp5vcfsDxBx9gSGvObsZpt7H3R752dbHn1dbte5ECqVm6hFfZdeLcdrkTwsXchzn5npmNb/+UPeJ964YdSgIjTJyVL3RGedV9OiKjO156ZIU45rqSxg8fXAw49n1Xp5Qu2r4Pe3nb2iqtd9mR9GkyhViVlYVqk5AgXUbGCGGFVpxNjjlvh97tRvdSQTwg4d4yDdB36LSD786ACOFzb0XPMh1dkwBH6LmOFkm1IAfnS0XPQe9zdYhOxSZmQiMZCkaYhtPnsKrmtAFbyrfvw9Y3dgNJpUu3pWc/y79ZglUSf2OtQjpVIt8FvvJgRMpmINkaT4OnLgM2ZAr45XJ9vUL1/aYWHEkfqpAtG+CaHft+8NRQgy9Q2/e1f9+xNYw6rE59/wrVHLLS5hcBiacsjjTmN3eEjRBea5plz5k8omUiDJax22iFpbd1Tpe08not0HGsTEnsVJP6i9lZ5Y/slMQMTzDzldi/9KX6rUccXOD1PDoa9MzdZXAGjWh48+KV63CI1JtM4R7es6/IwBYk1tzXEttICF1gOBGBs3xvnJz34/w0q2M1FPDObGDcJZFTT+FVSI4e3gQ6nHkrs4S4p5SjwdngoMUyWNFrgQpmcmrdyCHGXvd0xuv2/RLC50a05uCyPyEEZlx2ec3cA0tEo7yEHga2LDW2jbFVTryCCyqqv9t8dZmy93kOfpmMhQjWgb444KZ/RY+afIsENfwNeBGXv1vjfGI10sbI8vveEOY0CXEojz5AE0EXWnPLcwUKkDUfkVKgPnsRqdXuEbkTIZPcyg1o984vKYUFKz6/L9n8iH06uOGM16eWPypkFRaCSG1IzZ4w1y0p28b4jDT6jOvqfrNn/YGOpE/fC4Sb0k+uTSzxlFT80YUd2erhvSUatfIqesn4GMRqGvOoqPboRDQDGdw5+UZ2lHJmfuZiM7RszD8tAa/sIUHqFEuROxu7bYQnfjNen5Cv2nY3t2pNAMLQCVfohEZ3W2IhMr580zNCLmLYqRmW0LrnGOMPDWNfvH8U5AblTVE8ahvroGGx8gU5QlCk4a5iLA0JMW5PjeU9HpkQk/DWdNb0P3rWH+VI+pQIEsQurWS3Ec4bPXGvce993XglMi7lGVe9WtLjlpWsGfriTkUaaVor5uy6wC+jo1E8GElzqOu9XQZZlJ8d2TK24c0LXF0D8IVa/PCBfDy1u/+wJF2LBTSsGkkhBRbEaxciVaCH52jORx/0yPct3yw6ptmxSjwQiqcPbGs33PXI4EB8p7go35Z+Nu6pTKafyqoa3/8s4jiMgyNSdYWEY60IaMu/D0+K+PQ+YZD6wyO+gUIGVYdVgnwnU9saEtfF0sjAU5Onm1SRaarFhgqzssaD3ucUHGLfXSc66SfnPWHN1Pvyl5P4Xi2yZbHg7K9V1h8jK5Gw9Y3dM5pQv1VOkoeXl4dtu/5SXqe2ODk0J/JMAcpqaPsCK5GH9ADOk+Yqr4yXQ6N16+zeLB4417O/UtqPb3ijCeeB68w8/pRZFl6QyqpaKYryKpY6IJ6+O92c+WIeIJjXsltPnUVIduDXmIzZZrOH+nDy3SkGKlwpQ/7wzfW2Jk2zQ3oz4ih7JHpLxNlCxW5Pd3bzgpI+o/PJdXSG+cRdSg8dg+VwlEYRVipkQlMZxTRLBFdGviChL6WQF8enJN31t+B2UauMRKHk8x+RH+PIh1t/GUxAjV8J1psO8DNRsyqplib6O53V6RPU3qG3mgsgni6UjG0siQSVEsoBR9JZdeLXiX3JwJ/c1Cy1Gkxwl09UGQW56KZstKTYw8soEorUYvrb0IaOfQZ1qRQDC2oOL8LYOkxKIHOMBJcxXw+Nqm2euuRkRPDwWg24hAxepdb53FfaMkDbU0120ORKhXtTmi04QMmWde3/CHdLR+xFgmpE5GKMFRas9ghsiPL55NIZ97/m79YMqZDpR4K6iNzUZ/PKmUxIawwmBIxf+T8Ey5qyQPNsoPpgEyP3nOXv+JhL4ZtG3tmxhUOyA2G9Mqm6bMLt0bGFQ/+ZiOJbYHmabaJPwS+rbUmCJRDvS+LKOTNnbCLmICwNZNULtdX0JTyVsiXakiUjhu3tgBefvHH0xfqxuRPWjz4KddNkzwxfBgdn7O7Fbk3SJTVSJPRIfGWnm3Tj4Bg6/nNgRGdlmjkjcBl8YSmOrOJAiCb5eyXLiqHiQlmVpT9u32/Y3NgNJJhOwnZJt9aVtW9xspeqkF1zSM7Ps/vzfX3uTiP/AcmL3OyQ+bZuCTZ+9R5JYm+D3KPXiTLq0Okgs5JB7PeBvpAaaQW2tddUK5jwnuvp+00lOJImQcmWfXFbjn0GDR9VyDLSWVUp5MRWzzx/fafpW3Pq6nnlyXBPbas8uGEQY00qrGM2u3TrbeqqtmvXvJ3w/cvA4AIOoFcvBDUXs8Nzb0Zg63l8v3xP3Lm6dm2mW2YVIRnYkoLFEi5YjSSVLt++f1ZZ1r6lh3N17DNIlltfXWJTzmRTlqY3i502O7rs6csFSqdOj7goQXUDqWWm3JBtMgcy+xb2xKpInfAJZTKNiWzvsCf6flO1Lu/mYkNnxhZewWYe/zUlW9ZHH9wsSaFCtliGpIa0R7XoSk/cx3HQnqo+Bre6GVZaMyeoeQY1DibrZT3Levm49U2MQ9uPbGk+N35uT/rN27Ajlo0J6Hl8P6Hat9VsjrXWhQLVv3ciWpmrAkhONJTY5EwH7syCETZ0OZ98k2PRHo2h7O43rCjL1iZtU6D1Es4GEKBFSjDLrSpyy7Tlgzvci1ptUKjS2LVo3lAGUrgSeYdACrAFk/7LZjsE+lITSz/aZSqnwdsa5TLfInOrpWj7fva2r+zxN10T0uTF/jv2+h/+qPV4LqZ6NkndhX2kT44nTWV9RH8IilYfh1BOc6+uhnLbDUNhR/bf+l5dZjzbEBPJO2QpRyrRWeiPo+JigvlXV0u5WrCSqA0mdik/cyWz4Aw3v/nZGQjaF4xA/t2SvSfdvH0/+yfUti9/t+ZnqBUYoaFMNge0AVzlLFsyA65NJiiMJV7deufdajQhDFVIlL79vJkyuoswmGL6PgKusvlYYcHKKGa1ag8Lr/H1lavT0PcznfOMpQE/4Q19upHKxklDIBcJkg3QCRaD5GhWSWPtXVfwlKY1485uaXomE/eunRbvjdW43El14hXUvcp3yLz9ywKQs6WNW+tpJI3T/LjJNPUFs+24MtKR9HOTn9ac8O7M+njxIYY/N9OxkqjGPUiWVrr4fcyzr/62eNAXCkfS5Oak54E0vEdFFZus30lj6NvYtvaA5Zsge/ujuyuqaOOU2JEE+suifol45C2vl1sj4dwQFlniE4n9jyDljwctIfdkSpvjM8mu7cPYP1JdME3XsvJcOxYivemavU1am3uXIkQiQYsP5N77cnB4E/oRVDpKu8nWVWeldbl/MIrtL+wZASfIu8DSWO9ycigVhQ2MrQ1vx+Z+dY57lrKmUbFEGSJb5+MuZ/6xj1mtiqcGfzq72nioy0LbymxW9rmBdFhwaMvOTvUnEY40dqh9uMqo+cr0dczb0oPdgjsR3Eb58Ry/Q3CpJEM1UqhqOhVB418OE5TINpoDld3cVnXjLu9wzyhQQyq6OS3peWAzKZY3PcZkIA1trbBg1SpODZ2IjFgNB1Bi4etGaDswguJPzNfWrvLNKLRIeBVV4jX1TZuyLZvXW5YxgZ76YyXM6sGzGAKNYx8/aCBzI5JNuQNdPav71iimttc9BAxlpD0Vh9PPLF4vEqpnzQj1qq5t0iYtRKSOsHlmm2/+RDdxcOgQ0oy1sA9tf3JvuPVINTBc/U5mdKb5Rwqo6k/Be555ip8xiZoJtdBGu4bNFPRq8WXiMWFl1sxYr/panGHly+BbZkWfR0emzvQkAzssYt9825yZw2D1KeufXuqZEJRvfkPbxF4GzS74rETPpgxRFzOpJ25YvZlGOUQ2PB8YIEcn3bC9Vw2daIwhjxCCsJ2J4NCgL1YBfT9lc5DC6m7JnTQJGtoj23Y4HOjLNMfKPZlSPxr7mf06DrbugcCI2r8+fpeN24+yH0O7H1Xdr/xCvB2EblYmc8pg1WynTyogXGCiySF6K46SoyAbmABzMBNUxH5JG95O4ljlexOTCwxuLIhuDmpwEbF/lxPoAUEl3JPH5vZoJ6jvkaadsLo0OzCEx+SqngmX6WDcpjhWvXrpklYjvjILnr/J2pnCeZlwHf2scaKrQ2Q19ni8JuCuBBi/4sO9tL205ODvY0bngxGVQ9TFWTc8323J3NbKNPnN5hWEJVYr0IR2a26v1gdHfS2lzwGzMSn7V7xHW/aVu8+dKXp1XMgzUYcATx7uh4ER6gn3SB1+Zi7q0y7R31sgcHHUp22mXzLyvT9cjKyk87SvMvYVBO5zeDFfhw1SF99y8fPxBEgO+69XotNhvja7j1sVY/u/9Cgi+K76MFe1/f7ZuqpNhDXcc7q5WY1BDS53vVytP3KNxcEmndopaWdizd4+HUmANQV8A1kY5vBUMnKbmJYssrC4w/JG/dpeqjo42l8PB/IQxGebF9Dvqv18sFJTGKgnhdpzoKwXBQqf0oDlgcHp07a1fxMPLuFyokVjdFCAwoJVLV1OUf6TVS2gkFPXZ8+6meCp63am2JvP/ZT0XLFqJC/2+ID2qXC0UAVVaDQjQ0GlJHSBPPNRVbm2rrEuaShp1W1l6ZJ1VYZH+n5TOxYi8MM9Ruaz4B9l/INL4VRyQgbET+cYz5xxIo59o/pTQObAIn6lxqs7NhwKBGJfv6AbOUbF7vjloTHW3Nbo0hPlpHrxnBhB1e2C6SVV6FwlVPmCz25jl+pKsaQ+cNNY1jR8lujbGITlZWLMyf1ySUcYDfDao86NYOVViIK7lLgdOg0KMZpojA0RUSyYe8OIf2g/uvwFpBy6hJCUePlkD76iBh/KiKVm/kvtig0RGRo5fPCawI1NuaU+62H5Jl3P3pRp/9HnddoVySr1hN+5wEfQKSK9jwc/E80XNOejZsedQ5BtZAfHwVd3SgWxCp1bD51d0mWIS1pZpavK2rfOGoDOxMg28oPLC4hjXzIuFLdf1NQsC0oSihlORy3Cs4iCB3JqpN7FXMRm2ro5iNW9z4IWaVTGXBBPO4bTjE2mQQEcDWC46xtHv5yZwBAWJZp9ukMB30UiRinUwGV8Q+On544M4TGCjzpiW/1XwM2AnInKDq9qHdUu9VWug1OY5NxAOEeD5Pd19W0FWJNH2IqAds0Aksn4FdeBioeAB1VwpNmVrzrZ54Iq1F2Z0uOxnoIFmGOfWCVYLHIiStc2zY5GzKwUe2HqB2Qe/1YvlYYMzZ4e2Rxjorkkrf98GPbn3SD0cfXn63fhnknVnw9S0IVgRNpiN9V/vQDxFORVBNBLIa8HBICo/Z9BQ142e7JP8heh6poJ8todXl4G+n6mfUstmdDqoj1/24mG51kJTjH03Vi+1cPusZj+0L97iVs+ODKITpYhnkRTtYv0Qszr9ArmC0s6EH8Tw6KTi5N/6l5QRQhKOg+XYBa0mMAoNlU/qN9Ly56ROme2qXSeunEKwbQtXF/B3RIoTwaGdwJ6QkkX8od+ph3EwaOYxbzhA76QWmpx1Pjxu9IHXObE7G+NluhPyGcCL26vseKMcU5ghNQQdelEAstYO+s+xPaw4TlBqaBM39g7N+kRocoRmfIR5nAwYr8dRiKhsuMX4mmfciwYElhK2E2SSAV+ws8hORVq/Ips392+iF9Ha9RhZQ+nBUYYZnMgN9vQrBhY/KkPcw/CbzL4IGLAdfhxU5ipgTXP0cGZ+8257/7Go3R27+dUxPOI3sEEkhiFaT1AqMHkXmAZXdLDR2FlYZdM07XQ7CaTtBhBte32DMPDS8q60W1ht9nImGB6ac6y6Erthk1b+/h3gYkPUewjv6Yrw39QJbIalMQW7OTeyE0nIg/C8hsCcU39BBfSVA2lGXcvot62h2Vgf033Raxtshyz1defuV6+Ew2L5K6EPZ2Qifmo7weUJI26HfjlXQ7AkuRCFrFvmTKyMKmeuMFKGZxlpqdIl4kaAMurX8xcwt7p515Lern+6AipCsmazsAnwIilBKA7HHq6snczBb4W1gKUiELxnJNyOchEDo/ToWyuLsDJ4+poW2fjQ5KVrWAbdauTQWs/UIqXHJD5+/wVB8lbUmFunopFl5XWOesdCY+wFd+DhIHCPUd1P9qcqysexL5LysiOi+pPzokUpgXS4AWMx9BDLikK2lbyaS6v00KYr2HSwW011SMi7MfQwwOtHndXdh0MRh2aDI0HjBiGze0SQSyep68yElq/gM8DYZPlLpXVxvfNG4kOAqWGxJdWMdvrr5VfEtMmjXxv8ZvohR0ldflwNDyEe79ZFRZHpQr91IX6N7jAkG33H1p009fXP6cNUi6lF1EXBgpTgD9dak6YiL58siePlkQn9hQsExLnbVKvcNUPBba1K4qpaUJpOE2CDtdSQcqbd6e+lG/+Ro8MLMotW+M+gF4fYzZEkbK5/nd8ot9GL+pt60ysU5XDkW3McIM8blMEyDf3VOkty1j77YOlDvo64pnz5WJ/mpbEk3y4mr7fFAVx7sfV8k3eWKV21oiRJKHHBS789cezaz3Hf8puSUcPaaqS0NL/DPr7B1wK7CZMFIPo373zSLWL6oLH69QV7QKbNKGPd51Q4TW4jurr7VkaGyG8fuI/rc1Veb91FiYEaQ8rfd5FLT7LGFiXlSf7/eSZVjtvJUDVQWNlOFdQChBG4pOT5fnRk5PjN6juZR53SoQabJePl6MMVBY79ScA0s4TDfKeGHeYwBi/El4hnWe6w6cz2k3TPhaTANjjCv3QyYHYt9eza5u5K1Pq96A+0J53ZQTubLsbKj/uY682HtJTsLCmsGClf9zYYlIiRWBW/47bKFxChYzmmDN9vmKTsSfUxNVJ/q8hLkyFrII+xDLHcCIg4mP4m3AZsZsv3gpgZapF7xcdBV7dr3Nj11kknSQDP9neenhz4cc+nZ55MY1oWahSGqjk61G4lKKlNzYNIobWuzCY2tzv4g1On96WGNr7Q2kUcSdW11YE+C0K9AzJsqDGIUZ0AvSiCA5TALLYwjLw9Gk5j1V/8XgQW0ofvLeIEJortGuKoeThqaB2qftrYksBCdJQZSQ19DU15jNvZIU7vnHUyJkhfb6t9nNvnD1ZgthsFGONBfekG0TeuDUWlfmagl4zWxGIgLtT3JC6augjt8inI3eGt2sbD5WDlcyQ2LOymNowoYwWQSFzCbnGwUpuF5v4kb/dSlqCyyE1R4cXT67PuZdkoV2zkb+qlJp7Y+/kdgb/dZJcdTpR1vebGp9RtGU3fkVqb18p8lyFbM7xivS2KpSUvnmYhTSY42vhHq2A+zcEKDA+ZjitbBK/7Yq+a/uDMrpLf7vzeAykPMRla3z4YGXP30aPEnxxf+h5IsuYAJoj+K+E47cp5Z4dFKqLXZ6mYyVhdAh6fmmd+e56m5H36onN6qpSMz48o9Mg8Lw8BHBF5UXW8+gtMNQFxpcmIpwUcxEjHX5/Ig2NhU02FFSoOUjXn/p9LJTUJ2DOmiGVqlX7KbQpxRPlwl1JAtQ3CjOcz4M1PXa//NLDbHc422hpDxNSkpCvvMVdiZh2U9C55g+PjrAa3u6KEph7HyNI6vYCVkLJNmghdHolftisVOAKOx+f9FTuiLAYeYfZng7mwJjqcFfm6hQiV2MYrEJmeBD7x7DeFGXonfuLOyfCM2dNChq58/t9JuUfvIBlyAKwybz7+4PvQxEqymTvfrFDrys2k9Eqfzurz9XslVrTtWpk8L85OTToSZUpx4fRBzBfKPW7iQNVh8crrn84mKiNcHLV6XVIdwWqnjikupDqIqEiZCuyOWaeWPzegHXWPxEvQLzN4NPbLAUhjiXAPSr+2UlMDZa3bdIgzYEhWPThLsy2+m20HlixO7HyC4iSNovihKOe6dQEfQiTbJgv/50iSrYsYWsAyeHnKCMgnTR37Znq4v3z9qHIsEfipiUbNYfH9fZQReMGVIgTIcqHgIMOhjl1ONzJcZY4JeTsul6Rqx2y7RPS+pHb20xug0OAKD+izqiSG+mi9+GqV6WZF5lbWTOrWt8Zy/AZWE8OL4mZ5+Q4j9VMh312f6zKyJikoPaT5eam0DtrZkuczUixEjBvVqn6gpyjgbu+YGOjmBvZtPFRuZj7mar4jDm5qy/ysjVoAVpvFzR0iPJid4R50kfbO1Yn39N2E/Hqjtl+WjnqIsAhZqbahKQiCGCTLjjpfNEepvNafWQnMnxkMjQxAQkOeiZhQJxf9dL9AWIfcKCiY2PCbSLamAv68gaMcA/f6T/6vDHWg5hoRbapPvzRylG+KQpVyJ4ecx6hjO4iYQFU0Su3NL3HkWhCNQBBE+YvSeG+3kVe2LReDJb3iH0HtedA4grsJrW+ndQd18/WyPbEXUOV8XbSjtpPVdrWtOnnh4S4ytuQVFuTsA8HP8vN3QnnhThK9CKRVj7Waizvf5Rz3MJR2aZmHTYulKySCGfNfBVfvEJ9jAkmnG743IayqF7BDltXl02b9HzWNa9+VPWzW0P1CHnI62beSDSRzvFS1ktKm+bhhvX4/ToTL+76PW8FOVqbkQy3ut3jVlVj0BLJ+mwyUlj1Alf/lF2Hh7aZrXO0o7zBYKIQfQ1Q4pprmWmOWI1IUSEDdqbs3CpGBgF4Cc13P+gTYwGJzmu/Rhu7VFcjEjYF58QR39ffNHjXcLiEeF5purUUlFmvRP/ZirWw5Pn0jHN69ijnVfihq00B4eSHvfazyvFkcrW+xtteZAbpO+GQC/oTbngAhI6Bo+GuV+BScwRUjOXsXl1j1oIBvv648GA4RdsdSARKuiwPeyjL0HJvugt7DxiVM/M84NskP/ITFRzWOLXKdu96bjPgdcvXVQ5I0P13LSTaqozcXX1uFG8JGZ9Ib7fxuwMRxeRhIoT0ESPj5n9k61qyGrcPi3krLecMJ4Dh15Won29BX5pzGPTDJtTR16kttbI6jaurPRURGYx0mVtSUKt30xmbKCaaTkbpchOF6VHjxh+k10rRoAbjZBk8/4emHVx7Tw5/wr1nx1PMPQ6nwz0Vnu8I9bNnzonzaE4+0AyO9gyUDRFUTnSZ8C6m9jcsFPMN8ANomdSeaI679luMpPTAzu78arUqniQKFrJlXuGaTujvcsQz4LxhvvpvvEvEmWrsG1zY7Ff/qls82Gr9jLkHtW6sRvJUAlzmKkaIffuXl3kAYWCees+HqC+kjybOJqofVYFmEZn+FX70H0SgT0yqtY528lFnaVkhIu/841YG+KeaXFKOdsFdL4ax4raXIOWwQdscDwB54lYseWLTiZ/JDIDu3Tu6nLLmXjI/aF50S0JjLZ/KHqASAfo9Tfcz11WlsUujm7jJY5QvoYXqp5e9BDqlR2u0E3Warbz9Q2zVldre3n0xq4uRS+/Aqvio8YGX7CvrS/3s9JtIs5aumZj6E3H/H3RYTOwXi5ilP1MRUmdI30ruJ+k8iXo22ZnzczHT3V73LeT3wIkvPM0OODniQc12k0at//g5tdxH8zY2D1FKvxj0KyteNtxSbImAE3m5eFsLhZfSFoStxk7I6e8vb+1upb+3LeeuxPN6zFikTpf5DcxOwW3XZgsC3LJqLBtufWjqDP5KIN8TCPP1sz64/TkFWlNdsYmPeV57/jKEzK4S1ptCCl1gPZGZaUrU5dQ7kGlLa1Y0mXhiIfuO5NcQfN0CrR8+pkwWaUL0UwvaB1I6+bs2DVJmG/uc6+2up+pLqlfotfRWb2zcgeGR+0qO4ZOvdpw8pDakpfQpT7+6hFEL4YLxwHOnLEsejpTyJd9C5ABSNyLs8M++VMwcYjZU2dIGmV+VXWhid8pru/xa3xHdHuzvjVOwh+JtV1T/gntygGrQRaweNAW6VBTKjg/cFUdVpQzkYFOgNTsVm2vjIp4LJQtNgxWborinK/3Lgn6svAR9hN3U4CdC98WlKKALif7gJsOor29bImvkEPvKtF4MatAM0isKzExfclksFJp2abuVO1RqYu4t7RXsCxF5+sx8LOUmD6WAUwPnGE1KK9Bg2NFQFWlcv3uPtX1IhcNNLYqNZw6fynRTXPjJqi+wkerAEPzUzp+jjmYiN3kqA8lT+YvXpJ16q2+ZNHOm1puaz0+R6jv08+dHncjUFOPGWoqt9NvK/ryI/ef2b34oOUr7EODEcdbQy2DwZxmA8SsJ6KVc/YZnOk24FJA5EnTk2qwKHdb6m1dOKI2hh2Jbs7Jm5mC+t+vkDySK9615PY4w23T2Sxm82tQlSruEkjv6RcjSVR5CbPGP8vOOd95C/RhrSVQtrUWij/I5CHhFkPvU976aKEoq/Oq2Q4dOlZ57gLX5Gv9jaBgJi4mTlh/Z8gmsN0UKuoCYHkEusn6eab0lLY34PsD+/WUJkgJUOdWlI+iod0i5/wb0hVit56aEyO311t/G4xbYbbbKP9d7R0uV8X7iHaXw6a/l9GmG5hJvYjA6bq+vWZ91f1l5hXuAexvxJOJ20vOI55uCFS8dJ+jQRg6uDWG1WVQOyZYW68WDyKyJQHXiFb3ENR1qXk8RWvpqF9i7DNYnSaoOAivjfYLCoqjOuBheT2vzNeQKbw9tXsiGyURX9uPmDtCX4R5Y8TltWVvxLHWLDTSsBmkeyNdqXr5pC71kPMBuRxTzIhcJQp/M35vCxiI/9yA7u57whtrketcIQ3IcYLx0ZfI7AEvly1sbyJboTEHzdowqQ3hWpyOMFJBDy1zSXHQcq9BkIolaouQygI7VS+1itmVwe0w05yG09S2TtrAxzLbPym64QoScDrXzh8AIipgpRZ2sGVqovvGEpf6R9kxTRebfQX5TJmQb++GS2Qg5eHuuGH7rI/IP3Z4om2qubeomN04TVwX68khp/1XqM7UfzuhZJppOMmlxxL7xlYGEqZCBaW3sqjkYUSslwIa3bIzpV0bfibAKr+4/uppEWnObfEwEfmt8OZEY46bDQvd7o+E5+EhRHig9hrmnu6/Dw8FsoPTQdGaQ/+SDzYwNXUY3qaAzrJbxrqHO+vggcqYCuhHMMJ00/ax4opPcEnjB0DKPkX0Z3xrDQlVMLRnIYvbQ0f65wL5DWiUllAEnufLg58xDW1Wy5clfalAWqFXS9HS7tffpTCu9tChif+/iIzL9GeQgJebOU/N5Ypbbyi0Pr8uza5sOD2hX7h7PBFCY8SGe1rb9kMEnj2d/86B6WxVCJSJVxGUUfh5boAILXpgTvkMEiemf7eHf7Vw/l+gm8wQ1N+SSWjrDxn4rRsQIknWYOGlNpEBVIvCa563WDrCg2yyGOvsOE32x/SzoB7ZSzwS8JNKmXbcCbQX3PSE5hh6aa/RJjjFa5alW3ho5M17E0anM+iat2gYTI4DDZNHVCF1lsrTTXtOJEdrK0Ml+//XIHti0u81hihQ0j1SaUBpxNtzjdEhXv7lzDEVaG02MlXf37pGCEfQ5DO+8a1Ax/cnoqJ6d/QJCE9J0Qb5c09jOMDYgD4Bm/Cq4H8OPSqufHIGp4P709CwH/vVcMbYpBTWrXeVNAwI6sk5vrxJOJENHCOO5BK87sqyM4Jo1VDH99211bVOyukzcvlQskbBx6X3oyvCn8ovOOgUVmMf0j/ZUhyckQ8ci1jDbG1/eDMbyVlqulW8S93ROhZxqky2/G44rY7qpyAjZk68rM1GHREt/OeoBffnJYe/K27jZ0RlrpJLeD1+/jctAe4ERUnEx1YLINn44e8jxejZZFa1yOUYt27vmcOIeolbZjbLj9sOARqK6+6JK9XS2bCu+X2Rcw+4dYC9SZ6eMV4yLrVciDTx3tl6tDEdndecT5IuhEoNrq7hrTUgmqy9bfXZctT7EZ/Znx59Y/9Cpask73WLCgXHLswXvgl4iW1UdeFd8K9TzOUyyoL9Kc4ZMq7W9AmxbZ6caGmuJY2tJrXqmloRXtr6kZnUt8nbxdFA/QV6eij1Mkl3dIocElZpf5/vycmjQnUtJ/aaBnCv/OPg5kAKteWn8ytmeJ3RXMV1ex83XqjVkGNkGqs86bu6XPFhztk+bKKAgr6Iq3yyLpb+DOtq6q5PbYuaCmi8q35x41CnVxKpCZiouwIbP9cY68nJk0Db7SPx+DqmUkvghM6Ws+pN2tGlozwN25w6rfr68+Rq/FvQdQWI16icvGMEuyZdbajQhEWMBVMo4+J4Tc1m126+EnCxG6oAEKo545k52SSP2okENelPOzEjZey1IbLIXGFF9AySsDL6BPkktRso1WtLMBX0LuruwZpkW01+K7qZL77ol+MNjbkLhH3LRtCnkQ3/rf1rZToC+pAzHI0+NX2ngHBp2hAqoc6l8TFvtmpBYYhZKH/qhVCNdDo/mCyhZYYPFXeNXD+rN9wdDBLNuVTEr+38IMLjpcxYWoJUhk5awc36X5dj5YyNXIDYml7z4yepVZBarsY98gM7G3T3LOXJwB5DFxGnAEj2Sc1vPWZksg565ll1vnj3fHuRF9/0rMrAcaWIXfKJRvincE9WixK1gIJEgrR1UAvriDNUzngh+10UPBTj5Fw3K4HNdKc55as5Dkh5L3+SG3sB2XmHPIXyTqUmrttFEGEHOpHmDGtlywXBc2RNk2124fB3NTFO7LteRQ1vDe3S2JnSKx+PdvNhqoRSoWWMxJ+ipkCb0DgEbWJIvP28qRqs9kBOd542VMRWjNb/TImC3sdXjrrzHyJLsLUzK0SAFt/4yOodx+1i0yveat9KypGLzDO3+10rNX6ruMVnhc5788s2ymJQtSaz/ukQPmy7973dIM+QZOU7aNqxBIj+M8GQJ9IVbrWcCaQyNXarsChZB7FfCeldzvbGtV7RJY+FA+vSrQUyXqY88tjU4pr/x5pcKS5IoSFxiGVl+1cu0MmTC0e9QCOLDcFwZtHwzI6KAViO0LPtWLjULvbJ/53v0y2KkSvgeXc6OyPvl79b2DLMDYERi29dy5Bah33e/18cE9rVIpOcbH6wMfjY7hpI4H6iYVBccAtGpQL/Epj1RTQcvGnt+svjFausFCfq4/+EWTqHSkleazh+FXbpWhZ7P7hqbgxEU4dAYBo0K8ixwj7X6u/JNP2MJnTRDo4rNO+HnMXNXhvRQdcGym7v/MT0d44mL12d7VEvHqmneWJkpRvWU9PK2IXootjGtNnlyck1kJ7ucsLbqJasylIZYrwr1MxX0BTwcVzZRvtnQE/Wn3VH+ryLuA+jLq1ONCSVkmxj81gHwGWMz4TW9cgv3dfnsG+QuiaWx0wADGMH6P1M401cVBhouNtOIomzusL7zFmbn+E0mKm+3kQ7zVfm8fPtbCrSGc5xndoGmarwvmQ+dDUZE59yBbhlMDMekSWzB5P/W9izqvVtjh96D5tFI3ztht30RpVLAq6cjL4L4Xi8Sg5S9s///qoS5WRvfdP1v0dCaE/mnfyuIXeSWNPE8PaLGyoCSdCPCuAVDbpsNzEovr84BrDTIwB0045W597Srvm28ACOYI6jCAR25y9/zIsiRgR1IrJEmPOTpxGPkGdXK3zYVTX3jTo1tncaXZMvzenrGExciIbxIgN6ff6UpecSzA/lho2CwZmkxI+W1YL6T+68w1aHXBG5WTgOSYES3lEC+icnW6ivxeg3opHYJZ/gX+kfIM3N77ulAJprOVDPyI/8rg0Z2qBWdP9n65vOBeLQ2GNGcfSftH1nqqO4STOqZnclAtvhk2fDz6Nj+eTQFuoMqXQ+YLoFyin+HlM6vesmqBjUl3QVL3irII41o7Ij4G+2i8EGPuGnYf70aHQFGHBD3fKKs75vcZaLprGUcOwzoG8caH75DW79KD1ZAwE1QK15YGbzldWw/CXqHXKcAYPLieJMCLxsZsw3Mzav76wlLbwzzHDpwD6/5iADxAMqmzBv44Y9SvjhTgBHRKmQfYweQpBCWNlo4CplvWjnPJArNs4CGGcdz6hRtQnFy6MD88rMH0IW7Jy/zuP3eH5Wu3xjdZF3YSpuSf9Fga1Py2fxVSKGBeKypSWviRyQF3Tmz0HxfslaPkH6tuAjy6ANSJWKPsA9A4SD9KWOaffMn6Ev7pfxxfk9soo6jtBv/PjNuGvT6+HlmJPIWnKLOLs8xJwrk4WKoeQMNZgDtBkeTw4tPui9k6zqyFKDBxtjWCLqkiXBych0bknRxFB9xtLH0jebK0j9Jt2GTicNXs2/rWZCDn5CDc9VzkScWK6R5t63Q+uHXyM0ac2wFkrl8UxdN7KBjfAk0k0PAP/Aiz/5CN3mRV9ux/Phc75rq7cerqgxoifzylzXVxSbQPItIVQZ/x7Stp0jZuy3MZqh33GPhhypkx5IiyKOPyC1AGYGFg/xfkhtjU3jFFvTQltJrJdLBf1sj/I0Kmanj24qP46WSipSCi33JfuFQZTJ9KZEbQlgqRMwF6d2kOp87kPZP6/653kBeDm/ACGGBplkXMkvBGK6pu5wGreGMBJUSkE6Yi2VmIrxeIUC75GA38m11t9EsFwqcHlRBBar0u6gS6V2+adejOjh1A3xylltVG7FIroV/cpkf9NAbiJyDkg41dYw7AZYGcGls68X242dFmwVQLeMIU5NW6jJkGLEvEuWQAXq99Er7A9KbIBqgAWq+LMkmuiA7kaijgouJyn89GItQAiyAERliLFP1xq+IV/KPZc7NfovXIzfzfqmt3UAjApSkBBIYZZZZxLRRUPfJZNDTtlKdGH8Z7cRsvy5adWbUN2lNTIf/uJKue0a23PlLbWLRJeVaD3DEMXfUad3A3Qq4omK5q9wCWfVi1YWKEPvWoBxGQF8K/uejSNRVGcGLbptbkB1KkqoXsHd32NO8PT6oOjzH/UKU1NIxV5r+aptoJmhLZFT2f3s2d5nOa0LRqgpGOKmQ2RBIEngMw1+kq04YoTOUyQoOVaGSyDaGQ3qoVMC/h6TcUrFX0S3cM31jmSkT6ieWfh5pdFBcGhRbiPxDd84pNNmX3ByRpkI2cnzMQq+RcJcomM6MzozwLom81KrrScvB3tFxvUUW8pbXyvzB+N67VBcxOEd9gSF3c/lhYhq4Z6mXqVvgTQwVQwEwf+/ewvUGW+NnQj8C18N6B9k6A7LlxKv8rzfxwTA3hzetH/DvobkvrD1rxuYW9YBMBpDWnDGTjFLLrKst67IZ9z57MAWKJUC2idTvGdhgqcDbY+tePIn36rnqFOpIHv6oc9hxfbjZ3d8xGE0e6xQ7PFhL4MGs6BE8p6TlmoJvi3ij1b4lJRdGQl2pPba3WC/GMk1tlkUTpp8OYCzZQ5oCrVe+LqU4RfSFd79KaDpnzjOCdlNdlGKTUcpkNvqXUtI3thLM8dsKZ0quU0CcXjtoFoRH6qnqkXal1f2VhTCb+8gP/xrgx598IFw3OfOwdELHgL70NTXqskjYu9v2zU6RyEQfycO54TJwKzlT24rNOPQGujyU5YpsPwH0JeFUfUK+87lauD59mL4vwGTk9PY72jB9YdKrBYnZ0uGLDGTb/YPzFjh7Xzdx1W1l/4f4ltyb+ANEy3RL/pZgXt0FHHJu/hksg395n0OHrmCOT+qsGYKqJ44e98mvelmjDpUi8ECiHOTrBkzuFHCjfwVnfnhWc7IePh1+wd5j8NWUml3qqpEnz++Ry5zgOyLqomsoFGKpWlUT+BIdIfF4zyzrTWbuXJKZV4ZhfkH0VHhlTLeAqV9C0wAy8N7hccf1fQTc3qjoBrGWwqoOYY/o048IdXJmtoijf+YORcRcEJ4UIEpoCCrNienl8qteevAgAxX317quQk86m/MaMCrHIfktw7VPPIG8BLw07FJXO8nPs6qfNc4q9oRCA3icF8FXc/pk4CfYAy/vMTkTEtoyMBupoMVLaZEuRUe+y/OhBY0nzZ9D3NXybnIL/+6siBtY1VyrOnjYT/ylIx8Kc4QtCUhz4S2pVR2Icd1oFvKhbneY020WQz5587X5J8av+Ox9A3ezrs+Dazsx+0GezyMybB7w1nV1krFQ5MoEz59UcPf9Jrg5GIjr7CLKAqLMqHweR+D7q/yqggmkCczKts1gR5Pj+i+Pc+QOxuVQCxVtz8mW42B9P/+J2uiTnscUAJmzqKvsYfTxCf0yGaS0rXenJew2KnviBrSp8387pHmjnk6wEyqRZJ5XS5kdXMmZ/cziHaRCPknzEwAA6N7c9ALUR0dwRr1jDnPOejyeHMILqoxryATk3tomccs7mM6JAKqQNTvJC5t2PncErhR/qR58+zHJdMkmUW5pPjJj0SLgW1ibJOJ7gaL9zWEjEBsjqFvVsa5tFpqVtWMJ18S2ssT0w9BK9BnXPZhQMwd5x/9tyk5lKei4kIGtxXuDmy3obv2GY3Rqb1j4m6h3zNf16vtqhhOt4SoRX7NK+KsjpiKeh4+pkBm5vmtUgIvVGUb1PcS5fZBDfB//3bxY30D5UKtR7v6w/PEj+ePd7PmDbx5ji7lt07JgBOlgYt+N0pxVVcPZbIcQayRutjA/BuZ/3Z01js32FLHmSSiQOVPZqw5l0zeRkYqP/ahepeje/32LRD+v4cVM/d+x27j2r30J11YQvVJkO4ESr4VnqTPaM+KjChl7UAR0QiZmGP2O8NfQfosngRKxnrv6dcli/W9zHJ1tUtEIFOEs+ffys5jjVM0PX8eZ+huTF06IYVHeXspaoYPozOj9zBXIisklDhbyOr25yCL8ypfHW5mbM9BjwKkOvPNe6PEoMpScRT54/sRZTb4FWaOzrdNo/k0lKPyNMtkxb+5zA/vfG67oD5R4/9vSXAh4wLfp5MPEtFpgN7j6wE4gh9rHL+HIYPLGiCrpk6pjxgrP72WLoTbQz2CShdPlud5Abo4lHmuLYn8qs0XMoqBo8WhAw2BrduxHdRbt7eZUbr7RzW8E7TTsUqGG1jTlyHiAEkHX46a9iec9RKOOl9L4s56e6VImms70zLtD7jkWe2ZMTzpyAp5av5vhuCIL/uj6qdPU9/4NNs6lac0jp3/bfe5RuXoBs+ET/ddyXG0FnbVehH8dRoZ9nEmB1qzeODe9RxG16aw6j9lImtgVPS6+AgNAUNZbwTTS9zKVvhZ6/lme7CDgVjY+1Om5eqrjJO12Dy4Lf0K23BiR2+1XhaGxJAsOvf9u7Ms3oJxfQlM6c9BGrjf2lRit+Rt/+pcHPSo8QNfMGfpI4rVcZlHaU3k430ZIWAGRiRRqoFdt4p68+ZrsbI8BpyK5JYmbpa8gcQ7RtmTw/InruXcd0oMil7AqeVkUiCVOJAPLLxX8OaIdEsWRou3QdZTRXepPPuXRKvd3SDT4VVGS1nDMHX9gVQBuf5eYZa6Xxed6V3+QZN02tF2mjGZ1gVWEVOd6c4OSagFOydXV/U0w31ePwY23b053Ca/xmNaY54+l+OXSeQJIT/4/bHt5ONvp134I1dq3mhah9qktra0kiiGkoRjaxF4GQdVase+ttloNmgpFtBWjSmJJbB21pFNtxj4h1FrphsbSoLRVy+/68P1ev+u93vfvc3Kfc5/nfp6c5zzJEi/6w5dzZ63Qbhqa1S09CXV7IKGbkhF9Cd4GaJ8Sd+xG5d1bFlbT8g9IzxYhOiLg1nsmxRXClw6ZLMmHOxdCsoGOvRoRKM65ZXVmwhM6LRgWEnTjq17soNjd7H6JbeFx+IfydING6C+Srg5rgcRFdxkk/rtag9tEtNFEI6PqZp6Cb3xpUY2+FdUaBjpWsrqaDvKWtA7pzcmpibBfCm5Oe20D1dr8N6npLfktzUD22aFxsi9fS31ypmObnVfkRCag+2UrhB1/64OJKXAKyez28slc9akmbMa3DPxAu/XkX+ZsaQcKOP7BRMYT/BulRRBHcjh5aICn2tBS3GGUf2wSmBYhS0xDuyWyB9hwlh5jDtiU7nu/oiECS85hj3dvi+b90Kd9/n5EJeuJ1pnQ6HT8uMIV4NVbiHRo2yiLN7Yk9zWXOJIZKWb6z+ZXN7+iOtau3TtSzKCxqqrdEjFRmdb2mc+Pn8bsF2EsmpTmQKWk/Dc2t7pDN33+GVPGBfkaNjb+TKTGRMg1p0V/k6lXWBFYefdyftOQIZLq+Enm3gtGTff8phMjxG8wLWvVoqGyeGY6L2CqUIvizSw2De2O664p0Xbc6eO2v/vwOv3P8vaaCCfq755aHwJ2BIGJKcKJtlFaMyikKKh4rqVjFgpCgub7vaXG4JhO8DbszYmpoWifiuUF+vWoQbN5Tx89D/SQLEfMf329h4Hz4/NT8atTtDYd6O989PKD6XxokD1mdF2fp6ls0SokpVMlpzqlT+xcigwKnuOZhr+6j66rOK9o7C/mb1mZ2JdbKagICPwRhXuVMZTpY6iULkGDjlrG5IVlXIzV4AT6/QR+1rJ9ZmYajmlRj9hzgKh4YZNx+JacXvG/gZ4Pe6SnRrVMZGk+tYbU5+ZPrzmHfOCBXDkMSOmYDBLvnj2unnyDHnBZakTZY37kF8uONXk4YTnibV83t9LQ5vBIzhE95Zo24yjt6EeJdMFbFqmvTB5tfw66pvf7+cDwYAPeRB0rLvhARrU13YGjXzIiByNyymMi/OydMJCwoKpC3Mpw7JrJr28SnV7JoA0Vmqavf1s6HdhbwEaMyr7/FlUpea0liSx8IitKLNrrQyZjsZqZGrorEs9DjCL7xUhv4JhDRfvbZN7iFD4BDDT8aAco75OT00Fzfzqand18G69/9jd4jhbG6xZzbPoxi04BpmJKodbnbvw+eiNTGTwrsHW+TdfsTUcYLaqqtZo7sutTAY/sao8ZNCsi9xDRUP7kkokNR/OqGEhVVm/NEUZd0Oas5sNGjUR6b2zW7Qo7b7GU8iVTT1bTC5tjXQN17WfCViZ2NmwYL1SyuozNqH8/5Xp2H8nwGtiK/xtnl75mOqe85b0OviIxxKcTi2/pwQq9J8UMNpdoalCe8HUJpoUE1Ru/SxmMbdLUpGiCAzLu9y8FXJiC33MrftZbahrafc7cZbTqxcgT+jZY+Yrt10NRpxowRGk0SCXrp/VhtUwof97AdrxO/46y88XRVgHOhZtA4zFXKuzNn9Lb37l4W3teO8DgMSM0aPOC88XUf3Qo3pWOisbW2TJvx6DwQkyQ6nTbxuDXwVYGjnOe81Ty7z4sE22ELrv/oGBRSffC3MQfrIoHybw/6S9DYyP5deLwLW694t2AoDRU6fwNxqeExwmmbWkn7pqm/hDmxAdt9oOUh8yK7mUfbpBbTewwbjhJe7RodV4wgm+XnxQelMZnoACMqkyi2dlnM0V6cysg5z0DaW5fpv2nhm5IrujDC4MujL4648m5RsFwt6q0jEe26VeaIO5xkvvfjzU+nLrDYYfDrBTXGhUbRE8pn8I9+fRFSkHb0jnsBU/yNCq2rBi9O1ces4xAHJLRwkcepgrpSuiK6Ar29yGTP3TapERYvro1tAzPVOhfqtW8OVMIzFmvvH8qFQOSkPa18i4ZANqbevr1kJa3tLUjHJf3t5BvxmX9mutYmyEH6h40m69hJmP+0p467nwRG3tugq/go0ICWTunnMGHFXq370CiaDoDHrQl0OS/Y8AdakFpKa7pwgRfk0p6mtkBivC7Hm03FGAUDvOKUwwPOUaL9mMuQHRywApECFLBszOeSkXvMwcWlbsaSKTUDGT+6BMnO8Z6Elm5GQUQROkaV/sAznvF3ONTTS+U/DPy+e0KO9GIdqrBdnzl3nbygZ3UV6ZF8UYv/x4HCg/7w28E+CfvW5qBcgN6IrqJJpbE7CX8//SBC2wvcCYmMQywTNYcDKvDMWEoYoVYrwWcReEHiFfnerTdbNNWzaPKCFRuQXrwve0fc/+JRdSieB+t9YoDHW2Y7nZRg5X/1rFXJ0pKlyjbt8f8tRZIpJBf9Yk/VoW9+zl+ZO81OZUeT8W52qT9h84LgE7IbTr4abZ9unIys3faSuti3tFwyYaQid/+uxAv76PrXDVz5VUY/limixTRCeOyk+5r+OPb8EGRi4D3mBoPgWOecZJHXTYEEKN7qQMfZx32jd/2lmd8siHxkFur82wRneuSst03ySeud/wudYdgY7s6l206iRBOcnHpv5FtHam76aiAFwy/okF2S+UjklNdUKFjRaLN36VhfmLDciofjzWHEyLCThPO/un4/Dg06LqJkeNwf/Esac9c2Z3+0f9bsWUwdLOP5FFtnIu2xw+dCHXmBhdvbcT2NBaoowhseaZ25Wu4+eT9LY8naak+a7oaKIJ682uaffVq0dzmn2mF1b6+ReYOKII6qjaa6hp6SRmSn88eMthYXCQEn0QR1PdxB67irKxdLfvY9fUODyvVUYQ/x0wxJ42FKsDShP8FbCb7f0Hg4xOatDYaz+9stX30sCeoo0LxAUQCoWhUOMqoTU2qWkLyf2ZyJ8XBFe5qycRyEDiEqmt67TmCOoqbH0B0/eijtHxuS7GRfElQHBmvznHHsPdyc/PVUYQCcaZ25UL1QoYA1eDuc2xKLakLzOIjqKMIw+/dK2XPykLyM2YSPSB5uQuMyAqwNGeoFOCCMcOctIWveiDmryTjrJA3r5atN6nBrAjqKFdPRUoBqZ0qDpZAxus+KxeVPMrD4br5S/kk7JBAQvY5AURX4wIaVklpoC80HYf4hU6uyxQiw+XGI+nkNH2Y9X9RtIWy3SJDbGb/rWt13CUBLEPLAoiEgqLRS36ROOY1+ckYkpJArh1S9zG6ZP4l4OBKUaQUGHx5YBbv/BHbrAUjO3i4WEpsw9oC+JYH72xNLBwGvD5HU117V/LE3a1szjb+JIPl8oM1UQT1y/qKlAJqO1XpRTCXlHOUU7iQfcISvCsNoVk9BK3cPaiXJlO7cgVWEry40B8x0MeNwiGymruEExxxTP4f3MkpLWBNq2Nz0faelzDpgmmSMzRQYeYUWU0Va9uRM/fHYhI2jz8eeVCJogDiTT+RKF0Yeb7zYdGoLows1CnKZkmdjQ/HsLXIauoognplH82+p6+PC2rQa6tgbvLFI5Bff8OwkzOSyfCkpXLq0/SjEBUUQf1keC5a/k5pZnCFWhJ3q5166W7uwr16ELNCLemf+SSANTcrgHhIBUrZA/nmrCyUW85hN0YWsi167iRZ8joWcpW2ojHs9DsHLC2Y2mJ2lzCzQmnSMyw1oQxnBPIrO5bxpUIt6eNpzjpLClDIzRc0e5vG47yV/LnM9dI1OkwWMoz0cOkCefNtn22rFNoV9H54dMMelw0o5o8o6vDjj9gNxQYp02Mz0kuNiR5npSGsYA8XS7C3uCI+4ghO8ZmqDjcdcQuojbw4U1tscHIGoXB5RV+eXLpGbzsD85cKtN0xjdKIUm3T+OcXOnnXBpe1jx1NHT5RO4NuMmtTYwxlKlSoyaEw7C5QXd7K4QY9He7zg1KK5QUQD+lAKckZtSwIZBKcAoNSYLBAVqBtqiHv5UKu+I70M9i+/IZPmWEu/7tGn+T3FqTatfZPOHGuJ3oMkG3kxoM4XiwpyDOZ8YKjsVxLGaAOSaEX37wNVHHU+nAkSrlBvw0rHiFYN9AX+nTIJ3Q07PLXkxxxzt8LhYW1/L69d7aes1T2izdAs/c0LLs7K24O8paeGSbMZw59wm5ULOSz+wvtFDKWGaUVXp5XBb4HYtjPyTZA/j00+y3LkZ4ESBSUV75QuN4kmmIOpdyqsHE19pL3EV0C6eMjzJx/ZuRhbTvOwBBAFEYwBgW9VzQqxVFjtCwUrjcIJdTbjKZgbXdkeWkl0TEuy/wmphf31SHzhUpwCoAdjwRF8FlIzlwtfHioBRT/CfvpJQ1u5CWf+mDu3eKX3Agzh58ZCnRyp+VBAKdP7hplL3AGu7/yHDllLL2q6II5Fe7sav3Oq2I9vD6oTqCTr93YdB5XmFnLWPZw6ToGUQPCQZk0e5jISM93fo6hUdeCryIpZliEscwYa6eKI5lPT3vJd8h9V40C825ySPcxGZHgVqxth/TBZ+u7afYwoZGe79Yc7ZLT7/V+O84M2ZWewSd6OF6Xxx1qrGTBOPJ+hrwnj++qMWYYjh4uUCFjQFTDZtFUwqXKz4EpJxsuL3/widmNGwLBL9vV+CR6QErXv11wGHurlHB8YDFQLX/oh5cFat1NuBXctb6cBlCVwwcQ2dc+B26blKj4y/AsOMZ6KRM7Rtdfivu1Sjg6vwy7/FV898zVzqpK6E/TgSvYZAw7PeLzFeDgdj3M1K7GjvR8xzM0GEkccnO68852LEOFwzmprLX35VHnK2hqYyXL1ijes3b+k6/WYqYUNLWWtPVjlk5Oo68nAKxL7u/xF5X9iLqvLfgEBAKBULZOiDprv2v/bwBL8FLaNpMAAA==",
+	"static/branding/cayley_side.svg":                                        "H4sIAAAAAAAC/+RZa2/bONb+7l/Bl8WLmcFGEkXJN8XyINtOscEWyaJJtxggwIKWaJlbitSStC311y+om2XH6TZtZmeA9ZeWz7nweXgOKYlZ/J/jjDwPvJZFpVi2MQAjPwT3Gwpek4rTClxtzUYq7YIrzkHtooGimqodTd2R59nodyyhQtMUbEVKFTAbCq4KkmxoZ7kAf6dKMykAdhH40TrA1gR/urQpKrkFOamAkAZsNQVmwzRYM04BLRNaGMAESGRecEZEQsGemU09T5vFMgG/tjnkyhAmAAGJLCog10NHQExL2v42xhSR5+33e5fUhF2pMo83rtp7d/36l5u7Xxzsojbog+BU2wX415YpmoJVBUhRcJaQFaeAkz2QCpBMUZoCIy3pvWKGiewCaLk2e6KoTZMybRRbbc3RmnUUmT5ykAIQAeDVHbi+g+DPV3fXdxc2ycfr+7/cfrgHH6/ev7+6ub/+5Q7cvgevb2/eXN9f397cgdu34OrmV/DX65s3F4Ays6EK0LJQVoFUgNnVbIoI7ig9orCWDSVd0IStWQI4EdmWZBRkckeVYCIDBVU507aqGhCR2jSc5cwQU0OPdLkjx1mOFj+XOQe7ph1i6LsIAioSmTKRxfDD/VtnBoE2RKSES0FjKCT8eTla6F02AgCUORc6SpMYtrUrtorXVUsTj3KaU2G057u+Bw/uycE9UZQYtqOJzHMpdB0p9KuBs0rXMRw0xj6onfz5fO4h7GHsqHTt6EoYUjrHoXqXnQvFCCFP77KD59d5RVqmrJAp6907wNVyqxK6liqjrqDGe3P/pjc6yE1NOkjDxCedkIIezdqBzQqQnOqCJFR7HV7Hb6jd8DH0/Yk7QXgc1uigeH4N7FlqNjEMA+TiWY2wNIZ6l+Fm0KaM+kDkhjM3BGo+D+a1S8c9SmViycQwqY+ff2iWUrdblT4RLQupjGPPh8b7Q/SwkTl9WBFFPj1olTw08Q/atmPyYGhpHoYpC3E+ZZkWLIZ4jM5aq966HAGwyKkhKTFkBECruUPCsPYAYKHSdfT+zdtmBMAiSaKPUn1qhwAA60BWcmtiCJc9vEiTaC1VTsyS5SSjtjP+VOZ84R0MR86mKmgPtGkVbfrk7GZJk5zZIO/OMM6v7SQQeCdJmeF0ufD6/3YqvFZGK9IbqFx43SLUo76ytlDpjtF9k6MgGU0klyqGr9b1DzaGlVQpVZ1pUv+OTLIgCTNVDP0Wlqt/0sQYyamyD4cY+qi1ZIqlZ/EtS+k5Q19wS6+f6KxVb0gq9zHEp8Y9E6ncO+2mwP70KY9+d4XTAB56qF+osAvUG7m3UmK4JlzTFl0z4+REZUw4RhYHlgOc07U5a1DNzGcsK2mMzA+mem67XPp49l7MZ2ndAxcHE4Rns1NzUsYQh2N35o/nj41VDMfYnU6C+XjyxCqVMQxw8ITRdkEQPmHMScly9pmmZyqYbJWiwjicVFQNzqqBixakcDIuV4R30tt2711sUZooAOxeimFZWQx2oK2ZBQIUTHuQ5oU9bOsH3rhHd0yzFacxNGpLD77CvlmkJ6il1vrb7JwJqqXg1YmbVCxjooyhYyswDebBrChPrFUMncD1Qx+j0FqbI2DhPd63NZ7StT60qh2FXYhl0ZhKP4Z+p6zEMRx3g8qP4XjmBsjH3XpU+BFkU9tsXUtoU9mF0UbJTzR6Na9/l82w2WcRdsdP8JgPifjfyGT2EkzGR0wC9GUm/viEg49enMTs2Rzwf58D8gfvHgMq4e9Qk6e4vEinBmjA5ahR/fGAxBMt6r9Ej86+iwJ+iQ59kebA+KWZfHNv4G/r04SphLdkknLYqfahOekG6mSTNmG478fuFD9OcYCHuXpUlYOsB7Qaoo2YNeM8ehXi2XjdyTSKCG3fUO3DiAjNiaE/ogtn4k7ms2mAxj89KbFfYksL+03Al4XOzgoN0CP4ccZvlpuuwrB/nH+73Nmz1Z6RNczzm4hdh6sQoe8W+5zuDfA5mX/w5j2qpo+Oz4cndIZfX87TjL938x7v1a/UO3nGZn0xwS/UwP5zGvj8qfQbNXDzIPl+gfNnKAzPnkSHFH8MiWsu9++lNO1TPOdRfckVw6K9wR7o6nwDhE8pSGEczT7TaIqL8rIZWlMk7D0Mb5AdUYwIc4Tt6w/8I0gbRU2y6TD7gtDeA0Q+Hv//JafGUOW034cRKsrLvVTpEdCsCap/9cBpbyoiv32diIQUtJlwTXLGq+i2oALcEaEvne7r1WkItTe89a3twQ0um9WjGZNi+DV7QAOEp3C5UDQxnUPjYpEA4Tk8wO1lSIDGLprg6XRgOtwxzlwc+sEwzN4dYORO/SkeD2D7zYpsIjzHaD60nC2ZbYeFdyDeSPsbUeRUmMUCFPiDCCnNEoCFvUD8T230aHIfof+dhhkdKha64yCY9S/HMZzOXIRROMGDDWdXNEDBoxcUq7C/HrES4XJhdEH6Luw9lbTLbf2P7ltq5wAFh744Q+osrZfb8gFCzfikfFa0QzjLRKQNUeZxPdu/Fzm5TGnElWNWbZBINlK1UWcLBd7ZLF8u1w8n3hfNPz/AZfPnvoVXr95y4dlJl6OFvXdejv49AHb3QLYnHAAA",
+	"static/cayley.png":                                                      "H4sIAAAAAAAC/3T8ZVzUz/s3gC7dS3fJUkp3h8oiLbnSoYAgsSzdDdIgLa2ACEsKAgsILEiKlDQssIQg3R2e136/v999///nPucBT4bPzlwzc81c9X5PnN5zNTJiJmIAAECmoQ42AACw1QAALDAhPgAAEFTO7QEAALieTzWMCAkJCYMI7WIBAAARTN3UEwDg+ecP68TFG/NrRi9VEy9Dt9devi897AG+vr5Cb6DOnrYvYfZCbh4OefsKTADAA4AG+ImRX/7uou97C/sz5NFBaVpiuB7OR2ISLKoKfHosymyIHUdccbHJw+6uiF8LvxJIokCE5Sz6NLS4WG8S/tSXKz958Y6KlLvOK43iMaG9gXTOMvsBmUtawVxLg+9+4EHBiX+hwmPEAUr894jiSdbJRnwvqO/9H5p5T9uw4fAvXUVnAXc39X/ZiH9ivyb4a3G0bP2zmcY6VtGPunJ/oy8FO2/swsF+rlP49dVoX3xRpgOzXOtGD2ubqGlWw/rA+3D+r5tN2GW4oTzYLYRsPvgw58PN5Su6NpOJmrYWUYKgCHJl/jWQ3yzokKLL4dPHkp0KQraJ962vB1NFIQ+/bno85mRfWm8qP3rkgNO8Bg272DCPT3AKUqJO3d/oe4l0gBWlayDYPpoFvCVP3655myx0kybNw6WVnEj0cayQGS8fwEJctfMo8SJMGeDw2zxeXLHX5np8EYVQguEfQmwyPotY4dTgl0l5ebVvPsK3NehP47Tl03McJcPrwMnHr9qTzbffwMnG2tw0jz+4BPbJLA4nVWLxYqlhcyrENPxkRsoiR5nlWulugH3vSzGzFYtYaRDGcUW+Lvz+6HcosgWp64oYLrK53A1EViBzMY2yH2gvS8iaGQ1zudX6MvQcR/eAFrF5NgX4woDPgNC6wYUjIh7TUNpLykP90RWjbQ705dvkmtBLYM97rvr1gfe0NPOe38OKsJfPWbUy7/GDZJcNbosOHtfXtLW466BTkGwdthW/hMMPJL28TFNKRNtNPOy0haPPUfNIQWRNhy3jh81wjausVeoOnEH4/kbfEYI2Ku862T5sH6djdmz87O3B40SN+CcdOCI8j4j3Wlxh38ne6CYiF+vYH37dPD9iVafzlpbex1F4fE3NCSu6iGnNY24+Yo0V1Xg5mCr6+KOiNB6CfXbWV4CSCQ/1wnG0AC3/TmALClXAQpCFDp04v7RF3+9v9D3qwBHlIXIxGZ5sfxpYQ9s8zx8Pn9Y4M0fXBWm5fzx4/Ave1uIuc0iCzuuwrfgIgjmpqBnnHTxOPBpM4zScId5rGQpjv07m5ClAWl1lvUo5ePyrrK3FnRAmPbYun/X+tvRjyY768hOGtQSyypxWiOMo6/M7rmdo6G72DRD0SehzCBVnGLuPtDTbvjyhbdCXQk7DbOK9Fl2cULy3AcDeGM5jwV7+YIJUusXhpGWCmkPWGIKE8FEpL6+7Rd8HfT3DaZyGvsR7LTrhBBpQ2jWznS6yWu9rINdWax5zHuz7G5Wg/tXBv2EFTHKthRessaLu9esDk5k2a7MEYUcdtke7rjafg7S+LPg+0JhaRM2GaRUbX7AmOOgC5Bf6GssKZ/M5DaWJ91qeIM9MXuHuvw1V+6jYnHvwOO3hrMI5UEAU27fdYnkR5XhBYii9+x26rcE/tiofiztH1RwcZTon7F6b4m4Gm0kR9e56O6Vt+fDrZh/ah2v0Jpk73GeomebghBVs4CUtvU98O+HLQmQEkHL+8yd3TZ7C1mk4IFm7XQZHK1EzZ1s+LOrLTImbP8rbTnvDdDR18NvTQCgJqvRXgKW7UZzwhnl86DFrlNvicJI10lXB5XoaJ9Htz5+APt8HfZNpZyw5AWNvuZ/dydaOFVbuzDwsjwguPJChghLuB4jxTAjIiuW8cBwNxp4d9WV5JAKU6fGlKGSNCGTV9r/xlpYO6fal6JtM41bj2XG5V/89IvB189k1sDtmf6MvFZnTsaZO2VajXWbqYSdWOVbojK30Wz6ZxpT8svDggcIV2/5V3o8gvnI2qDaPqYdd/S5/B476x4Dg4e0zf/Ko9tc8DS6NuYOlTwOFCH72BOB082Ezb5jHiwcTUGXvb/SdhRuH5R+YGLyWqgtRUcteBGtvmIymDsIL6nrKmWRt6g8evJJi1EqEf2HblxPn8RSQFTOVHPQFPHqRMphakWzpwJOOabKX/ra9py/M/Auf+ef+inwyTcPOTzvMdDyJ91qGYfJxedukeOfnrGDt8z5Fa8OCo8XhpLwrYPf7cozEAyHDQBTNMlZPqVWhg+Dc2Wux/jTOdFUpbTFY0RHrY+3t3/7TaZZu/47+9eMBQMym+mkgFHHwwKkCc2Tqsg5kxJA10umyWSw5AmbLvgBG0WlfgGTW2SY9fduS9oaJh53Yp7FCZvyqLfnkxIGAgvIq5U5E1dwiSuanL0UfeDCNM13S9ktEiP5gwKjmdJG1hces8xuxPkzzdC97QdXyYf397ardTMEgjVay9vQsmbCcKbvPj+uThHRLXx5DjFy2nTYl6WfMVtNF1vm7reI5rwZTB3O9gwnecdgGEejZmoaGuNfvjNjFc6vxOC+dsT5++21TPoxp6H92IT7hC2AUG/qP5Lxt+9plJh529X1Qv/HBa+ccTFugoM6/bSFdzxyaDgBOAjujws99FnO0v6wP2CUFa6jx7rRj/c/BDI8Fe33D/DvWBmKreMkx/8mZGRs/Sz54kBiH2aGEXWMXifpCBbu7q97e/65hvfBOeF6R26ORM1awoKSX1zDexk0yJ85ugHiO7WDqYHF/EME7rIkgAor0AP/CRruZvEEWrWRtusV9+TBSxJQvQLQie9NUy6c95ydGrHHl8OZgglSPszSJyaGTqsxm95k80X8WKD9I6wuB4wWrWrxigdujkUNWMAuTXOtrgO5NMmfvPLNca0AQwUvtSczieOcdAAyqlqzaAgI79OtnRv5Rr8p9fLprIOjTtmt5xVKhmxHdao247T/ag7nub5DtHWvqH+eQncEElA2htuZxRmtLb5NrLoIJXmoLYpbxZ+vt9zbFZQOBayDoORmrpapLL0Ry91osx34wdbB+GccziCAVpBU2FESwMmiP2HTjEyfwDWZM18hiyflJ/HZ65JhVzeybWOhNTmeRP8SSh/Ai5Oodt1tV5xdIQA3KVKr0db2AcVUtm5ehA/FeCweSGknXYatRX7gqAi3yFDrJrmpiQLDImIPFOnPK4TX4+fi3sNkIfxXfjh0efOEvmVt3fvgMblqXOlmYk187VrhAjhj0ZdGQumtvzznfX18KPZOD8R1qUZpu88Ln9aeeIJvDPcO9s+7uyibYX6Z/dpFODAmq+Fiy83r5CYOhllTVazHhZYNvjH+OWdUMBC3k4nmXb1Dxoe3AD8hQI+/myxJ4AfrqluDidnrr7q/V/LGtAFtHfDbCoHiIitOQvGpzYDL6aSDU2CUvUunOYd84R3+2pPM7FDeZPjXblrCCx8ibE/0Uzf87eeHDjUaT36THFpWP858/raOYuy2XW60MSjPv2YyVHtSfynVwVvW9oLZo8NcqWhNYlKIlJESR6t+YJCAHl6OSoZRhYxhfPmr5pPXmdkgw0e/vYm5/lliztJeXzv5fEdtfo2+ntBWvhESGfCmI6g/HfTv9JjD9GJcnAZ4TvC7fnnFiMLaLkHFAr+5Li2I/BDJ9LQ3MS1mOgpgcuNLtX4uWCZmMplbwR5+jwOi/gDwT7OUfzTShlXDqMHacwLcuocqw0lgFlmBHnBK4/KoT/R5V/ZYichwk1t7WcpSHbEwlyFYiCdOV9PJSXJensF19NZhaIR99jpoJX75K/t5AciLYSx3wNxr+HEsIW4rkSEYr3BqnGrTaji6DJ2PNh0USzojfs8DyYwWaR818PdeMUDALxZtnKmrZtEES3r1p/9rlk7D0oP6VQUtkx1XWqqbWKcRbBkYDIxbh67HtIMjHseABJYDixHAhrPgscZWmNFL77FjZ8QOa3x0Xh5Miw+TD2ktixaNH3P78kZvzpfjXfGO6fYJYnL04Y9X6WYjNdCLYW9+QBPG2RCugHz+gXz1qC7cFrydvq5CqQS7whLCYnkrwY3diPadLnfnBOruIQvDV0PS8gBDkPPcFGDLJtYoZb/54f2/iYbf94qOiNLEfgOFAPuv9N7LdDLdHPsbzjyG8qxv7uyzHFqG9w4v+YnZt8RXb04gWgyKyuVMslEVF/OaYl7S0FIAcuzJGwdhMEWsXNTZ+VojxeLr+4/GQwTrfNZ+waiUN4/Nsmsd/5J2COwFlAAiAiTJ4gg3tgGQEHfvMnmTFOXijCv2X8d5GOcpRN5f+laMkuuudWUTJUDZT3wW8XCj7aYvsIGO1rL0G9jBYC8iKedeOFQ6tveAOHvcVkDwWERJlGuuDw3P7hyfkkmGVd/cx5pqhmbFG7V+q4UB8IMEI/jTpuBcWtPjqCmRQfn4LWBxO2lmjKmUlOJYh8iE5rH6bXIPV/ryvtIBbrQ8QfW4BnGF2zz0wAflwwvQ6PskowwmMppLcB/uyn0rdCcMyYlHmvbLKOBbEQQJ2E3mwyjEr4sARg9Y8ZjX0iz+Z1V8Lnm4CHCS8vOZ0uiYVtZITtz6NFS6wI94Sk+DzmFDKcC4bKHx+was3teh+ktpENneqi4j6fUU88uWREOXN0wFP7e/D/NdqAz60O1CoAlm2XkxOXSH4JDySWa4Vevhfv5X/4ddNn91HwE4HdeiK4/IXowoAC4MLwtywa/88ZtWn2VoePBGNoH3tCjoOYU+5ofxAK4PPwLbqvgx4jldDl5pbW78NhaLIm7cFrXc8p7rTOFQ2gfum3xxU1IxXif2Z1tK51fqqHkNmGEQvCzabzM+mx9lZrwCyBt5Jt7QyPMsGQaCRdPi2I+pbMWfYR47ckb5h2+vqWJrIweVl3BFeVM9mL5IWOQcSkwa3Ye1AoSwpWqfdNbknZe+Hjub7yBSUX/a3kKglorTBS8es6aIarzBT0psacpf2f0ZeMHheudQ9CykLTIZbp2DzbpjHsypF6k25I36fFZHPnT5B86GidgqeNt2J17ggE9/tgTBeaaL5eHP7qMz1Sae3NLrFlef43ondiBvxFByCFuexTetL47QN0J86Qnz6OSRIyS4ehL9QGyDuDExwsXIzsuoh47TMdtMY6a2CB64FGPZNHUqIw/B4D+Arsk+3oFCLF+doCxvqznGBg/apmeLAvLhv7SOarB6KSB8QlbNbiagG3esDDtvtrpStEp+R/Og1xE1Suv+b+56TsBBeqn60INrEZihI6+hCQzMREBH12xbBsBx3ubJMxHZhi2likmuN71KyM199pd0NmzrxlEKdfos5z580foW7f3K/9t5WjGe8anPgfRj8efdU3aPfzynnmscKmeMXukLEoDepX9x7YrSI97GhBPMngr1GRsIvphrdb7KD88LvHpgT31LJqIKXYkFNZpiNwPsKSIfdNFTTTVQ4rMTnLeGjJsbGG00gB4DzRxOv62NRbFpefVz53Gp9h119n3T1AkJrVJG4lP5NF9b4Z6lKyVoZPDjUIOCevYWYF/NhSpCWu3tPCjszZBGIyN0mfqhEysIHSlFlhgS15jHHYLetKfGmhyWBlHq9UJvrUmhl/ml+g6nhSNu3mcSUDAe2azb/7gwAosj23mzjXYLqOuKe7fNY4QLNxU4QC7Qy6UuXcj8gUkuCH9tttan5Pe3tCR56P0jraFyzSCWtYtsMAYqM0b/2fuhAdEiClqD0F/8xG7QFhaLYZkkuHw6Fvpgu9tor5UayZP2t+gnYd/nzB14KnVRFrHQYPKdIJeEPBvbZZXKrle1XbDOgGW2mqGK+AV2EQPa+9cKfxwqZE7XeTZPfk658z1vYDdISTe+chngTHgajtR/Et+p7Rva3GoADQH7feragUJa3/LZ1Ai+3u4Kfv8b2ZJJrxa/HG4IjG5By3EB/+tmn4A2jQKBtPTKN0/DC6CwbyWEPlNUG1yTzF1bpTY2u8FxDIZExocTNQX+FJf12tQ2nVNzbYZ9uNcEdrMYKfVyp3GplNRXbQKRKRuHD24eHRzJwQQdVkebfVD907/e0wg8zyw56BDdDm5Y5yQ6fQE7+6rWyTRjYFMcqUFkb9XElCP6WPtsX+2FNoGb2yogRYgmBCPfoXJOlM7S/3Wkxl7zcdOaY38fRNp4iieTgnEhBnwTZ5ajgr9SQ2G5nnbHktH6CW+PVCHsOJIHqQy2OSiFj1O4iY8+2WgSCTXEtiPP5QewZ3HpT+ojuLDX9AHuItVX/Xr9PEvfXO8Mp5rDaDNl1Z9BxDGJVlEcDc4FrQNTQL65cTFbfXSFfgNIQabMaxfC21QBGH8K5Ux8UbNbTni1QHHWIKn2XckdY03/Q8W5vOx4OTcxrkyzu4agFrIHtQoL0GdNJs1hyWkvhDugXipkeybH5bIYyFSVw5lVN9iBJuwleGHUF/xLOc0ieO3Vbf4zCDDtMa8KaaK4GWr49h3xjD5Q1BI/E87PZpnVjIuQI5psJ5ABVzM8eQYdCemujLYpTRAEgX32LLabjG14+1u23XO7LJxphBwAHLOcoRzn2ZsZdox0s0xx4ck/mF5POHo6TMEHBLomzPh67vAAvrE/UnGHUgqJmpiJqzvg1/dW/ehyJYNw1zydpp6Z5qFLZKFuwZxX8vmk63yUie+U5Y5dPqYJGdAU8B9RRZ6+0LTFRvrGRN/0HlEUGQZgluEco8bHePlrMZuadjnx4CdgugOxVbljez0Wa+q1gxJV6k7biB7gsOsRmUyxmjw900U+TJrpk6mG3/QxG7Rf7KzCQNlw3dkDlSDXTAt4KhOLNd0Xt2IewybwAQ6s1bNrLt2nQr2wmKIazsGcA1aDjAB1sLUZIAeFt3q+FLIXEymA7aVJDekwGJD3yYefWVmMWQ4uOH+RMBiVFvVx2fBB3EZ4eXr2mngiIOHFhzb+4I84j61asdYCcIWwmVlrpgvZrBT0HkhE7/e9bbRTVyoRwGz0LsaYF3QduEBqzDWsJfP5G3uyo46thFQn+VWgV703QnZG3IloBbZ6xj7U11ICox/ad5qrLILTqdAhLyZBZl0RJHlvF/XXTfo3xYyLgVmHm9hDNyth8emMFjRK4+UrWUKiQ3QQBzNOPamKNjpTS8tmVrETwFUnNT6ThuFomEB4gBn3YmFEBMoBiN4vm2AymDn778B6dnlHTdKsAfej4MkifOxKe3Fe9ca0kwgWeyEFAns9wu7NpinTuAlCalQZDkze/Is3JfMtif1arumiUwb+7z2hWyFokwGY0B1cL+uzCudV4TozOXth45Kh0PASFxvMXculNdSFfImWRZhy5QgAh7BH61KeCrxLS4Bvh6WFrYZCnEsHUxixH9CCDN3Nh2npTwoDmDNl1KcocKmOFvkuMjBKQReqHxklXVpREbSwKU0be5CixS9enUu1EMlZgq0SFcibDKZ0wknDhaJdrmRqWrmUQOV+XUSP1pXaOSq7qeiHmGEL/iYIC1Mr0AxbiuJHnoOPly+JjNYhyvBbFOcmcFxPUaly5OIXPCt4qD1X61cSRt5SoRWodqwRf159KwUrBjtRiOcatc20/g+dcv9S60skKjjm+cX+jyPUMnms8xb6ykRalkbjU0/5W3o5DFJuwsB+I2Dx7HVt2g6UL306J+NiqTVJDIug54IdydtTmwWRsuCCWOM3gtkklC093c7OolidlmiKPrpiRV8hZCh36NR7uoszfGoNddqfisPTxmLeOciTSKVu+GvmJbLQQSxJfsiQh9wFzhPOf6stiMR62aU+7engnmeboT79XX6kPhS3uPorEjS8ipUAeXlnUnADKHH6Q2tqG1SJr8ZYJaue6Fu09Uoq+lmn4yeGM/icQDUjW3ijfnkb4i33KKlMmC6qa19FLF3i47qt0qNNN+zQI35jwSztP+KcweBOa2AZKWWxKeSkIM6AjiYVvU6A7kVncwK2uYcUAsY7yxArFHVkxU69Hv53PWQoae9QhMQR4t4UcFtF/fMNsw4nO8hmwcEdDsffP/D53v5ZF7dwlwgtZJol+JKLlZFt/cqjA/gm6FdV4DI28qWDmOSrzqnZFiNU4Fb3sDVC3W8e1FtpoIcjbvqusbZMK6iYLw1NNGTJbFIC0Uzs+8hywo2xRrPFLrJDUStZ+jcscq4QlkSFjJ1oTs55zWQwvsGk5+5RRqzaRHbqataHMFGw0VwxfWMu6P6Cr32pHXKvnmJBVwM0BE48U3duZGYRBCasJP97PY7IDb+Fs5NqCngPcIA8Xi6PJeHgNUT4Zy/MtOYlgf0bjys2+XNysl/BrWouk8qSnEubYFnj5QqC/lRo2ogZTCeG2P1g8ImItGIwV/s2fURt5k0I7huHcQH+c5l3ErxauOGrt5DshaPTYNkfuyPc9UZ+PsT9rVV3OHkPUAP1r5xnMjMVbC4g0LGWtEwtpiCVZ89fQPaqYbyQuHCDPf4SsI5rj4Q8Bb/UQolC5ErzPhhG0fsocFq+8FEWeg0eaehxtwHYdsflkid/bazLNWC86dEiW+o2Ia4oMh1jq+fblJHjOqjYHSgvh+wAF8JbdX1EbNUpTJmujcb0pfPQrG4OBMnIcZZrUUFX2TCy9Kd3VtEJWFal2VpkX4NaSFHiTkTfFfkOjZZxKFja16noqRjMDMJqJFvsRmpWiaf6qFF5i5M0HjfB/3+MIhBHu49vH6ZPh1R6AUTusrx++oTqMgj+HTOlLEtU8i9j5Uy4WQ9ptmdJXmnvGkmNkOHUXrpwhu85DeeVhcURqVPe7v+sxswgPeEIVkem8GT8Q2Bg7nEHHJft5lSrmJUfuCJZZuPOvr7Rx8HvKS4MclQ4+kHQPbYqofsP6QOkLkSfnbzRrH5gBXLhBwn10k4DybSGE9aK3uHa6JA4LvqIBeD9FCf6nYpsHaYjk4wb6M87+2mjJ+Vq/PlCaBS98ctVeNEKbOvGK+LMTvBWrBrdr5qlUMJW03SxlsNHrBxFxM9nY5jEuAYpQjdvKcTVaQYhizDH111EayoXA4VLM7mcaeatY1OpXcE+Ju1d+JT5SpX3eLkw22lqVbaU3pSCC84+BhbWr1fbQZ4qOYza2WuhD0FN3g27qklp0WltDuYKoGfYtjXHtlodWYpoOOAC87mHjibppKu0u43QvSvgcOaoBwpuZ0bAHMsa1PU/SYN2RFaug/jncUvuTwxLJmy7T5Q1Y0PdafTtWpsaeGRcJf44ka5SMdsmd3bOKwMP9i800xNLkbLZhwNiQyK3GA4kQXot5qPimhVAifiDySHWqEwtqF1AVizJLR2rvGnkTQ3/58EgRv5gqgCmre3AD/RmMzbqhUjySVJksFdvbyFn0aED7fTCrxZtr0/b7n8B7WV5XSZf68opqkdyO5TZRZGDVbccw9oZX0wY/qxPgMYQcPwSy8c5ZdR05pvvhmZH3w0MctJdNI4XOcj+E+f5LrECVqksfO4QVyEKWjx3EBZ7whd1sWwqBEpwpzSWNzqSRzT9YPGJjLag3RPPmiSPdl9mDg2sTuzOfmGwFfmqQVJY+pRZJJY6PFgo8COh0s3XPnLT+nHLxHLx0TgVj/8atBm8lruMVwbuUgBpYV9h//C3QZclFjtOM7Rs7wEwZ/eZ6bPIFA/5ueOBBbhhZGCVEYdIApXrMsN/e1t4gqdw22fVEMMchzFuL5VgJSnVexfcqLh++T3bLMAsCN4BggrD62IwgQW2p8u0ApD+ip9hoVMu6ECuZLrXNicpEHS5HJy3bZlR8wQRdbkyTROTOE++saD7Q7oKgrWK2N3pHCKx6yVSXiW+rGyQ7y+P7RWkNphrDg90PFpMRPQ9/ls0OCSAFy67k99vb1MB0YJrXD7/wqD0Z1SPZx0ORDMygJbTCb8IXwsxV11uY8kv2JRppX+Cc4/PgFH2+VXo9xYf9l8fvQM78RPfivggegAV7mnP+OVbdnGyVWBXS3MOtGhjtMh+dZ55WzvHEMLBrbNI+o6htIrvNXePXxB2bqS18XvOqfaosfiCu/W9xRXUd936ICOMl7gpVj2Y7yS3jRbuHsYOzUmOgAE8cHNoXc7AoNPk63BjrgrGT1jGlwaFiYzAVDvwOub0Tq5MXKS3jtZjzQusidVXXG9+PFjjpuEZ/RFh/vVkLuHjbx9JNilJ1/33vfBfCUFOjW7H9XRhNrBTAHGEwNY7gPl/mRTlLUjdvb15ICZeE4J1sdQDzdbd+HwWbkloQ5XODlt5mRHzs/hyWkiVjR1l3RuYWEky5z5QTsdAj/TzBvZ1KGjDj07RBLS6Rgw9vFTpURJMgo97rLuPc4lxy1hQH6QTiOGex4NFfz8DS9bQsBdz5ANlop5jZc+I7Ryf+IMbmaNXIA5MwvDzS0PtMVqXjww0VT+7aGOG4gWF3/EZtkY4Qhv2GjhexZSQwas9RLY85LOraDyFjg8gvUXlOhzw1D9eEg4a/G03dheVdX4cmJHz3W79jLQp2XyljNlikiS/3xqkuqL5SvgZybUU8IuvH6dfLoyyqs0IGT9fWTpH/yOVSg89HVV62qayTK8Hc0DwSVpmlREka45MvZlkvlvbW5bNoyYYEX+2kTswFZTKS/hC8WPzrSzn3XU0b4wjJ2C2803HDT9YamubhCemiY6XHuol2kSOTVrhJGYiEh8HvyWYmSJG/Vh8UWook827q2OiL/mzy/OJPIfOxQfLg95lmvc2TwRUEHrdzIPCzcAqa74fvsztkKo8avJVsv++6ZYDtq0/h92qalvG3Yh8qqkXYZgo6Uw5MrtPZjdC2V29cFG7xYNTHvhSp/WuJHAh3S/WrYekbMTLj2j8HlDF2YpoQ5aTKFBoprE4iGaV9XAUEuQI2acibFbUrt5fmxFrpY3NjtfLh6Rg9vgT2NNusb/IjZO3y/IGfqUK7uDVSFwlAGslG3lyH1ChU8VfI4j354TCXtuPpx4pqEZaL3kCGqWqhw9en75rnhP4OsOve3JfOwgkJVNh4sfr1F9/1T4Go4EHhonA5BpmM7bfHD1+R/RcLABXGHknx/HOez+/WefSlG2hduED9eiYDbIJ9iy0Db5C0zuaWiMDj7sRT2pfPeoeJQJRpUv1xguuweNW6ynKaU39yfNVsxwmCgPfPWdXzMYd/lUchtNtXAOdQCfbYwfk+2ayQ65LbGwCVjxJJtw8M4z9UMJ22U6nV+KtGAXUf0kaxhcxMtX7+VlFdJwxjXdmW5h7uDH9UUH3VatZTAg/ECn1godNU6o/7XmFsOI8gg6ViewSRaWOLTwV+KtDl/eTvrO8Dd0cn/t0wLSfpQ1Yt3KxVnhfTduy8kILYPOOvngNlcaavXevtAb9BbMhhtYCH1+UfKqphICiH2vwziutjPpGWBsm/sQePVz/A1dbKiEudcD9GySLpwevhTuwfttfVIeS4NdhvjQOPo65pDxfNhKlPH5nEnTt37+EW4XfiAJPg8gomTQFssAcrJKesnKA9/Z6X2o/g8xHm0cxNY3/cYNHPOLcYid5u1uJ3UPaucQ8ooD8I1DqS/Ni7JZXp8RWgNCdkEQJ1VpS5/1l/aNKkQNv8U6JyH6fjfaPmgo5rNEuN1r2Xk6GLIBE+ipVt0lmWWPplqFFs2QFgE6D8JfMdj1BXfDE79mdKqMy6IipqQYvAgF2A8/vJbxp3/vzRbZdhB6ej53pz13Q2xstfJsd6EYPzH3+YR2kmR5Ce6CI0B00vqMYyMokxQz1B+ANubpK58WuxA32aNhyiXBlciAMoV144CvSeyRSmfdV5onNHKCV9T1lD8OBn3bQnop7qo7Q01Ik/EWefg/n7yV0LlnSnKHWgPz5tjXx4UnA/VWQb7LsLbQphtE5ySLi5qL5wYffe4X3NwWN8eEU1TLQmzLj9D77PtsuwsRHUCsKL3oDtMh4r/O0ZXvGTsJO3dXoKae4fTl0QtJsIhHmulxl7wAzNX3pbJH3goolPGuIuqL6aTMkt34Yj9uKbjlm1EmvoUr/zKSJW19UhobFt6O3hovDgIEh+0lND84+dBbsNcOrZygbJZcNtAMQSZ8b+nrVbBxzEKB3+ty882lHbtXzbGEbtIk087kJ06In2ovSvnHyi4xrNgcAj0MEhFisAbrkMW6/JxzrhGdru9+ylmrol06QWGXnYqFrWwlt5ob+sFa8VoMkQPslGWp+u4LmpEh4Lss+Z5ds2EEVsP7rmUcNPceMKqG/VgoKiYhp0QUrNPcFW/NU3Ygy+igqVcDXcs8xrj0dnOQxYtwqXVjqVFZMOr3BhGu9GTyuXCzkhrkjvgrKaXOMeIF2q9Q0QlFjW1P0MqYKUw0AB2gqqr8ipetCrCBoG9mP3GoLnNKnGxaQj780Mp8jcJVs76Oq3qBHx+xcCNwllTd2+sMq87bkdZM/I9O1QBw6RCoS8m1azysENRz5O3bcl8gePWhdivdO9U2l7EYQWB3l0HjzmG598MYBzQR0yFC6wjaXU9tesM5Sx2eOlx8A5+VqrreHRErNhPHw/Io/ti+1AWTNW/9Lhxow09r0mAjvDVJJs9lODZFG8Tknkxb3Vg1RmVO/uGzT17w3qC+kqH9YsBkFxUoQq5LuR2vmWtMmrrz/fdbUUthFrnehSConm4gQxX/B/p+JMD+ja9ONFbQY8jy1LJ9E60SUbOUNFcz8bckm4KdT5hu7wJsliEGzgFup8VGHnQSPwBhonV5xhTtDcrF8s2Uh7Drp9vpTTVCpjl9lPskBefXioioiryksYqP2lwLj8njZuwIDyirZOdt/Tavx4A/OJpQEL1WvlroA668PgPK/NVxP8Lyap1mWDtz1NBN9HSbPuSG4PGdgsaGa39QmWO5w+rEs6MEkr9z9drBJdD/0NbMExXmzd9fsqakr5k78dd7Z5UtTtrEqjHyWxDrBSALoUTV6rPclJe2Nnq5Vdrkim/cdZ/NBAeLr5S095xhNEe2TaNrPJK6NfX7vPP93PpCM3wXZp3M/KCmPVm2O1sZVi2oAvDDZaRfdvpAYXyuzsa9vMZqmyD6rsPhVyQljj+D80W+2taTYugteVlp+c0u26nZKubOdOFuhWX0tPM0s5z4V2JJlv34pTPjIlH3wf8AGuEnaA7UBZpVfY7ijw92KfzWpNMzw7XLB4fkaUD97KX6NX/chVauwBaCiz7Q9/ngKTViKffjormuAPoYDo9szaSdNndSjRoSHqW/GQoKgYPrhUDSzUP6x2/SYVlda/aVqrjyfVHfyXR4fqwFCjgU3owHTEQ1EN3koHLSqArCXwT5XFDrh2RAIcnfgT+9p9nS+Tucmmma77HkmzdGqkxaglHUH/kli7BHsezSsfs6ppfsNzmZ7LLdqRpwBAChIXKGZPDyxtxCn9Peesq2Y9vYizGKLdC+E89r431F96yl+5CVndHJY1RQg6S5ZFlTV110nT1wYjDmQM7MhQF3W+XcFbCsvupQNwxO0mDjuXWpfJSfNq8sPfTdhMwQQY1cciuErmtJcqDmlWFimj0pkVCTYhwFvu75A0sM778WzF/AkbrsCy51fm6RO9pDRvqldfqetqDh4YPCQ6KBDVsJ9R89tZ7UgJbrgQ+iYJ86eoC/KwE37tnXbYGr0I/C6TTnXMP/oeTFSToNGxpl7BFcTOu9KR8Yz9G7Q9vYTr28c3X6Ph1/sdXy9q5HbSwl7q21KVU+4k1WTNHKyhQ75GjzIY7EZeQCnC4FACl13B/NMTH1GJRlqmsOrgAmUcgS8Sr+19LI3cdtCBc/SBB/Bm93fkwWOedpYwnLWTs4aag/Avox2i/A07r2yCQoQXAqVFDJFpJeW7pXBbxYKFEG7Sn4iypgjXTFvtBwi2vqtkzt/fgoQJLj5yaRPqimezVGzjI5sKIzXaWOp8UbyuTvxV+LtUF/7ijuzLWAzpD3wUSVFCXReeqPO+xRTtsybIFBk+Vt5VMhdn0LfkPECkYr608y6TyIYK5kMb1IUnarc9oe91ZF3wZPHqmvfXCyGmczmmE442tuKXF1GnZm4UaOca7elLP9a99oS37cl9QQM1MY6zihztfWYeNKxtFL+GrNRslexmVCR2C99Z2cbYCL82koJMpa8qrMipXLj4Bx+9l9HNgdjmzhNHypmfaAUTvErUxZjAsOAKsE3BR08zo4OtuKuveX1BHVVM8rjMJYk/CF8t4fk7QvXzPw/VcyDnkSzL4Qxrc/OInS7SsTFU8bMq7FCzufwSibxOr9IDivqeGhzbXbnYxGDlz7nDspoNeFpql0AOrV05kb6ayIHZC094EuvkX9E+BttE0TNbCkzZvUyukjT3QD5K4MsOkqENCLJdHq2QGkoroWEWdSz8313OV3EgXZb17XqC0LsyugiEjItYExOIWBHJ3BEpwoO1hLQkb3rlGkZHH+ON1dwbND/hzv95GPmTLeLOIPbHCcgjJJ/328dqEVyt5GKRficWRpyC6qtPOq7RssjrDluNthn6lDcpN4Uf4MuAvn7/kcT6l42EV//vagvIxr+zJNyyry0RZa/f4WDy0VWvNY2sk1XTPzqSmw+kJwtkTbp6Z0k+Xhf4NGCYDVdhUz4+8fBIroNQ4Ai2VPXFGfmefO5MBKYFWx51b7gQYholo0o1Dn/TwanRAoIZo3j3XIY7cVDsF/7if57/H026w2jSfjdGk8riBiqH+OyWtyUzTgP1wEFJZ33tRhLOr72aNorOWaNENSgtyMWD+lM9rDqyI2/GIpVGCjiaIyrf3XWFvodfBd1BL/z2Ber+vzSfnUcNdMxwmDYD+zVsfqDj4NzB7Pdr5kocGbCUaJpjST8VKHZpdd671LbdTrOucrrkN201tq3ACdL1T+5aatv+hu+FwWDhbndbsvMo1C73d939YSwSLZ0lOLA8WB9oXYWnR80Mp5Vso5bmcpsHE/qqHiCEXAY65DQulZ53Bz9KUvJbDOBV+4u7FK+cNanVHXP4ZNfYSjLtcIkx3cgnOOqCafA9heuBpZ/byvGDD8YeXCBFZKMSSPPLZ3VknfYh/8FMx5p6Tnb48+UnDOk2BYidHqP9GaKtpP3uxSLt3zVkxw9srgo5w9ixDvqYdeEFAiANBAVACBlwz9c0PSZds+0yLHzMGiOqMTWXa/0V03LMqhb/LfqG7VwIIVywK59M40+f/TfogbGAUKib8ZRhmKctKV/1PTeo7RJY3FzW1P0Fqdqxpt46rrJxPDfuKzio/41YxhJ8vynPY5sGhszgjgilyd/Wel6wKTQ7fD149wuNGJm+7e2I/KMDOSBQ2pJPppGBgLO0kl/symJfijYpa3E/P2V9zF6DbO26aRweUBvUZyENIvd7AQn9AFcJ6rdPdIPLgSGhAAWSIGE190MpTvYz1hdM3xJv7nVyuZY+p72WwhgazcHU2J84I4kcHyTaVMD7J6w98XLmJyThE0H9qXY7pAXfA75n8b/ukDuktsmFh0zrGvVd0gfdf16piZnKvrsL+P76y8iY7NKqZtxExMEymGjf0PcymXNlhPS48PfplCfuoRayyejPgsGHKV8K+1ugH8qXQqPRtWO9Fl0d3lm+vQefp7BV7/P7BCeDB8gf0qO869LEZpLcl5s7T1jBjNWImyzTuQlEkAM3/7Cs2TPIZ0Ao/KXemzNnStlZX4rfEo2059j3u/LJpXOOl1q7CvBiYKcq0h4pCzq2ci0R6yOHsEbvvFGiYphePiL/1swvLIxCbLh/GtuUNz/xvQEWs0Da8WUGfFmITvEotxp2n1QUckbg70mSPCqwInh+xgoOjSA5e73szjWIthiNcD1OEao5PHyJPEJcqceUOw3u9Lz/qj/1BB5ACLUaPzJ6x4Dlkh7qdYT5Nhc/W0maV1GUOD++LTSHvDFbM/GDtgeNDIDZyvw15aEqZc6W/Dti1tg8ZZsPDhruA0tXT6KrCY3bfUhrhs6eQHQmf4Bt4ZRByew9wTX9UY4B5iF6Lyu5ezkCcRTv2CzZy1Y6HKiFEW7BlL7s4kK1sh9QWNwxU1lEG9YK6rVEAd7bNpRXjQcPuh2d+F1wFXCr1uTpRjsW5nL/XtX/1ZtSDNcKP+Jg3wxAgyn9uX+gEyskIYsUxpGbzhx5Slgs3KD/+sJhRaoMVkztQO1dl+HPTt51cDZcizV54OgSvg9Van0ezkUeSxeBdHpbeNd4T5YcfcKAe0dk+TND85f0aCu+XbnRD/zy5idqVZfZcDmGSyEYXd2z2P68mLZBi4egkxKi8ffzelOUiI8N1OGpHLkdmHqlNjir6lI5b57YGLCcwjVwaoobVNYgGdp58Bj+EU6P1X+d/D2dG2nVnn5nwJerRakwJoqTxPfpnjQfjQk+6A99g/pXNHhhRtZ/VcBZsQO0yA1kJiKhjIrTnWhqAn6dqV4sUYYfMbo5gRh1/hQ3ToU6q2uJtRg3fkUjE3+9GLIp/0YnEZtXr5PEJ7mCSzSCODA5S3/KGhDzghlCrIgnEa++3Wz0xonfQjuYG7GIfF5QfdXML2sC4UUTh+9hAjTcZBpK8x0XqieQ5v7qJhQmPgsrUbUL4CaQbqR90a/TUv75F322V9OG4fJOMbxy9dx64YevgGirGRhYeYquGxwXQR3ft1cSCrhaYeeC12WXw8GYTAYhAji77Csg6kc729Agqczp8v1DVK8q8jVHriBeCF4+CNQE6vH7CN9Bn8fIRRGr4WGPYvVnmrcYahqav2QRqph/4LJA04ol86FBsigu9wm8lbHmopZtWxwVbd5kfRR1qjdIibD/gDSqV3ztzw61n2fuwIkzMDTniMlTg8m9O2F5Xn3L/P7XXG5o9TyB3tQK4mWkYZg+R24HTj7OLRjMJ8hs8zOsfFsPHYPIVa2MdrxmE8ppNQLDa/ZRky9M6BG0l+DGlFSYKtoFXTUyHWQgxZQSBa8hQ2HvbstnMbUyyDwBP+Vu/JheYm909hjNZ+MHIX9n0YndwczzXTuvrKk7O9yaGhrxmukWKCMBF7uHtwrB2KAt0Pd2x4yo2aYpInHJT3BBJDeSjiOXNnwZwJugPqUMCnB24iclqPm/uYWR6Vv2YAID/q7YsVGYYrJpPYWMBTjEUGcNns4JgCwySv+kK7N6ZiCIlUyfmin1Yai4rKkbiMhVNv0DtCb+6tO0IVzeG1m5DUaxJBeoSLUzS8d8d4rOUa/YzkLIegRGu1wDoMkolXE1PB3XaOpwqW6yHe6pSuT6yPQtZwdOXCScjcxCXerFp9EushJFvT0c0oey2HpTZDDpOVb808ch/2SKfultSYgxbgFD2aXJP5Tl8cOdgMYSu9g2dxQ6gbA27yJukKczZV4FO1yOSVoiBPffAu/v25k4dZ2Yt7nzxDerG9IhpVmbFV+wyEc/p8L38W7DYd8bNE4IRUzBVqwDkaQymEFTEQX55DzBnQz3OEENVqGLBIsr2dgxqfXWpCUx34Z9rtTu/eYveHubSq0gJ0gNpDuSjyO3I45/JdQ75/d43+mj8iHvhzChun9SniSk/1aDd4ZkjZdC1myjWTsUmnm9GyOzAZ2A5HgnPyXXCKvBZWz2C1YtdS13PKHQh6AT/GubVC61ofJtKcTgfFHC7hPnTVlU1AKz850WH1nuPPFa+El4cFD/r6LbD9jsQ7q2ayvCYTdiC0/AbCni+JBF3Bm8u0/vdD6v8AH/Kq6+035Wsf3ZXdkGg0zjQRmF05ckV+SjlQ4JRS4/RbP/7ox2fSQw3M/4tHEf9/m2y7DyijzFckThcS2aZzfGtGVnyBqWXsPZRg7OZREsgesguB7Fqbi6oV+wWdjzx7+Y/v+bZm0+RB+A6mNS3ht5+6KCSuuka/Lchz5bJyo8TCd6gh1CWWc9UDYBO4m/p/TXo9Wb6sQqsjpf1kqI9PPpkinMlw5X7QXTbYf+jzQr9/oKgnbkP2nWtOIBkrRpLtkIcrHR2pXWcGWz9PQoQ2T1NkIPvHTCqv0Lj39+Xv1ZePP/zkvTU4ZogKHJ4uX2Ecd0MZgMZy/S3x3o/96O5C1Uty9Tq+L1n3P6l9mCaqqQi+9nJtV27u4MKd861xqEhVBeKt2KtWZbfYLrA7uaX8vLtx+ipCT6ARp/8IOwLkw7O15wKktqJWcRZRGxkQYxzhbA0glLRFibi/c/6wuaV9NjcvrIZlg0pzIZaZJsFCOCaM14v3P6IAZL1yAgBUEfVo1vUGv/IUAn605pxaoyB+Gv3dnMsXRNe/vhVg7qJ/+z9G8vQlD52/Nr8u1IRdUt9u8JYdVufic7CbCZARobC+0VwQaGS/maD2uNBdX+zIqEHA/djv9XNh+mUz20N6kgu60DMSYwg9H3Wk3y4FgSo1LMn/g6v+CzuJmefGFCZswYLHbobzPFlF8Wa87twQxzdnAOadE4hgdg/b5okVcFzztRGD/EMXm9GM0M9Be5LtdOq9h+jliZ63unQ20o9BRi7u5ppJnDB+I9v8dYySzETZcdCj5nRvM5mL45e9YVaVlQfeXrBEtupTaatTpf2nvqSmdLCys6Mg/3oaxwlC9/2JPLclYGr0Sff8mN2Mk0KdpuNctGOzEpJAZiHQyY2e+UvnHiJ8U5R7b76iDMNDjhfuWfkDtIW8TF/vnI9O2uAQGBZdsoYCdLZj3YJj/2JxyUNVCxvYTgeuQS3uHsjnet2UHHw2zkrWjxcdyNsmPszzjIw1gHm0QD0r8aEKAluBxKNluMynhb1tQdgORCXfzwQcoZ+X4vSKnUn4y7sboyd3BWalANTn9Q/rVdeL99gdL4pi53apQEprgV2t/uexE7EBHbTdpr7jGZ/cHhjcuhQ7vV3w6g0E3R1M7oLrrIMyipNo9cspH22cRFoDR+s05X/MGCgM1zB2elyXofiCKDce+3jP/WBCOIkpSROo6khvLrwajaQ/YbFj6JRkrSJ6xJ5qLMZWxj8gg87mzeN0/i4Pf42ejM/x/FwLuHVv8UA93OehRINl7+txRYAqyd6A+kdxjVC6XKZGGcKLKx6lNBWPMGP8JO0mDOJZbCU6S/lFoJIVMmZzEfLvvAkxMxnCSML0U27vLoUAs9WeoKZSEl8tFv3dx5aVM8/Fn8zavBVA1WyCJesyzsEab4emlfBV9Y28japat/w1uj9Ov0T4mYu94UESJAVYcj755amn5v/E8J03t0/4/Oh8rGTsaULcpl7xLjov4pHZ/JWVTEGnQ8AnUm8BfWGZ3xI0BpzNGOTbjNcydboOPOXNzXoM9w59W33UBelEJitrsqi1uVhg2l3pQbGv4o26M9loXCWKEP3JvGma4Jb+U4lBCp7+EQd09voDZXVoeooZ1i8tW3Nsezm925cUJ4QIqNscOk+XAgVgdJPl7UnJotG5S8k0TVLkTwVcJTvZQ3+J+GgD9xmmhSjTEwBMdSV3YJWwBhB+xzlqxdsMXehx6q1CuQh1TN9NAZR25N8sIQLBcuNcMlI/6g60aM7EIpWiOxpp/7zIFXVpQ4H3wVoG0oKCr2xl5RjacxojD78yNFiUaAMcNx72wEU5iN0JR9RqGL1Y89hMbC4riafbfeFD7KbkeDI08pMc84I75FtmuaR1e/9fbHEMKqpJTXr+90MCBZ2wnP+tvv+Kc1EXkz6cRdSe4gqOpCPoUOL5Zu+TbeE8GH1OIQVlqaR8ERsLhxFtSNoqhgnD766Y+ZT/JdVJzpr6c97Qx0IX+Jds023unezEc1mD1J82SCcX1q1t4aYkF1WHQ+X3VqryJ64cwaxb8u8w8swarb81fTjG3d3PHyEItHUWyUZdIb7WITD0xPvsgpJb4p6muGOlumhGgg7P1GDOi49k+JGAB+rYHKL25lxCeLv1FMrLRZzP6EsKpTw6zsHwy74gmkto9MN8MDEetocQQWfI8OfFTnPVA2s/ZWxipJCX5dCmdG0+Fk8HOPZ/O6N8LlilP42OB8CA+qT7cGYGASP5utE2bfieBy+M3LRhVk7ssAKfrUSahcztmnFQ3jg0/0Rt6SaFMbDLQkYUFZ8GeQrC3VII9dnjZhx7tRQc+BecTdsIHWfwAH6M+I4neZDHFa5YXAeN3cdxEze29QOyZvXn3FvVByFQJlf8bs6fOtHkK0hVai9gsMZYsUwvrWXHBSb/VdLwZty9NR7qQGUX7L7HSjPVAWt5gMeoNftzjUjuD4+vcZO+TWyJvERk7kaw/HItKP0pTG2qhvMpn7/wEHRcJDsBCxiS52ubg4qIeXMpRXhjrYJ2DIAa7ZDxYxUzA0BTPz4TTOdFn4tfEHi99/RhMGFt17qQkwEAKcr9jsROOnrKgO6+yEgVWzniweuBzNJRaU8A0GcUAwH6YFtnsp+OpEFXKBfW+x8Q5IeeViccRATaD1H+BUNfPbDJn1N2hbxvc49VQQS3IZ9kuedyTjp4xoBso3tRo2jz78H0/Tnbm2kAvU4Ez1Xq0LFPYX2zxLppRg9gW4MFGYz9ZpFEMKggfIwsA5KslUqUOr8bxQeCs1tEbbdqDMF0ctYWBiXC1TG97KfWhrU/tOpw3m2cyWqAS305vidD+Z12x5Cg7BHO+e3n86asWHzh0xcQNzEsxVT18H6ffyly/dklno/sIAzXCMsR3iBlBmPVm4cDmm5p77m4xCR1S2KULHODT7N986vJVun4nJcvVdM2xmzPxI1V5bAUMQ/ARfJqh56DkwCl4PdO9dS4BvuHumJn+8DTHhD6CWob1UZapMyKYy8lcilV4JqKbQoUe/XZwHl+olzKaBIaHxlZWQH5QgD3vMAvJiUFoV2zYoVMkRN9AU26Uo8/f0nf5UyqoU6aKw3cQbxEqJ0Z7uTLaNdd1QmKuKVDsjBviHweYR/QArEJMKuQ9QI1qeZU7Pi/FAMMKVwQOxfzc5I4zBuavxvEQYxKBC2j+IwewH3xj89STL4Hloqu//AAahIfP/AQymH581/C+8oOGUefigPfDq1fT2r1PePxB5UpmYQxUteqzNzy8dHzEYKBDJJB+qxH9eeUuzA/flKs/z1spRgVKnPnLvtU0k/7eHYVheCJDffeAEOW1aqWsYZ2NwnIPQGPykyX5qzwNN+bKTJvNI9n1iCACq23KmIrHXpfNMWXwofVyLaNteUWMrojgBNvPDE7Psty5ukun+XfT+Lb8H8lZ5+GdY/8H0rbydvacq3rpGHoTdQLxf6URUGlCM3iEuu7mehWI1UaaWbIuKErOoXGnuZJu9stsOx6CbP8BLYNHAmX+wqKy94sYDwqSQRYJmkPMJxXA8shE5qGTd+QGw4Y9sJjQGnJhaYP2Ud6Yy0cStXRbPqKm7Z9pn8hfuKE88+h6QrG1J2DFmPsRyTIlKaqzBI+rGRfuhF4nzfofhhDsYnZXaOPJIweUsILrdi7Pkpcc9fyxRJ/l6B3H6aIUfbooTTnGpFfTTnnbbz0TIf3PY9FPF0Pwf1G7XRqUrsitqOejMhLKlkSf8rBhuGJZA1Lm1L4nIa3dtHSJQSf1b5vXqSkRENO5kKLECTytZ21J/yjLs5gfLMaAuYRFXqRQuj3bC2WOqf8MLZbw9ZeqRwJJOEXBhfHsDk8xji5Mr+0ocIoEi37XYqLNUXb9//1SsT14rWXtRf2onrDZTdp0ZdHyDWNUggdzxidDOioAbuJGj4RMls69Bs3QbLmj5DCG92WzjMGrwOjlOEoez0dkOYnDnk4XSlFQpAEBlhcECCIMIMKgzDRsM9ep/mFpq3c1eeohc+TYhGhcldk/JkdsRvdC/uwn6ZdjrH4OmKm542G5tZb2xlnWiS5t69XI0zxsuRyVtXDkJ7/BHZoGONYP0GdeKdAakvHkvi4vMNt6duUfjDBP/A/DDS8TpPu2uwrIAKpTBh1+OMLL8NRlT0n51SmCTH5tvH6evwavfgeoy0krUVqzH0Ksj2N4xZrCBcskxcLheesh1+XYN4kpdPsolQB56FTSYK/vkaEQrTIOD/YYS9a3Yw3NouxAOJVVQ3WJDGsMGP3Fnle1jmGpaXfT3RPZAxidXS9w4uEQDYLTeg58/ajWQ6fCAJ3qR2zhwOU2IMBLiBExnaGfrER2nKonX/nQ79ocoiyEnwF7xH9i/8qNO4h1D0PEoYlVDHGJJ28w+R/6oHaJlbfV96sM1Wc1zfeZPCd7n9pSH1GgWyoqvUoaWhlOLYX/5PQeCQfXmFkcM6RIYpDydgGFo7K/28hRsdvrUT0eqmVB4qyxUxqXonQ7JYyW7PHn3756jb12Mm428KQ7ZbW6oYgR6zzpMW3AMmzHA70Y0/uGOWIwLOQuVrsTcA9Bo/EAH0gohK5hJnM6giLfLDboxe0Uzz5HxPBEQ4hqXWuGL0eRCeHKXoINVYw9HLU4eud0AhvguIoLfLOYYbEMoQmIsZeOjojZT7MQuPxjBfblMMIL7TO3wHYZdUZ+bBniDedoAZ/p78PM+bEXE6KC2zfOOT4jS+X7gW6UivOlH7gNtCDE6T7hijKipzWCqaBicTfeKaBaTPbtTfO7ruVaGWCl1D/LzNGfPZsjkMzPBXepZvM3i1hYG+Ep7eSmabXmqi0EsSWQIZBJhvczZphhEig54KVFMypp4r2XPvd2Z3f5q9IdZxlKfLZFIzG9lfvEw+RPB3s0b+c9wK1wW84131OD1bs2iSFFT+38EAOIUApg0WeoJm59seahgHmCQRa8h19GjUbEqgYzNu3dSaHYyVssEzpDb2fJtH+RUxtJCTX5MuIrq+hfHknjdj4rSNLOsF7I5uC40R4/K1pR4m7H+DjYL/P3N9hMv7/nW4DHG7A/aCZeJvsQ8lvAYwrsCFfiEegS68ZGWBuKd9yyun/feraZ8l0daIuwq2j+W8NPKl1ZuzyNTwtzE6/yTtcKtsT/HDjDr97xwjj5HPfyAl026R1aFIQVh+aKVRi/WzeMTIGfAH7ppRQKg5n6qAlEih8FU0fbybevwNNr8ft+7u2+itQqdyis+4bXRzTCi/kwyVksSxf5fvBBW4luATIFjeoQv7KbSUggUt6vB/3Xz7dqGWcFTBHyp8fBW64R3CbZsvqzUcurIygo6npOJFOMZFJAVK6OHsKYswF+pH3Ra353NSSjcU5QsPUd5ZdOHG4bvMMm1UnmKVm4vIX7frjn55TIi7G99pw5ZPtLMe8qiAf+laIUmSG2M+17YjnRnLqWx2chT+nermPRxpf1LaRMmSAZcDsi7NcytkX/ZcK4N+JdkJuXl5X1J+hTSj6WMr8RevxWJhCBXkM85Fu4ZZczAr6Nc6CueBtYwNg+6ttgfLw2biYSrLd+BPOlD6z7N04KOcYpEGTFHjLEBG2Jpe3nrP+oqd7cyvtMxqSNkOTNgHW7LJNf6ag9Pb2ocsdLwH86ca8i1OeUjuBPwkgemBJUN/ESHYwxwSBhAUezhV40VMqfscGsN1xmlsgj/XMjpXyoQ871Pm5KiTm0O6rfTTjPxsPtJ3JrH7GshZvdHMTKQTk0/uz8GG2ajojYz3Kikr+d6pYcS+TXPkSvUw03zXyIgKgvUD/iuorDPjHjZV7lW7mTlOLoXs3DO5WJ/AP7zCFgkz9UMGw3SOnq5/IUhnSuLhXFCy9dz7R4pmbH8TpwsHslIxmq5ObmNC2+VhzHWuQ6UhWInU6dqbh+7EFvgBnGsy5ZhqcUPKD9tqjT5qOjLBKOzfy37uScIjQ7SOsJfDmdIp8liYfxOcBY/0u2an8stjU0g6eWl2tTWCocmapl00NRvhYaXgNeX+EauGNEGaFuOvKUorZ5/KJbEey2UCEGHDqopW54eWSQj/7SaWBkbhuFi5etpy4aguyw+MHFaf2njoaI28zNcIRa+4X4SmYzzgmj8NBRxpW4cN/C4qYMhMa8uWKruNSbfNoj6DyU0+hx1jZh/MkVTrx1pHub22zweJ6AgUQNj+ix3oFAUwFjATX75y+Ssi0XEqd7s2y6uLRwY/5fpg83DpYCwIiyH2IGFl6PmPIVT7u1By/bRaSR374nyCS14QR87L4n3WuIwGFTwkUQA6az0soH2LZXOPyGHYTOGNopFDlgL7qdKbw7HvMDRyu/6AU7tfhLvA/Sv6VzNmlFmrLfsNfJ2tpgLq6vswaDL4DL6Al83mWC/8V+Q+9d8DcCelV020Fal9bEtfj2YWqH1D43Y3H0hdDL9c1jf2+Qa3yHWUvgO9iYWARW02126RmSNdSqGIRm+n2B+9cLJ+lsHjXvlz3+5wiaOo9XEXljmEFY7uUUs7cFmGueO7dH3pV/WByY/7W/0ncBOHLbHLf9h5PIvnhphqM3QhQR9DrYTRVTU0lCQiwWTC0sQF/Rp8CwiFNeCD8Tv+t3AcZSsm5soUI3SgbigyO1R4a48oe0qRlZ5zIscBCGb8sBRIZc/f+Q+XliXb9Ogen4PP1UYMUD9qH5BmUNt6TelPyX6mBlGsSLVEgxshu9NL4WaOo7q4CWnCCC9rp91fLoMXH7yr+dsuQWF5hPesq+8MLefamaSaw2phFPDPH3PSefuQ1Cogh5aCqMpVnfPTz5Ec6dsKIuKrehzlLR7JcPOIjba8Crr1Z/B275SDCkQ6iUtnYzHht13nczNQXd/kV7UspZ0Jn2FLSIDbjMOpwavXzP10jJC7phEaGcfgBu4mZP9TnWLzNY2b6hq5HyzhrF5NszjG4IIXmmzdHvJM6f7KH+nKll6K61wXyT8O0uoWkI0N6VSVEKILI7yMcWmjAWKlrabJcNczO8G4nZFlcVwJkXne8dgSP8Pe39/o88yPPQ6mTOucK4j1Zi5af+gjjl8TXX9N8NTOBFkkRDxLoTPPGXob1JKyr2KwpNPo9CrrNXgjkiRsmkM5+5jW8sR1odxXxaNr36XfLfOB8INdgNjHfcXf5t9azle5Puy9HcaNlYkT8+mMXFW64hL6nySVl9988ZMD5jxfE7d9sPXU8iPX/hV/oZvqLTSrQw0rCW5X0npLNFoOn6Zs3j+gbSPizDZ9YFearlirpVeAxPRq+dMizqfhTdi6S3W9kd+/v0ZGsJO8SJ6cfkEVqjYKZ7tYMXRWTOg84WFkbEl+jagVNjiMtQzwhdxtp1kcaTkeiAeJ5HYULHvz20gL1fNs++9UL2gJ5o7mW94UOdysE/y9+qhCCnqTwUD1mm/J+fVZXLhTWiR1SE9bYmW85lcl+s0B/hHGafaqlnzYq5Ox8KBx45A5O6iacPc1Vlj/j3ymzu6GTn6NiZG12STb2FgYODpcXtqiIzvdJysSoK0mHx5UF5Z6xx5FeGaS7dcrLqpUXJPI7TtDMemwTtZTqbJa67TN/SNd6Cfn1+ndxY8KTFR4O+PmCYu9oKbH/1VDZM9BS/zt7Bd3bV+q6O2m8xPBtkdvszKael8Iwni0fxlXB1pVD1DBAX4iDcKkuW6kMZ2ywQOycWqM3oNp/qdsxollr2iu+KUyXVU5Htm+OV9UHAcotSDq3DRifXD27ete9NGbBLmge3PKQAq07JO6jtaKANZquzrz5MvTDfLP3IjNXcoaM01lKA5uZD25yLLclHqjEbJPZW1H1mTPzsneYfFS7p+U22JCCqIQ5R4cFltvft7Kum6nyu1BHqz38O9WM2zf3ohJUcsUD9iYFsUn+ZV5B1bfybXdYa02mGRPOc5pNzz51r6M5Dq50XC90x8KsNQR4fRwZQutVaa0tgouYdz0V/CU+cRmQ6jQ87XpR7WTM6jPyfTWCyX+d5h8RL2+fa/Aqh9jZJ7Sj5NY7G8X3BtrvkK1C+z4kVQvXcu+I5VLe2u9ltd4ZfwzisLW9YPdkv9xrT/tllsNZmThPtPy+YfWrF9S6nlOwkosPnoTZgKqmCJk76mMWc5+N11cwkv9eatKce0cFuyHKRMHip18L1ZjOPnU1tNSsan6b+xCGC3zpTIXo3/kepXolvXe3T/djM4CPKrlRojfh65GQHMLtw7LM2f3Trz634PbSbn0Yb3DiA50ZTgYc7FEURj57mFviyVG9CUALZe978/fObm1yv76l+xuavl3VV/q38b551d9Qv9fvJ/5Rh++aABuBJ1cYwODoIMjlnEi/OprWpmlH4OX8PMLmR+8OYS7qGQ2DAxzQGOL/XgYtuiCBX9xk52qNQBd2yPk+dTWx0PrcKROXyWUfq5SiXjUWKDIZJ5WpZl7D9ruzeNxeK3JPdYNVdsROzNf35hOI3FwtAI1EstS2zQNnNbTf6RiqDm3sKySfFOZmImKGwA6qduDvTzTzTwJjbgYIYuw+jHxO6pa0raaZR3LF+Uuw92AV0uUbW4u8Zv9W8B83KPkyT3yGrfrAmmTedgtNTqjnbPVpyVcZifRPdjksrnNcIrOep/di8+TQKn8MuIvoqwmsZ8ebBzt4w87jeXgpK3hURr2p8MLAxkqUbvHVk/vM2Vc9BhvdB2HOkRzOR059f85YrUmpZ1Wiesm5OLSh3+I7Fr9I2mb05qhwJkry9LdSBlQlCeelPMFbwgFxUtMrKEv98wos9pZrya+yMVQce9JWRT6Z3MNEugAGXtnl1bP3mQ3SSZ2GCJuSJKPbh0YOQiL1lfIRdZud+UbD5MbCBCQ3dYcKARQYpxiDIPrn1VjVZ2v0m5fsACI/dW+jQH/hMGB11s+WpP0jq17zILbqxPS1341H7RXOAo7lDgaM6k5OueuLsrHntzrRb+SJV4mlFqi+TZYcFxdM4ola9SIU0TGfH5sDMp9NqQwcF2mgMM/+DBpXMMGPsCFH18ju1rEsOg5/tuNGVdbn1HrZQyQps5XzPSa6Eab30mo6pu5NWuDnrjoUbb9BN8SC01eD2l38SuoHeWDZ3wMr/gKxBkQMsqm/YsLu2USwSXpXnklSM2wrL3z69umR3s5oldedYUHBW+Z78k+NR+yeinseFOY69NmyaippYVLZZUQun/tFV5MjASTL919xmTi1WfMkouTS3mCsYycSj4jsx6qMHeBNTnfZmvSOryZeSljSWDg7BJDMWXDx5cSbTcW5xIlWlZvq9Jr+m6QVWcRZ1lfg8TG9SqVGJN9GXTniWkeSnChNF3I1HLOO4Tvl2FNff7c6JXCgFZWBdv3ZuW5GJjMXcPttdCteS6W9BJdQPNbuh6Cr7/mFwsffvzOqxL2nsliw68IOnEBv2dB6Bpo2TwdevetEP453D7aVNiD6pLn4zSUTOCo7IZog6sWywTm4JPD/6COvn3DqafgPHDf6QOyLbuTUOQReHC5g45HyORehmlgVWRflM7uPwDQ6e8VQ0/x4DovJf5rA1AkNjDb6x0dDmpDzVSsCrD+6safiJ9jsnYIImaNH1zbOjXx1c1lf1hU3zPQJsTqZypGl4L1bXYnfjQ+hGPHCvOTsRt3EONxjr+Xzu4WIeC6GxkY5+70MxyGHu0+3mxN0Xqk1IPLg+iRR/+B8vcV1houTWZdFngLfYsC/fWmx0Rnakd3AOCfVwpbHapZPEcHKQisqjbpPNWlafEg8uDsXVvmgV9g9QNq0xcyz+yRksjyx5q6JrEpFrUUgvghpBWEWww9cuuMRx62mgxOOgsMlqt11ewxGk/+dvVyBx2gGXM3CtDpICFYufe4ighPWYLKLB5dGh4bAh94dvYDrwF+umNqlG3BPGpgSbGlBensadP/woIX6mxcXSKO9H4WX0e3S4JbXWlvdqxIzQxOE5B6d7LMeIZFChR58hlcqZidpBZxjwDih2B3bGnNNIxuPdLdfpsptMvkNvBmn+G/tBV/JikSi18XGTEShAdGC+R+yNVvat1b/oVOh0peaesNXJ1/XN5ExAzmELI2jEw8zcx7bTxx3etTwEFaDP0msVto8fGjvtIqKvFpdmVGoODLcSB/mj3b9eX4AmBYWtuAAAA0FB9Dq55ahPx/xkAsEVeM/lSAAA=",
+	"static/cayley.svg":                                                      "H4sIAAAAAAAC/7yU24+jNhjF3/NXfPWqUitNwJBkBrYhq8xNjbpKqkm2q3104AOsdWxqmxD++8qE7Fy6072XFyL7nB+H8zlMfxoOB74PV6pqNS9KCyENxrApEa5YK7CFeW1LpY0HcyGgkxjQaFDvMfMGvu/cr3mK0mAGtcxQgy0R5hVLSzztnMFfqA1XEkKPwi9OQPot8utvDtGqGnasBaks1AbBltxAzgUCHlKsLHAJqdpVgjOZIjTclt1zeopLAu96htpaxiUwSFXVgsofCoHZPrS7Smurl77fNI3HusCe0oUvjlLjv15c3SzXN8PQo73pjRRoXAF/11xjBtsWWFUJnrKtQBCsAaWBFRoxA6tc6EZzy2VxBkbltmEaHSbjxmq+re2jzk4RuXkkUBKYBDJfw2JN4HK+XqzPHOTtYvP76s0G3s7v7ubLzeJmDas7uFotrxebxWq5htUtzJfv4I/F8voMkNsSNeCh0u4NlAbu2jwOEdaIjyLk6hjJVJjynKcgmCxqViAUao9acllAhXrHjZuqASYzhxF8xy2z3dK/3ssbDIezwfTVYSdgfzwOCQk8SgBlqjIui4TUNh9GBF7NBlOzL2DLDP6plTsHCclrIQiU6M5gQgJKfyYPOQGBhme2PG0ddkKahDwYcTPqxhtSSn2zL3rJS9w/owr8w04McY/SmpP4ILh8/zF9EMex3+2S2TTD3IA/mwouEYzV6j0m5EXcXaRfGPZpQ29C4BAkJHD3MCGTieukDRJyPiHQhu7uVr6YF/fA4LsRj6COOaL3zO5XmJAw8M7j6CKMo4BG34SP/nd6QCNvRIPwggZR/P2r+Vb8iJ7w98N8UsjXzzX6kfAfWvsXt55ynQqE9PBhZGl7cuRciIS8GIfR5HZMQB//kk9MI3oyPWmod19fjseji2fc0SfMt+PLMaXPmL8m7v0Dn1TxOWmD0Sfc/xk3mByt55MP+tMwP66PP8fgPtyzwT8DALMgapq3CAAA",
+	"static/css/docs.css":                                                    "H4sIAAAAAAAC/9RYW2/jNhZ+9684m2KBmVlJlq+dVYABsuksGmyRAJN0iz7SIi0SQ5MsScX2Bv3vC5KSLVpynBbdBeq8OIfn8p076fGH0XgMt1LtNauohWk+mcMTJXCL9pzs4aa2VGqTwQ3n4FkMaGKIfiY4G43HTvoHVhJhCIZaYKLBUgI3CpWUtCcJ/Jtow6SAaZbDO8dw1Rxdvb92Kvayhg3ag5AWakPAUmZgzTgBsiuJssAElHKjOEOiJLBllno7jRaHBH5udMiVRUwAglKqPch1lxGQbUC7D7VWFePxdrvNkAecSV2NeWA14x/ubj/fP35Op1neCP0oODEuAL/UTBMMqz0gpTgr0YoT4GgLUgOqNCEYrHSgt5pZJqoEjFzbLdLEqcHMWM1WtY1i1kJkJmKQApCAq5tHuHu8gn/cPN49Jk7JT3dP3z/8+AQ/3Xz5cnP/dPf5ER6+wO3D/Xd3T3cP94/w8E+4uf8Z/nV3/10ChFlKNJCd0s4DqYG5aIYkwiMhEYS1DJCMIiVbsxI4ElWNKgKVfCZaMFGBInrDjMuqASSwU8PZhllkPannVzb6MB6NVhLv4WUEsJbCpmu0YXxfwPeEPxPLSpQA0gzxBAwSJjVEs/V1y2zYf0gBk7naORJngqSUuIosYJItHU0hjJmoUitVAZNc7brElbRWbo70FSq/VlrWAqel5FIXsKXMko5IATPHC782sD/Bh2LNtLFpSRnH3o0N0hUTwWIOf2EbJbVFwjqpgxBHQzItoFOxEfJcDahv5pOPs9u5O0AZWhkibHRclnme582xKKnU/hgzozjaF7DisvzajQMna9t4dsQSiGlLLWttnHYlmbBEe3FpmMtsAWhlJK9DpILf7lvQ4L8eHPPe0EkCdJoAnSVA5wnQRQJ02QlEAdNc7SA/zVijzed+2yR6JTl2xHRLVl+ZTf2h2UhpqZdAwjLEGTIEd/2wZGdjJzThyLJn0kAsqKtraEPoEPdJsz5p3ict+qTlCQleovoroNb83VWWjbNszDaoIma8kbjmxIyN3XNS1QyTsUIaZUpUV+9ByFQTRZD1MYOStFlyjqaYlFKj4KeQovURrE2ATqCUmMBL3FVMUKJZKD86DZzTy5yzwDm7zDkPnPPLnIvAubjMuQycy8uck9PT6cem0EMXrTgqv7bOn7I2A2clNSb6OEbUDozkDLsWdJ9hdbNTdZOPzUih897Rsj1a9I7m7dEy6v5v/ef6DLNKQvv/UktLEqh5ApIngHkCnCVg3dZKQGkSdeNk4boxWOuXqtVIGIW0m0Pny1bVnJsxZtruU0MRJk3hhqpNXbfnx6AWkIdCjeZaG9R2yM9706Ezmen09dHcWw95Z0LTye8RBhgW/xs0RTSg5TUQs66W5KB8PkxeDJOXv8eTEYqk3MQ+oUx7lFmPMu9RFj3K8s2Q6ASUH0LKDxjlh4fyg0H5plcDqpwgZ6AybzRehEz4K0PYh44xtAO8dOx3dmPggDjKMiacQeDEOIqlLlwBvByOwDRUAMwBB18GL0JnNmTL6/ZHAcwizsqoAg8VGfc9LILzAI3lnsMnOrq88AkG2XsxiiROYgMwHJ8ggaPWKiCH3CM/9S0QO6bwbwaHfwO40XHQwsthsDUFNT/uCuw/16M+1P5UDzPmqHcQ/wD6WISjYYkYvt8Fg+UXTqxupBvHwhV7aAe+erPu5K1XjE3kW3uFsDTgfjcV7w/B7yv/Zv3R/Z3Kg6UHoTMdctw/ZzxpLlSIs0oU/op7HdXAwY2OI0u1g8lM7fp4jhX0fzVLT4ZYF9DbGyLS151ukbq3dgvbVM0c3KVbhi1177L8r/7MKCSytUYbcuYl4+7Say63BVCGMREBYkfsk/8nKtgo1scmHFIPsOYS2W7gexbjwTlThwtNJyPftkOpcRDVVvaguq/QRuMymkHxjrMlJ8hdQKWlQbjtkZn/nHf5gHrR+AK/hkT4IkzDC+Ot+RjFQI6gI2VRloYwvSHqLqZt4Ls9E0ycs3wS9OMq8Sl6RVlHVfi57I8JSND1R8QjHwqGV3/G6LlQvKIllJ8rSr/ezsSgaXvd/EAzC80w5MKZ+j4a6ISl52yM57WkRL90vAHPadS6Fv6XpXuwO3LP2gSsjV5nOUxP30HQXB/8lk2NQiUpQMitRup6NDgACXJ/16PX1ulBMtUIs9oUMGuflZocn9xRxcRXywiP0qQLpn3unXlaejsZZRXlPuDtG/UNcM9s1e7NeTb0E+LfTyuiHQbxis3V7kJo/gQ4Adochue/bd8XfdzdpET7tP1l6b8DAAAIsSBCGAAA",
+	"static/css/grid.css":                                                    "H4sIAAAAAAAC/6xWbW/bOBL+rl8xiFE0yVkvVpK2JwPFuWkOZ1yRAHW6RT9S4kgiSpNakorlFv3vC4q0a0VOukA38yUmZ5555pWKz4M4hmvZbBWragNpMruE+xrhmmw5bmHRmloqHcGCc+hVNCjUqB6QRkEcW+sPrEChkUIrKCowNcKiIUWNu5sp/IFKMykgjRI4tQon/urkbG4htrKFNdmCkAZajWBqpqFkHAG7AhsDTEAh1w1nRBQIG2bq3o9HsUzgi8eQuSFMAIFCNluQ5aEiEONJ27/amCaL481mE5GecCRVFXOnquMPy+ub29VNmEaJN/okOGqbgD9bppBCvgXSNJwVJOcInGxAKiCVQqRgpCW9UcwwUU1By9JsiEILQ5k2iuWtGeRsR5HpgYIUQAScLFawXJ3Au8VquZpakM/L+//dfbqHz4uPHxe398ubFdx9hOu72/fL++Xd7Qru/guL2y/w/+Xt+ykgMzUqwK5RNgKpgNlsuiLCCnFAoZSOkm6wYCUrgBNRtaRCqOQDKsFEBQ2qNdO2qhqIoBaGszUzxPRHo7ii4DwOglzSLXwPABpCKRNVaGSTwSy5bLo5QABQSmHCDdpOy+AiSea7s5KsGd9m8PKuQQErIvTLKWgidKhRsXIe/Agig50JCxQG1dhJmjRdr1VIHnY6nKW9Tk6Kr5WSraBhIblUGUzKsoebaEYxJw6q9pRmSfJifgCt3HEyf8rdHiUS5KGH2jBq6gz+ffVicM+Z4yMVRZUlMClTK6AlZ3S+vwlzaYxchw5l5pwEFjwnyoUQ2pieCi4tLvAqOcAbXxyJ4+chx9Jk+1x6t0ZWFUeIWCFFuEtZfE6ZbjjZZjmXxdf5ebwPPk0dqM+q/+UJKUJZq11oR0OglD7n/F9DGmuiKib6YC59tiZcVvKwFrPkakAIXvnfJZfEZDbmn4YhZQ9Qz+D7o/vfad5H+YWZ939AHt5EPutB7Vr3d2Zll71nZqAfg0dtl8Gs6VxLgqpycppMe4nSs/l4qoeds0c40lH7cQkip9YQOhjhoXGv1xojRajk5thCec61LSThqMw72TlbqZldWxmUrMN+2HoYj7Kb8X7wdz0NQgq0B99CJih2dlKSxFEjxnt8Cn3HJ+lXQBDE51BIzkljn9HdQtBmy1HDeRz8Z42UEdCFQrTPAYXTNen8CoDXr1433VnvKlJyE8qyLIh4ILo/OvSvkBPDHnraAOEG86/MhEYRsdMgnEMSpVcakGgMZWu87lp++1uKv9b5MeLplqg99qGPQtjXILycRa9eOJgxju2k52DcbB1iHKcSkcKmyVt5189ZWeChkXM1tHmK1s8CkVxL3hpfINeD7n9f6wHg4IE6MsWTi9yKAzicj2SEYLfBW/8CAQwW7RA6A84EEhVWdk2jMKeTixsrU5hcvLFy5k3c2nDjuN8Zk8s3VgYq480ySW+sPMUyK5nSJixqxil8P4R6Nri35Hh4x3XfsnXl9Q9mbXbZdE/bZLX9PJoeuyll0e7q3X+mUCykIq7su1Xyq0S/tmITnVo5mw+isesa4Bg3iAqi0IxSNfzgGVXk8fUY1wfs8f8B+KhmFEO9tpvDwY3W7Q+7MP8aAJrXiQq3DAAA",
+	"static/css/query_editor.css":                                            "H4sIAAAAAAAC/2STUW/bNhSF3/UrDlwMaANbUowNA5Qnz80wYZ0NxO6KPlLitXQRilcjqShqsf8+ULaXBiHfeM/57uElmN0kWYat9JPjpg1Y57c/49gStmoyNGEzhFacT7ExBrPEw5En90Q6TbIsuj9xTdaTxmA1OYSWsOlV3dK1ssTf5DyLxTrN8T4KFpfS4sNdREwyoFMTrAQMnhBa9jixIdBzTX0AW9TS9YaVrQkjh3buc6HEJPh6YUgVFFso1NJPkNOPQqhwCR1XG0JfZNk4jqmaA6fimsycpT77VG7vd4f71TrNL6bP1pCPA/hnYEca1QTV94ZrVRmCUSPEQTWOSCNIDD06DmybJbycwqgcRYxmHxxXQ3g1s2tE9q8EYqEsFpsDysMCv20O5WEZIV/K4x/7z0d82Tw8bHbH8v6A/QO2+93H8ljudwfsf8dm9xV/lruPSxCHlhzouXfxBuLAcZrnR8SB6FWEk5wj+Z5qPnENo2wzqIbQyBM5y7ZBT65jH1/VQ1kdMYY7DirMR2/ulSY3WZKktWiqxeB7AmQ3qFT92DgZrF7VYsQVcE2l3ufLuNP1hzvcZAnQKdewXVUSgnQFbvP++S4BeqU12+bNeSVOkyuQ491pHTe8GNYvpYtjNbIObYHbaPs3SX2vanL4/rbjmrqoSAxbSg3bx1l0YmMKWLEU0T44eaT/mekvZyrmlaSqDvxEZ5/YsBopfqcClRg9s9OtaPqLnRP3ojqpjs1UYFHaWqwXo4JaLNGJlTnt3VXo+RsVuF3nP82wd0/sB2X427njNVQey0B76f1rnp9D/jcAjwrXXQgEAAA=",
+	"static/favicon.ico":                                                     "H4sIAAAAAAAC/+ydCZgcRfn/P5PdZMnFBpJAAsgG5JLLyDUowsKkAeEPKnKfIoiIHHIoqD9wN8KES0QgkK1coNw3SCOHCAsicmnCfXSAhQRIuLIJnWSTbKb+T+/WJJ3ant2Z2eljQ32fpwg770x39Vvvt7rqrbfeghQpwPt3DFuNqWY9YKvOD6Bf5+cAUM05W9BRDAwMDAwMDAwMDAwMDAwMDAwSgQHABsC2wC5AGhgLbAoMMepJHPoBw4EtgZ1Ue+0IbAWMUHIDg56wMXA+8ADwMvAx8CXgAp8BDvBPoBHYAag2KosVA4HjgJuAZ4H3gQXAIqAV+AB4HhDAAcDaRmUG3eBQYCkgiygfApcCGxq1xYavAa8X2V4ucB/wHSBlVGfQS/5LYAXwKLCtUV3i+Z8v7wFHmDmBQQH+twItwAvA48DfgYeA54B5QC7App4CNjfqi4X/LwOfAC+pdngYeBB4EngHWBbQXnOBQ436DDSMAcYBWwLDgRqgGugPDAN2Ai4BPg6wqdvM/DJyDFHtNRYYDQwC+gPV6v83A04C/hvQbzuqPQ0MSkEVsC/wqmZPbcDJRj2JxKbAbcAKrc1uB4Ya9RiUgT2BdzV7mgnUGdUkEqOA+wJ8gocY1RiUiZOAxZo/8NdGLYnFdsAbWh/wCDDMqMagDAwF7tXs6X/ARkY1icVpmk9wEfBDoxaDMrEvMB+QqiwDfmrUklisD/xb67PvANYyqjEoA4OAuzR7ehSoNapJLE4GlgNSlU+B7xi1GJSJH2l+gFZgb6OWxGIjYKbWZ19m1GJQJoYD/9Ls6ToTY5ZoNGjt9SqwsVGLQZk4R4sxmWViAhONHbQ4ruXAT4xaDMrE1sD72lrgKUYtiUUNcIc2BrgPGGRUY1AG+gN/1ezpYRNflmgcre33+gRIG7UYlIlDgCWAVOULYA+jlsRi44A47vFGLQZlYjQwI8CvbPabJxMp4EqtvV4A1jeqMSgTl2j29LKJB0w0xgELAKnKYuBHa+iz9gMGAsOAEcB6wPrq35HAcCUbDAyI4b2VAmqAWl/9RgLrAkOAqj6g4z2Az7V9gUd/Rf0hQ1XbjQTWU2WEz8aSkD9tGPCE1mf/RdW/r2MIsBXwQ+C3QBNwH/AvYAbwOvCm+vdV4L/A08DfgZuBq9Tvjga+DWwcgn+0GtgUOBS4GLgDeAqYqer1CvA88AgwBTgPyACjilxfrwI2AbYBto5gj34t8HhAfGlNBO29jnrGbVTZJMI+cyjwTeAY4BLgJuAx4AXVhnk7m6lszAauBy4CfgLsCoyOqY//rdZe7yv99UUMUro8H3gEmAMsKZC3ptiyDFgAvKXa7ULge8CoXowRqoE0cC3gAG1F1iUHfAnMULYztgebGQX8S9V/XgR7PUYA/9Hq/BHwrQja/jjgC1UWAP8Ahod4v37AlsA5wKNKv8vKsK/lQCswE5gGHAXURdgXZANs7Ow+xvthwKHAvcBnveB6sWUJ8HCZvpKRwPgCeXRKLbOBP3Qzx/6G7z5LgcNCbocDADegnv8X0X5kfz//HDAypHttBlwCvBOQV6O3ZRnwJnANsEfI84RRwH8D6tAMrNMHeL8WcADwsBaD3l1pB74EvgA+Beaq8on6bCGwtIgxwztl5LsYA9yl6lCo7T8FPgDeAl4HZgEfAvML/G4F8BTw3YDxSMbHx7D5XwVMLvBc/wFGRMz/Z0O4Zw3wY+CVbuwjB7jAXOA94E3gVeB1wFFtOw9o1fbhBJWXQvafHlyANwv6wB6OMcBEbf9pEDfmAs8B04DzgOOA7ym+7ARsD3wT2BHYDdgHOAw4BfgDcD3QDMwCvtT4v3GJfe29BezlXaAJOBbYWT3bKGA9YDSwBVAPnAhcC8wMmDO8q+rdj1X4ic/Gwub/FsA7Wl+W8+0zP7CP8384cDmwsICdtQC3A2cD+wPfBDby+ZjzbTlGyTLAMUADcA/wlhY/IQFHfT8M6DFby7T3yzUJ3cORAuqBZ7rpgz8HHgROB3YA1unFfKoaGAxsotrsTNXOfy8h/321sp1cQD97FfCNEupXBYwCjgQe1mzmY+AYX7s1+mRh8/8U31h4KTAd+MR3/6khj2XD5P9I9TztAbx/GTgX2AoYUKY9D1BjyR8AE4FXgOUh838b4ANt37Y/N8CbwCYJ434/4HCgpQDvP1F2tkfIscz9gfVLWCep17iQ76NOKtNm/H6PnwGztPzOBwP9gGkR8X8I8JCWZ35n4FFtvLRlH+T/2sCUgHn+fOCPIXCkH7AhcCRwMTAqJH2d7dPXCuCnwK81v+SJCeP+scC8AN4vVfa3Vy/5FAaqgakB8/zfVOh9mALSwNO+678N/D/gkYj4/11t3f8eoL+2trQCOK2P8b8aOD/gXJT3gWMisLXqkMbg+rr/HGBrYCfgU9/nf0vQnqCDCvjMvwAuCHmtpzfYXJsXS+BJYL0K3+cb2j7895RuouD/JQX2/qU1e3okxD1BYfD/ewHrSbOBH7Bmxf3dB9SotnlEyw20a/zV5duAE8D9D4CjEn4W5cHaunA78IuQ7rWLNheQEfB/Qy2fzAfANgDAUOBhra+u7yP8Xy8glmkh8JM+vqehH3BVN3u1z9b0eFHM9d0woB3ydtYX+uHfB/gowoyHOSnAjxwm/4/Q1iL0eL8zNXu6IiT+VJr/Jwesz02MKJYxTIzRzg38QIv3Gwt8pOV0Hh1TXfsDlwX4zT8FDusj/fDUgHMyRoccg/v3iPhfA9yq+YxO0L6zPfChlmvqawnn/3oBeXJfA7ag7+N4bTx6u9anDdTOClkS43mB4wL85kuB3/SR/TAp4M6AuX/YZy4cGRAfEAb/vwnM0fJ+bRYQo3W35vs8NuH8P0iLi8kBv1sDuD8QuEdri+O7fo2faesdN8XgVx8K3B/wHrujD501mdJsXwL/jCAvzuiAHK9h8P9c7R7TC6yJnqitnd+tbDGJ/K9Sz6Gfm/2Nvk9/dgbmautEm3b9Glto57vNBraLuK4/Coglfx/YpQ/pOwX8RXuGZyOIhe0HXBsy/9cBntL2+hY6T26zgBiFnRLK/6Bz9deUPbF63t8pBXzn1Zrd5iI+221wwHmFOaCxD/peLw7ow6LIjXuCNoarNP/31WJhX+omHrI64J36+4Tyf19gkebTOG4N4P56wHPavP6gbr5/uDaHfDLCPUF6HgkJvNVH/S/HajxsAw6O4L712t6ISvI/aHxxRQ+/0feaPFvh/XmV4v952nN9HMPYNwx8X+vX/tdDbOFGwCva2ue+EdX18oB5/4Q+uu66neYjy48nw/anbKn53SvJ/02Bt7QzfzJF+CRmaGdP/iBh/E8BN2ht9UyCY8uKRbUWCy6Bi4vo4/X8gNdG4HcP2pP8aR/OTVwD3BwQ+79/yPcdrcVMVZL/P9X8ec1FrGmkgMsC/IXVCeL/2gH5sG7tI2tNPb0L3tXisHbv+Wfsrc3x3ga+HnJd99b22UrgIWBIH9b/OC0OVgIvANuGPN97NQT+DwYe0J7l3CJ/uxfQqvnVt0oQ/zcEXtae7VL6Pk7T5qD/KHINal0tprwd+FnIdf19wNj/7D6u/2rgMrruIfsPsEdI75eR2hpgpfj/ba0v+xAYW+Rvh2mxnDnglwni/5bAbK2NzurjtjdU24eZA84s/udd8gM+CAwOqa4DA/z+84scqyQd6wfEAuT3Xo0HtqhwPzAiJP5fqNX/zhLPj/+V9vvHKnReeCX4P1bzmebWAN9/vbYP7IMSx536WYGfhXhe+IaaX0kCM0PYKxcXNgbuLJBHYhYwCTgI+HoF4mPC4P8o4EXtmseW4Q+dXaLvMCr+76TNPVdEtFYTFlIBvvRSY/nWCnhvTQipvt8JWPe7dQ2JvfCPyycEPKd/ffAd4O/AH4ETgX2AHYBNgRHAIKAG6A9UA1UBayNh8F8/6/+1MnIgDgBu0Z75ygqs7VSC/9/RYs5WABNVG5wUUzkZOKDM9aKNNH9GG3B4Gdf5sbZnYAawQQjcODpgz9WaeCbZAMXpBwvky5XaGLQNmAc4wEzgGeBR4B7gZmAKcDVwCdAA/Br4rfae7S3/+wfEMl5VZn4KPbaknH4kDP7vGtAeuZiLBJ4oc8/IUVrukpkl5Kzzo06LiSy3H+kJvwnI1Xscay5qge8DNwEfdJMXuBKlt/zXz/heAFhlXkvPGVBoH0rU/N+hQH7PuEtzGfyvAW4POI+xHOg5AyRwS4X3QvcDrg7It7A3az5qgK2BE4HrgReBj7UcunHz/yytLr2JB00Bl2r1u7eXPo9K8H/7gHw/cb3ze8v/sVr8V2/PY7a0nEFzgG9WkAP9gVsDcmXswFcLVcBw1X4HAKcqrtwA/A34B/AU8BzwX+BlX27591S7zAU+1+ZsveH/sIAcLL3dD7K75gOZB+wcM/830+Jk8usTJ0dYTgFe1OrweBlrJPq63T96uW826KzA8ypo92tpuaIk8H6I+Y/7IvoDg31nmI4CNgQ2BjZR9rslsLXi0uMV4v+4gL5/+wqsS+vt3Rgz/0cB/9PqdEMM/b/ub3+kxPi34Vr+khxwRu+rxjmajp8G1q3Qcw/S6iyBt8x55GVjoHbeSLn87wf8WWuX2yq0f+FULSaqN+fPV4L/g7T8l3nuDYq4j39Iq8P9JcZY7K/5MVuArXtfNbbVzgz4EtivQs+9dkDc/8shnuFm+F+87/c1zfd7VIXqqMelL+5hT2rY/Ado0mzw1ZDP4AoaFz0dMAZJlTB+aAopf8EA4Cbt2k0ViltbR9sfJoEX14C9V3FhEHB/Bfivr/2+UsH8fUH70sq11Urx//SA/PI7R9huG2n7Nkr122+m7fta3E1elnJwmLZ26wTkfCsH6wbkrHq+gvOLrxqGaHlAlwA/KvEaQbFff6rwPmx9X/r7ZY5VK8X/3bU9Su0Rn4MzVst5uQL4efE/52RtHbnS+Xs30N7TpdavEGoDfC8zIsiVtaZimLZ3ywX+X4nX2FHLF1epWF0/9Lw0uTL33FSK/+sHjEOnRXjGhH5exOISdD4YeDCCWN2LQ9ifOwR4Qbvua2tQ7H/UGKXFbM0v4+yNCwLWocLIXdxQgftUiv+pgDiUNyNch9LjIgrl6CwUv/yZlmfiuyHUcTdt7fbzCuzRGxiwvjgrpDjjrwK2197dpeaxGgk8G9E+7F20cx3LiS2s5Pn/+2n7gJZFNAdYN8D3d18Jay0TtN8+HFKu6SEBZ0xc2st54YCA8/E/qmB+iK8aDtH8NG+UOA/U5+UfhJivZGBA219d4t6CSvJ/3YB4p8ci8EXto8VZ5IDTi/zt6IB5+akh1vUUzc/wUpl7C/KoAkSA7/W7hsolI2jf599LyNsQ5Je/OeSchT/R9n69UeLZ2pU+//9EbR6+RNUxLPQP0Hkpfa7ul38v5PPWt9ByurcBR/bymvp8sxyftQGMDvClXlz8z7usy4e138uPTRTnuztLLEr+jwwYA7wc4ng0E5AjThTpdwzKMTk9ZJ9lNTBFu+ftvdwT9OOAPXC/MnQuGSdo+z4XAQcU/3NOrfDYrhj0A64JiHsbFBP/AQ7U1gIlcFsIMSnrBcRCzy1h7KvnVFnUiziqUvWzSJuvj+3F9XbXchXl116q1kCODixz73wx71F9HeW5EtZRguLyL4so9/o+2v7bT0rI+xwG/2uAP2kxysuBayq4DjIQuCLgvXdFCe/vX2nP/kJEcfNBPuLf9TL26a2AHJnD1jDuDwGuAi4ANqtg/7ZuQI6O9hLzPe6h9cHzQzyzPygG9Cmt/n+Ikf8AG2j5MyWwDJhWgbjgIer5FtP1jLhi1/yC9uVdGKEtXxBwXsKIXvSF92jXmxfCWXFxYy9gPpADHOBK9dmwMt+zKWBz4MaA/ElP9XDOi47LKrxvtFScGxADPipG/gNsp8UoSWAF8DTwwzLy4aaArYDrA85pnl3i2ufe2prBpyHm5wzCTto6s1viXLOnfcs54JQ1iPvVAesc+ffsk8AfgO8BY4ChPfQHg4GtgXOAV+iaO2IOsE8JddPzxa2oYH7uUuIW5mg+4ENi5j/AjsAzAe22ALgXOArYtAd/xVBgLHA+8GZAe81T1yn2HRDkM3kw4rMy1gp4Z0/phe9x9wCfy90l7n9MMtYH/tlNXp8c4AKzgEeBqcCFwJnAz4FfAOcBVwMPAbML5A2bCxxb4nhCzxf3foX2jZaCAQF5YG4sYu0xbP4DbAXcFzDGkkCbajMbuAo4V7XVL1Reu4mqPT8OOAciv153RIlzwTHAmyHE4peK47W10nd6cVbn8IAYqI/XoDxA/YCvA2cAzSXkm8sBKwrYjv69GcD3S/QvBuWL+2tMuZeP0MbFHxQRuxgF//P2+Vvg/SJysxXTXsuAfwC7lTH3O0Hri2ZFdLZ0UD/0uuZz+kUvrve7AD01sOZhGGABlwHPAp8XYS+FynLAASaUeUbbDsBHZYy7w8CGwEslnjEWFf8BqoCxwJ+BWQXGAz2VNuC/wOll5rgIOodtSkxrZUG5O3vjN/pmwJm5r1don3ESkQKGA98GTgUmAc3AW8DHwAKgDViuSpuaI80B/gf8BTgJ2LwX7b8d8H/ABcDvgV/GuPeyH3AQ0KDq06D6on4J4X8e1UrnJwM3AS+r9locMDZYrGQzgOnAkcAGvVhXHQacptrqAlXiHCNvX0H7CfKR5dS1vwqoAgYDG6k23Qs4APiRKgcCewLbAMPXsPNRykUc/PejP7A+8C1gP+AI4ATgROBIYH8lGxnhXuK+jN20PWESeBfY0ajGIIH8N6h8f3ptwLzptpD2NBoY/hskC9sFxAMuAc5ZQ2OCDQz/DVbHL4AlWh/waUT7GwwM/w3ixRDghoB5wKwSY9sMDP8N+iY21XJZ5osD7B/SPjoDw3+D5GBn4JWAPuBD4JRenh1pYPhvkHzsHnA2ggRcQABbRrRPHXUfs45r+G8QLXYF/lMg7v014NRexlX1hH5AHXAGcFnEe2MNDP8NYAvgdm2vmn8/xYvAr4HtKjQvSAEjgHHAFcDrQDuwwPggDf8NYsEw4Fwt75m/tAMfAvcCZwMZYBNgCFBdYHyQAqqAtYDRwE7AUcA1wHMF9urdYOJvDf8NYkE/IA3cquVAkQV8BG8D/wbuBK4BLgUagQuAC4Ergb8C/wBeAT4tsLdelrg31cDw3yA8DAIOAO7Uzj8KsywAmoFTzRmlhv8GicBA4LvAxcCLJeTWKKbkgC+AZ4HLAWsNzEtq+G+wJiAFrAdkgN8BdwL/BT4CFgFLgXbNXnLACmAZsAT4AngbeAKYDPwc+DawboRrjAaG/wa9RzWwPrA1sCdwKPAz4EzgPFXOBn4OHAnsA3wL2KSIHJwGhv8GBgaG/wYGBsng/3Nl5tUzMDDoe/gucB0wCWgCzo04D76BgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBQdFIW06+VOX/f42H7eZL1cr/NzD4anDd4/lGacvZP205Z6Yt5+K05UxLW86dacuZnracS9KWc1bacg5MW87G6vt9nesezzfGdg/Eds/Cdi/Bdqdju3diu9Ow3Yux3TOx3f2x3Y3U943BGKwpnPfKumnLOSRtOVPTlvNq2nKWpC1HdlPa0pbzetpyrk9bzhFpyxnRZ8YHq97vI7DdI7Dd67Hd17HdNmxXdlOWYLuvYrtTsd1DsN11zfjAoI9zf3Dacn6QtpxHiuB8d33BP1X/MTTRfUAnX4cq/v6zCM531xc8gu3+ANsdbPoAgz7Ge69smbacm9KWs7BM3uvFTVvO7WnL2TZxY4FV7/xtsd3bsV23TN7rZSG2exO2u6UZCxj0Ee6n0pZTn7ac5yvEe73MSFvO3uo+SeF+CtvdG9udUSHe6+V5bLde3ccYmkGSue+N098Lifv5MjttOUenLadfrH1AJ/f7YbtHY7uzQ+J+vryn5hWmDzBIIve9smcE3M+XOWnL2Te2ucCqMf++2O6ckLnv7wP2NHMBgwRyf6sQx/yFysy05WwfeR+wivvbY7szI+K+fy6wlekDDBLE/yFpy7klYu7ny71py6mNgf+12O69EXM/X27BdocY/hskgPteOThtOV/GxP/Facs5JrIxwKp3/zHY7uKY+P8ltnuwGQMYJID/w9OW81hM3M+Xp9OWs36E/F8f2306Ju7ny2PY7nDDf4OY+X+UitGJk//L0pZzYoT8PxHbXRYz/9uw3aMM/w1i5H512nJuiJn7+XJH2nJqQu0DOrlfg+3eETP38+UGbLfa9AEGMfHf26PzRkL47607bh4B/zdX63BJ4P8bam+RMUiDOPj/A+V/SwL/l6qYoLD5fzS2uzQh/F+s9ggYgzSIg//nJoT7+TI+tHWAVX7/8Qnhfr6ca/hvEAP3vXJ5wvh/bWgxwatifa9NGP8vN+uABl9x31++3BmaD3CV7+/OhPHf+AAN4uD/QBV7lyT+P6pyDoTF/8HY7qMJ478XgzjQ8N8gYv73T1vOjQnj/91py1krRP6vhe3enTD+34jt9jf8N4iY/165ImH8b4pg/t+UMP5fYeb/BjHx/zcJ4/+FEfj/L0wY/39j+G8QUx9wSAJif/Nledpyjo9g/f94bHd5QrjfpnKCGIM0iIP/m6YtZ1ZC+O/lBNo6Av5vHUGun2LLLGx3U8N/g5j4PyBtObcmhP/3py1nUAT8H4Tt3p8Q/t+K7Q4w/DeIsQ84Qe2/i5P7K3YZN+vU6Pb/LToV210RM/eXYbsnGO4bxMz/UWnLeSYu7u8ybpb83sEzl1044bbGv9xwzYjJQiCEqPizCiGYLJq4ZdJVI869/p7GDe+dswx7UZz8fwbbHWX4bxAz/73y416c79Gr8u193pZnnWfLyZOblgkhHhdC/FAIMaiSfYAQAnVN79qPTxZNy46+6XFZ9cDCuLi/BNv9sfH7GySkD1gnbTl2HO/+Aw9/8currp7ePlkIKTrLAiHEjUKIXYQQVb3pBxTvq9S1blTXlt69rphyQ/vX73nvy5jGADa2u47hvkFC+O+VnWLIBeAc9uNnDp0yuSkrhPhIrOoDvDJHCHGREGKMKHFOkP+++u1F6lr+a380VUzKjr3zjUOxXSeGPf87mXe/QQL7AC8fwNyIuP9Z2nIOO/2ch5g+/VrvHb2rEOIWIcRCH09XCCFeEkKcJIRYp6d+wMf7ddRvXlLXyF9vobrHrn+dNLHqkJufAts9DNv9LCLuz125399w3yB5fYB3XvdJacuZFwH3T1d7EP3cHSyEOFgI8aQQYpmPt21CiIeEEPsJIWqC+gD1+xr1nYfUb/K/X6auebC6B7ByPbAa2z09gj5gHrZ7kjkf3KAP9AEHpS3nTW9+HgL330lbzuF+7msc9sp6QoizhBBvCiFyPh5/IYSYIoT4phCin+/7/dRnU9R38t/PqWucpa7Zdfywqg84HNt9p/K87/AvvIntHmS4b5B0bL3rHC7744388Kjn9zvw8Bfne/75CvUDXozBE2nL2a2nGH/F05QQYishxJ+FEJ9o8/f3hBDnCyE2UuV89Zn/O5+o326lrlXwfr69Abthu09UJjfwIln9wEK56T0t879x96z9fjHtNnhYGgMzSDSmTpmElDBlStPZV10zffnZ59lyv4NnLFXx+eXwvj1tOS+lLee0tOWsV8r+HtUP9BdC1Ash7hFCLPLxu10IMVOVdt/ni9R369Vvi7qXrw9YD9s9Ddt9CdttL5P/yze8d85Sb43xiik3LJ/aNOlseSxMa5pkDMwgsfCNp7cWQrzlrZNNnty0InvxrX/aZdwsj78PqDj99iI4/2Hacv6etpyz0pYzptx9fb46DRVCHCmEeE7z6fl9hc+p7wwV5cYRreoHxmC7Z2G7f8d2PyyiL2hX+woewF502m+vv+tPk0XTCrWu+ZbSaSixTQYGFeS/98682serF6dMadr4oQcuyucM2iZtOT9JW86EtOVMU3EDT6Yt58G05UxPW84l6iyP7fLx/L2N6/X1AVsq/317AP/blWzLivDMdv37BbZTZ4Zcgu1Ox3YfxHafVOv407DdCdjuT7DdbbDdgc9ddQFTmyZt7OnOV7+rSxqPGBjEw/+MEOJTZbNLhBDH63zyrRf2U3mEBqp/+1Vy/76P9+sLIc4RQryt+QMXauuFOfWdc9RvKve+tV1/DpH+KmdXf/V3p6xrvY9XOpRKpxnDf4MEc39tIcS9Pj49IISojcNmVX2GCCEOFUL8Swix3Fcvj1MPqrW+/dT/L/HJl6vfHKquEVf9a5UO8/W6V+nYGJxB0rjvleOEEIuVrX4mhLCinrOq+1ULIb4jhLhNCPGlNsefIYQ4UQgxzFfvYeqzGZpv4Et1je+oa8ahU0vpUirdHmf8AAYJ5P/XhBDP+7hzbZTzVR9fNhNCXCaE+Fib388WQvxBCFGn88f32zr1ndnabz9W19wsSu75/CnX+uryvNK1MTyDpHA/pdbQ8+9ORwixXRQ26uPuCCHEqUKIV7U5fqsQ4i9CiJ3yMT89XKuf+u5f1G/9voFX1T1GRNUPqPtsp3SaH8Oc32NMgoFBdNwbK4R41+dHPy8Kfqh7DBRCfF8I8ZgQYqmPr0vVZ99X34n9ur3U8Xm+dYt3lc7NPMAgbv7XqLjZPD+eEUKMDtMufe/pHYUQNwS8p1/r7Xs6YFzxWsC44gZVh34RPO9opdv8/acU2stgYBAh//fzxcwvUvEzoeXg8c3TxwshPgh7nl6EX+EDVZe6CJ77SF8M4xdK98YQDeLi/jpqr1yeC3eGsV4W4Kf/XwE//W5h+el96wq7FVhX+J++rhDC/YcoHefv+5BqA2OQBlFz3ys/882N5wohdq+07fvmGPsUWKd/WghxWFTr9D4eHqbuHRRXsE+lx+Y+ne+udJ33RfzM+AEMYuD/JmrvTN72r6jku9c3x/d8300B+3K9OL1fCSFGxRRjgLr3rwLiCr9Qdd6ukr4B3xjkCt+9Zqq2MIZpEJXt91N5sfI2/3o+br6C3NpACPFb37qC9MXBXiOE+Ebca2C+tc9vqDp9qtX1XfUMG1TYH7Gl0nm+L7wobB+kgYHP/nbxxch4498zemvf2j69o4UQz2r7dLzYt/tUDPyAJNm6qvcAVbf7fDGQ+fXQZ9UzDa2gns7wzT1mqzYxBmoQtp0PVLlw8/b9hBBiZAVsulrtub87YJ++F/N2bNJj3317II5VddbzCtytnrG6AvoaqXSfv/6NUcQjGHyluY/Kgb/At3/uoF6usXeXp6fFl6enT/i4fHrK5xVqKTuvUM/3OMi3f3GBahvjC4yujatVPsh1VZxIrRBirb4wF3OzGa+k3GxmgJvNDHGzmeGqDFWfebKgd87jvX3naHn6zuwmT9/Yvjqv9flJxnaTV/DMgnkFi7u+PhZ7XB+Lae081NfOQ4LaOeG6XEtxbITi3GDFwahiTfupOKzvCSEuVDHiD6q8MS+pHLF3CSEmCSFOU20/JCl9srKF/m42s6mbzRzuZjNXuNnMLW4286ibzfzXzWZmuNnMY242c5ubzfzZzWaOdbOZLRZftGfNE1f9KmjOmS4zt/6gYvP09uV3ma+fKyav8KAyzyhI674Yr628NvPaTrXhn1WbPqba+L+qzW9RNnC4son+SegLfM82RHHoNMWpu5S+XlKce1Bx8ELFydEhrLfk92DtJoSYqNZb3ID8MUH5ZOYKIR4RQvxSCLFhHPasOI/q7w9ys5mb3WzGcbOZNjebkT2UZW42896i7F53vH/pIWdd33TNa01icv75sqWcr+M7S2dXIcTNBfL0/6yYPP19uB9YRz1j0LkCNyvdlKPTrBBCem3jtZHXVl6beW2n2rCndm5TNnGzspEhebuJSU8bKs48ojjUXgTfXMXNiYqr/XtjR773/fbqmvOKqEN3fcGLqu2HR2Xfqg29MZ7lZjN3u9mMW4QtBJaFE6wVb19+dM6+pkFObbpuxsTJ08dcN3lKKW26qRDi4gLn9GTLOaenD/cDY9QzdzlXSOlo02J14bWB1xZem3ht47WR11bltrOykbuVzQyIog/w6WW44siLRXK+UJmnOLt9OeMBIQRqrnFSwPpzb8pSNWYJfe+W4r43z7vEzWY+74U9rCyLsnvJ+RP2le9fesg/FkzYe4OFWavYNv25EOJl7Z1XsXP6+mg/0OVcQd9Y6GWlsx7fFV4beG3htYnXNl4bVaKtlc1comwoCu6PVdxYWkG+vas4vFYx9qWN0yZoY9RKlpfVfKXivi3feP/rbjZzu5vNLK+QPfj7Ae/98qCbzWwbNE709Z//TwjxaMCc9wn/Ob1fRX+1z9by5wo/EeALeVTpsIv9+trZa4MHVZvICpflyoa+HsZ8wDfG/p7iRBhcW6i4XOz5b+sKIaZrcd1hlA+FEIdXMobNZxNbudnMkyHYg15edLOZHfP39bXnt4QQ04QQ8zWf9xvKhzjSrFN16QdGKt28oa2FzFe6/JbSrb+dd1RtEHY7P6lsqmJ9gG/t93DFhTC5tlxxet1CNufz014cAff959DUV4ILPptY381m7o/AJvLlCTebGSMbt0MIsbEQokEI8b72nHNVzPoWJm9Nj3zYQulqrqbD95VuN/Z07elc6T6qdr5f2Vav+wBfn1cfcO5SmH3AxUH7sny6P1nb1xlFea4SOedVuwxys5lr3GxmRYR2Ib/Mjrvl1uv++PMmMfkFzW/jqv2qu5vc9SVxo7/S2Z3aWlO7p2NP157Oo2xjZVPXKBurBPe3VLYfJde+VBxf+Q7y1WeHCPsivUwv1kfRw7v/aDebWRSxXXj8b3/yz+cs860NtqtcNUfElT97DekHhigdPpPvVz0de7r2dB51OyvbOro3YwCfb2h6TFx7T3FdX99viqk++Xi3fXrJ/1FuNvPvGGyiw+c859KD5M3X/SnXJCbPEkKcq2IxzBy/931APvfXuZ5uPR17uq6gn7/U8m9la715pn20eMioS5M/PkDNQz6NsT5SnTlZ8rvS9+4/PQxffwljAPnKH094frJoGnv51FvNHL+CEELg6dTTradjT9dxtbOysdPLGQP4xjT3xMy1T31+tyrRCRlzWSCEGFcm/0e42cyzMdpEfhzw6pKL6uuWXFRvSFtheDr1dOvpOO52VrY2okz+j9PiHeIqQnF/E5XXXSagZEsZM/ve/Rk3m2lNgF0sdbOZQ+KIHV2T4WvnQ5SO427nVmVzRbezb6ydTQjXXlXcP0rbbx5neaqUfI4+u2hMgE3ky3V9YU9ZH+R/Suk2Ke3cWAb/11E2ngSuLVLcn56Q+uTnJbuUyP/Bal9XUuziv/l1YoOK8n99pduktPNjyvZK4f8uCfCz6etuTyaoPkuEEIcUy/9F2b28soGbzbyWILuY42YzWxv+V5z/WyvdJqWdX/Nsz7PBEvh/iJa/Oe7ypIq3TEp9ckKIy4UQ3xVC7NlTuXby1D2e+vPZxy6cYM1NkF0sdLOZtOF/xfmfVrpNRDsvnGB97NmeZ4PF2Kqy6cu12Oa4i8f9zxNUn/y+j4XFlCYxeeE9E7OLvpiwby5B/Pf8U/sb/lec//snxPfXUTyb82zPs8Fi7VXbB5aE8nkC61R08WLB7pt4oWydsI9MEP+99eFDDf8rzv9D44zv0Itnc57t+WI++2JpC8hHEXd5X+WOfaGn0iQmP3/vxItenT9h36UJ4r+XS2ac4X/F+T+uyJxNkRTP5jzb82ywGFtVNv1+wrj2kXaGTdylXZ01O1TtVey2XDH15mFvX37UTl9mx72XIP5/kd8TbFBR/u+odJuIdvZszrM9zwaLsVVl06f2Mq9PpctMlWNMJmhN8oAS1//Wc7OZlxLE/3fdbGZzw/+K839zpduktPNLyvaKegYhBMq2FyWIb48kzCc5W50lVYpdeDna7kqQXTS72czahv8V5//aSrdJaee7SskPKIRA2fbshK217R3zXiR/ub+UPNC++L/T3GwmKWsA4038byj8R+k2CW2cUzZXavzfIGXjSeDaF4r76wkh/p2Q/ujMUvfMqjbYwc1mkhADsKDUuHCDkvifUTqOu53nKpsr+hmEEPlyZkLG2//2nb3SmJCx/zfL3P83OOKcX4FlYdZ6ZsGEvYd3lxfYoDyoPL+ebp9JAP/vLyX2V+sDvpmQOUCjr0/aQp3HFGd9/lROHmzfu+HAON8NCybsLZv//KtZk8SUE9QZTYa0FYIQAk+nnm49HXu6jnmMd2Av9v9XKVuPk2tvKs77xyXnxrg2MUsIsU0v8/8MUuc5RW4TXi6aty8/Wl7fNDG/h8EWQlj5c7pNX1A25/PniltKp0s8HXu6jjH/zy29yQEohEDZ+qwY19jP9dul78yhf8VQn2VCiPMqlP9zNzebmR3DuH/BQ1efP7tJTPbP6z4TQlwnhNi2r57hGTP3+yndXad0mY/5zHm69nQeA/dnKxurRL92nnbeQVTlX4rrQXXao8Ln/RRT/iKEGFah/N/eHvGTIt4nsuTL7Lhzr2+auL0Q4hptf2dO9fNeW29gxgJFc2MDpbNZmq/M0+01nq49nXu6j3hf10mVyO0ghEDZ/F8i5tq7iuMUyAGOygkQ1XrgP4UQdRU+/8M79/WiIs99rERO6ImeL+iNy4/Lj1Uzao1niZZ7/T9CiCNNPuBuOTFE6eg/2hkUS5ROM56OPV0r/9vEiHK9L1M2VVPh8z/qFAeiWu87qrt3kC8X8Cm9PO+zmLW+x4UQ24V0/tcwda7z4pD3+U11s5mReZvw9aFrCyF+rOK+27X4xrvMeQCBNre70s0iba76gtLl2uq7/nYeqdogzP0fi5UtDQvp/K/tFBfCXBOcpzjdo835fJQHCiFeC6EuS9W4py6s8bDPH/hLN5uZV2lf36LsXvPdbOYCN5upDbIJXz/wNSHEBQHnKsxVPuAtv6rnAfnOnNlS6UI/7+c9pbuvFbIT1c5eG1zgtUkIPsF5yoYGVZr7mp3UKU4sDYFvrykul3quev5MkLuKPO+/mOK16W/z8/0Izv+tcrOZ76uc7csqsOfDO/tX/ufK0+97+sozB/3vipOL7Uu/JYSYWuA8wF9+lc4D1M77+2WB8/6mKp31aLNeG3ht4bWJ1zYVyg2+TNnM95UNRaGPYYoblTqDx1Xc3aFc2/LNyQ5W85QlZY71PxZCXK3GOpH5wrUzAc9ws5mX3WymnHNj2r+YsO/cf1/5y6U3Tvqz54P+sElM3q1JTC5FjzVCiP3Ungv9POBmIcRBpcQ+92HuD1LP2hxw3u8jSkc1xerBawPVFh96beO1kddW5bazspEzKnXWX4m66ac4crXiTDlzgiWKqwdXwteknWP/I7UeM1PlM1legO9tajz3sOrTdopzvutbG/DOjDzRzWZucrMZR53ntKKAX8+b93n7iu9YlN3rlDcvP3YXIcSjvnwPtwkhBpcxplpHncH2kjrrPn89T583CSHSQojqNakfEEKgnimtntF/rvwKpYuTizmjOuC6g1Vb5HNxPOq1lddmXtupNlzcTTsvUrZwk7KNMXHmb/b5Q3ZS3HlYcamtQH+wXOlzpuLmjxRXKz6e9I1nR6tzDH4ihPiVEOJS1Wc1CiFOU2caj833P0myZd+ewU3cbOYAtabzWzeb+ZObzVzpZjP/52YzJ7vZzA/dbGYLN5tZ68NLfujfvznfN746rNTn8817NxVCZIUQc7T2nKM+36Svzwl8fd4mPTzrpqX6QXzXPsw3P52v2givzby2U234Q9Wm/6fa+E+qzU9SNrBJKXv5ItbdEMWlwxW3GhXXLlXc+4ni4uhy4mcrWM9UX7VX3/igYL/vG8NP02IpRvViblXVwzvxZ6W+ExNmu+uoZ+hurFPVC/2N0mLWpnU3dyimnfuIXlMmliQ2/e/gy+O0XAhxTm/aQv12YIE58VLfnHitvtDevvNt876OpQV8HQN7qTOU7pf78sbtYDhhELJtp4QQDb652NtCiK17Y3cl+sQTGUvs81+Fvtahfr+10n3++g1f1bVUg8jt/GsqLiVv39dUwr8ZsCaux2C1qDXxjZIy/vP1XRupurUExJ5ULNbB5x+7xnePF1SbGAM1iMrej/eth34ihMhUyv58/vLuYuKO02PiYtLD2qou3cU6VldYNxml8/x61/FmPmwQse3XCiH+5rP3+/J8DMH/e6QQ4hltvXWxLya+f1T276tXf99eh8XaetQz/r0OFdbJ2krX+fv9zeRbMIiB/wghLN9+v8XqPVhRHvruNdq3J07qe+LUnvJQ57+++ck2AXsdpW+v4+gQ9XCcr7/5VLWBefcbxNEHDBBCXOuz/+fyc/MQ/Wv5PfGfa/41R60HjwqRe6PUPRzNP/l52LkOfD6G53z3vVa1gTFIg7j6gG197+QVQojzw3wPazlxHgjYZ/xvFRMzuBJ18MXYHaaure/LfcCf6yjEZ04p3a7wjTW2Ndw3iJn/eu6091S8VhT3rVXxXy9qvjdXCHG7EGK3cn1vPh/kbuparuaDfFHduzbs8be6/ljf3pguOawMDGLsAzbQcqhPLmUPSwX6gY3V+rd+vtzH6oyHzYsdk/jetZur334ccD5jg7onET1jjdKpP3f1Bob7BgnhP8rfvciXa2W/iH3yVSr+bboQolWLJX5NxY6PKFQn33OMUN99TYvZbVXX3iGqOHNfnfbz5aRapHRt3v0GSeoDhggh7vDx5SF17mMcsbf/TwjxaEDs7eNCiO/rsbe+GOTvq+/oMciPqmuuFcPzrKt0ma/PHSaHmkFC+4A9fHls2tTeF2LgTH6/9ilCiJe19/gClWtmZ/Uer1L//xcl848bXlbXGB7jc/zMly9hrtKxMTiDJPK/WghxhY9DM4QQY2KMz0sJIb4uhLhYCPGhNo//QAgxQZUPNNmH6jdfjyumXgiB0t0MX72uWNNyIxiscX3AFkKI133r8hetXBO33XypxnaHYrsbYrvbYLs7q383Up/3X/ndytSpSgixqxDiZs03kNPW8VvVd3at1Bw/bTn50j9tOUPTlrNR2nK2SVvOzurfDdXn1fnv+mIdLvLV73WlW2NoBknmP0KIM/Jz6MlCzJ7WdF2af8oabHdbbPen2O5N2O5z2O7r2O4H2O4n2O5sbPcNbPd5bPdWbPdkbHd7bHdwJfoC31x6YoFzntqVrNc+Cx/nB6ctZ/u05Zyctpxb05bzfNpy3khbzuy05XyStpwP0pbzetpynktbzk1py/lp2nK2HbnlwpqpUyalfefkLVM6NT4/g77QB6wnhHhiimiSEydPlUff9Phj2IvuxnbnYLvLsV1ZRGnHdj/Cdv+G7R60sh8orz791LmTTQExu3oscZP6bllxfD7eH5S2nL+lLeejtOW0py1HFlGWpy1nTtpy7j7rPPux666bIidPbpKeLpVOjYEZJBrzLj+F315/F5PE5IN+ff297i53vCoH/u0LWSTnC5Uvsd07sd16bLeqmH7ANxbZUAjxu4BzneapGP5rAvYZv6t+s2Gx71zF+6q05dSnLefOtOV8WSTnA8vu+70hjzrhafmH7O3upKbJB1108a28+My5xsAMkg17Efx9Wc1aD8w/Y9DfvliEvai33PeXudjumdjuwEJ9gI/3Q4UQx6h4eX1f7t1CiHrlS6tW/393wD7j59Q1hnbXDyjuD0xbzplpy5nbG977yy7jZnn9wKLvfu/NM75V/17NLuMcY18GyUXnPH1dbPcS9c6WIZRF2O6V2O5IvQ/w7cvdUwhxj8bn5UKIZ4UQR+f5rP1uqJI9q8X4L1LX2jMox4ni/si05VyZtpxFleK+VryxxCVpy1nXu5+BQUK57/nuJ5Uwxy+3eL6B67Hddbz7+tb6viGEuMqXGyNf3lG5pDcoIv5vA/Xdd7RrfKKu/Q11rzz310lbzvUlzPHLLZ5vYJJaKzD2ZpA07ntreudju20hcz9flmG7F/Fg24BpTdetJ4Q4WwjxVsC+3KZSzl3RzqFoCthn7N3j7KlTJq23417vDkhbzkVpy1kWMvfzpS1tOefn1woNDBLCfa8cg+3Oj4j7qixqHXvnG1cp/7i+L/dBIcQ++X25ZebWH6Cu8WDAPuMnDvvxM1ftMm5Wa0Tcz5f5acs5Ro09jP0ZJIH/m2K7L0fL/Q7+y/Xv+0iOn3arnCya8jG7/xNCnFiJMxa1s+lOVNde4a3JXfbHG+U+B73U4aOLmP9eeTltOZsa/hskgPspbLcR281Fz//Oss9tz3tnX3kxvOPDOFdZO6d2fFPT5A9+euo/4+B9vuTSltOYtpyU6QMMYua/F9P3blzc98qQv32+xLrthWPlgaSmN10X2uNOnToJKUmd+It/HrvH/q8viZH/XnnXixU0/DeIkfte+V2c3F9VFv+B+z+Fh3KhPfK2u33AXge8xs7jZv0hZu7ny++MH8Ag5vW+R5LBf/ep/HpgWPCt9z2VEP4/YtYDDWLk/1gVk5cE/n+O7X4nAv5/J205nyeE/16s4VjDf4OY+H9iBLE+pZRfVmrfcAHue+WXCeF+PiboRMN/gxi475WGBHHfK5dGwP9LE8R/rzQYH4BBTPyfmDD+T1dxiGHx34u7m54w/k80/DeIgf812O7tCeP/A9juoBD5PyhtOQ8kjP+3py2nxvDfIGL+r4Xt3p0w/j9Ubp6QEvJ6PJQw/t+dtpy1DP8NIuZ/P2xXJIz/Xl6xASHyf4DK05Uk/ou05fQz/DeImP9eySaM/1dG4P+7MmH8z5r5v0FMfcDpccb9B5TfRMD/3ySI+94+gNMN9w1i4v8e2G5rQrjvYrv7RRD/s1/actyE8N/be7yH4b9BTPwfge3+JyH8n4ntjo6A/6PTljMzIfz/T9pyRhj+G8TEf6/8MSH8v075JMPmv+druy4h/P+jmfsbxNwH7IntfhYz91vDHvsHzAFaY+b+Z2nL2dNw3yABcUDTYub/zWHF/XQTB3RzzPyfZuJ+DBLAf9T5fXNi4v48bHf3sPz+Bfjvld3TljMvJu7PUecHmrG/QRL6AG/e/XuVkzdK7rdjuxeHFfNfxF6AiyPI+60XL9fw703Mj0HC+oBh2O5fI+b/7dju8Ci5r/UBw1X8fZT8/2vacoYZ7hsksA8Yg+0+HhH3n8Z2N4uD+1ofsFnacp6OiPuPpy1njOG+QYL7gK2x3Qex3RUh8T6H7T6mzgYnAfxHnfH9mIrHC4P3K9KW82DacrY23DdIOP9RcTiTsN0lFeb+UrXHf6O4uR/QB2ykcgMsrTD3l6hzv0Ybf59BH8sNerKKy+vtWMD7/atqv0FtEnhfoB+oVfH4r6p3dm/f+V6c4ckmx6dBHz4bpA7bvQDbfbOM9YHl2O7b2O4f1BlDqSRyX+sDUupsHi9H+NsqR1+p/v0305ZzQdpy6swZHwZryPqgx98jsd2p6qywz9X8YLl6vy9Xf3+h3vXXqzMFPR9fVZJ5X6AfqFK+wWPU+cDemOALNZ5frt7vy9Xfn6szvaamLedI1X+Y9T2DNa0fQHF5Q2z3W9huBts9Qs0TvL5hHLa7g5rfVyVljt/LfiDfF3j+gR3SljNOcdwb1x+RtpxM2nK+lbacDdX3zBzfwMDAwMDAwMDAwMDAwMDAoIKQCUIO/18ttZpYtlG/6g/ZWNVVXueX01Veu5q8oYu8pgd51WryeqmhvWq1B6iTOlL+P1q7ysevdrsuCpCNq92tq7x5NXlNV7n/kXJd5S2ryau6yld75K7y1pLkqa7yuoLakFJK2daTvLagtgLkzT6zaqvyqzRH3SptAVI2U/9kp0rb6qRsJbVSW+1AnYRan7wR6vPyVqAuBzWr5DmgVsrmeimlbAbq2iH1ZGct2upku1/eCNS1AavkbZ3yxjopZY4q2VzXWpVjLynbUh3y1pRsycvbqZeyrrVWNqekbO2Ut9TIXF7ehrLVllXy5jopZ0hJrey8ZUfDe9du2bNTXi+lVPLWGmU47avkjQ1SSpkbULuSxi0NMufJj2/okMtOeY2UHffqULUnb27pkKf88noll6vkVcr+q6RU98rLW+tlW11uNbn0ycd3lbPS8MevlNdIKaVsqxsvpUz55am2Ok2eS60kxngpqzrkinit9Y0rSbRSXuuXN6+Ut9ZJOV7mato75LU+ufpuTVd5S4OndVWXVKc8VxMsb6dejpfttX55s0+eg1SHvMqTA1TJZtlav5IRQEq21cpC8uYOeZ1f3ihb61YyrlXJU6vk42XbKrlXAe964wvIZZtPXtuhtyrv5ivlUo6XLfWyWek3WN7QIc91yHNV3sN3kTcouafKnCZvbpAt3cplp7zKk7fXeJXQ5a31K+W1Umry8Z1yWSVle4c81VVe16FE2V7b1mEyPnlKdrC3rU6Ol7JNydtXyatklZI3evLWOikbu8q93zU3yNa61nopm/P2Ies6NdZ53+Z62aLJa2S7p5FOeYv3lZYGKVsa8nJka01Hu9R4/VONpKFTnsvbX21jbXutkrfxGxqaPXl9rkrJoVNepfpH2SylbPXL69pqZScFO4ypUy6rVtpnQ1udkkuokY1SytZ8XyNbQXqSDorLZmrleCm9T5BSSiklNb53UCsNBV8yq7+jVP9UWN5c3728pSd5Xffy1pru5W1V3cvbkVJeWVieo17magvLJbWytTt5IxtR0428GbqVt/YgVy/TgnIJNHQnb13t8l3l3euv4nKDNRV1UkopZVsBcQtaTyR1ItVLKWU7qUBxDmqllLKVLhMb2fk7aqSUsoWuExuZf4NLKRtXp5OveiCllBodi5Y3Aw1S5oCq4MeDeinbgVQ5coA6KdsKzXp7kOcAaqVshUAFRiKvkbKlgLzdL6+vvLyn+8etn6LaN//7QvZTJWVzd/aFlBSSez+kXo1dC9h/Y11bqjt+tNS01BSSe/xrSzXWFeJfO1S1Q31zAf62Qa0E2Qp1BeR1srmq898AtI6hwZPnqA68f+v5NVI2pqRs2SO4f+gkd0Og40FK2SKl9J5PShncP0gpW6qaawIdC1LK8VLK5trWqkDHgPrQa59C8iop25GSes2NkkeNlK0pKRtrpWzpKu14qJZ8CbJfNeFuS0nZGsTfWpnrUA4NmptESinVtF9KKakLHCK01cuOZ5fNtbI9QN5a70mk9L4V4Dny6pR/f6w2nmuvklLKXKqloaN6HRXM1ahZd72ULR2ftjKm48mk7HjKamqllDlqpGzs+N9m6NCMlB0agiopZSspmYMaKSWkOjQrZYeWG0FK2QIN7R1fzUFKUi+llFK248kbpPQsvQ2Qsh1oR0oppZSSeqBeSqCuFWiQbcCZVVJKKaWUzWt7ks45RUuH3PvWmBoppZRSypZqT9Ihr2nuuJT3Lep8TIGajptWNXcIVu8UVVfZ5skbO+Rap9LZ1bR5HzZ23Kp59U6j889WT06HvHH1TqHFk3TIKUZeo2642vVTskWJqZF0lVO8HILkkBR5b5+vJli/LcXov7F7eY/t37zSflj9VdZpP377q9de5R3ylu7tt6Yn+/cknb/4TUpKKaWUsnHjzqt532qDVJ5/DVJKKWUuz7/GTsdnd/xtkFAjpWwkpbonKVurOv6UsgWkbKZOev/F+1yqDg6qpJRtVOWn4m0cL9uQUkopqZMDqJNS5qhdOXedsXr/9aWUUsoFq/d/jXVSStmWWq3/8/WfLTWq+wrqP9vqVvW/Qf1ze51sR0opqZdt9YH9v+wcv8rA/t+rU3Nth3YC3x89vX9kVcejdTxk4Pvtwk7V0CDlkwXfn3VtBd+fT656/15Y6P3dWtVc21mVrmiRUrZDfaH3f0/jh9ax3tN5ZUhtgfFNRxO2dzf+aUwVHP+0Q42EgvOjtlXjr7pC4zfv+bubP3n6625+RF1bwfFlh7whR3fzp9TKf7qCVePfssbPCZ8/hT0/Svr8qbft16O8t/Pznub/PfkPevJPtKnrNnfH7278Hz35T3ryv8hW9XljYPViwv8fAKHGUKc+IAQA",
+	"static/js/cayley_main.js":                                               "H4sIAAAAAAAC/7xXe2/bOBL/2/oUU3ZxlbaKbGebPpJ6ATdNb43NA6mzWywS/8FIY5kIRaokFcdX+LsfSEmObCubtts7A4mtmd/85smHul04lPlCsXRmYLfXfwEXM4RDuuC4gGFhZlLpCIacg4NoUKhR3WISed2u1+3CMYtRaEygEAkqMDOEYU7jGdaaEP5EpZkUsBv1wLcAUqlIcGApFrKAjC5ASAOFRjAzpmHKOALexZgbYAJimeWcUREjzJmZOT8Vi40E/qo45LWhTACFWOYLkNMmEKipgrafmTH5frc7n88j6gKOpEq7vITq7vHo8Oh0fLSzG/Uqoz8ER20L8LlgChO4XgDNc85ies0ROJ2DVEBThZiAkTbouWKGiTQELadmThVamoRpo9h1YdZqVofI9BpACqACyHAMozGBd8PxaBxakk+ji9/O/riAT8OPH4enF6OjMZx9hMOz0/eji9HZ6RjOPsDw9C/4fXT6PgRkZoYK8C5XNgOpgNlqlk2EMeJaCFNZhqRzjNmUxcCpSAuaIqTyFpVgIoUcVca07aoGKhJLw1nGDDVOtJVX5Hk/+dNCxFbvB/DFA9AwAFFwfuABpEoWeeN5LGgecUkTn3S1ZY27sZvJSN+mJIQVVRICKlUSAnS71tDv9/bCl3tBRPMcReInwYHTWodOT55ymUqyDbhNVxDrpxLfUgUxDKw60sgxNkPOffIzCZy6jt2q3W+/IY9okvhxKdAx5WiTxHmZ4Ak1it35wcG9OnL//V60Fxw0WIyiQk+lynynt3zLwPM6AgujKD+UXCoYAHn6xn2I17FzKJyo9+HN+73XxPM62sh8KJKPqNHAYFVEcA3pdGzjfZssgwH0DoDBW+i/OQD2/HkJ6LhgLtkkskx+4HU6ywfs4kIpFOaCZSgLoyOOIjWzJlfMkapK72/AL9mkJt/QwAAuJ14dSf/VJKLGKP/LlHG+D81yLIN72OtHYGsZ9KvM+62ZlzzaKHmDLUxLz3N/rWF7nSmnetYovY8csxC0ocqUnjYLlxd65tsINNbCtYUEliGigmXU4H1grv3LEPq9Xi9YVh7g5/I5+HF+1goQQn+3dOeX/p5DP2g4tYWpGJo1KBNvdLR2UnZrLZXviPxB4gdiX5XINcuvzCch2EW5IX49CaEf9TbFL5x4C93vtcNftsN3J6E9M/9Bzq//vpjLEHb3vnMeHvTwQFV/+YeOXj2ayo/38EAqL/YeGJAX26195cRbrd2bhLC3jX7Tjv6lHb3rxHv/0/l42fs/zEe4uRd01o4qe0BaWU3kHpc2ulf3GwsAm4L/k0+exjJBElQnDjwZQK++HmDCjDsnD2WCJ0wpqaKpktkF3pmhQuonMi4yFCZK0RxxtD/fLUaJT0rOsOIB4EzgaZFdo9L7YFSBYaXIqIln7xSNb9BsqGIpDBMFHsrMEut9IEfCoCI1oLqYAgDeGUV/x4Xehy/k0Ci+c072gRiZpry2J0tntnT3hCUg17iRZX2ZctWxp1uCU1pwc16gWoyNYiK1B1NpRVL2n0xaL2l0lDHjP/sNOZfwSSqePAuqIEn2mVvM5ZcrAXBFWHJF9uGKNLBX5EosJzU+VTSflTbORMgEtT9lSpt96PcCJwRgif1eXoklaQacojmmuhnwB5fZalzMIse6u7b/9llO/bGRiqYYwJPBAIi9jU6ZwIQEqwYqNIUSwGVMeYW2TR8ZzHxSXjXH9BYT65qhJvAcnK+y5q7am1wt1b20NpPSppmXnjMTzy7kY6nAYDCoO2PP4Nru31biB8sWsG1QE3pyftwOrDuzxmtl2wZfVdG1Uur1UrqaHFNhr+4uz4NVTUov5czek5XPluZPygv0WwehLFnwcHldmbYuuhZu94nyKo/JzmfLumPfckgQzUzGfVJa2q7HVKEZ51SUfmqjVUbuxWjVpLYoTs6PN287XxPCyfnxt/i3fW+tQdnS74mgMv2mKlRDVUfyeLncy1XNbjXwL3Gtc3ir7XPMqdaDZw7w7Ne3XSv8ldS7/VfOpm4sZBh89ZpfLZCD1ZiuMw3KLfZ+alevETe4ANa6I9yD/37FbG4+N7gIW3eYG1xMgopy2VhVtpOPJHu/KhspOrsnW6nV4+T0rbvgClHvV/frsglsgy09zyvH0cl2EiXzRM4FCaKYs/jG3xrdVpagZsk+82/icHvmGkPV+2+LpJ6XkskezJ733wEANWVPRmATAAA=",
+	"static/js/cayley_query.js":                                              "H4sIAAAAAAAC/4yUUW/bNhSF3/0rzrQClTBNSoM9NciD42aYt8zGIrdFgQEBLV1JXClSIS9tC0H++0DJXmL0YdWTzHt4/N1zr53nWJh+sLJpGZcX737BpiUsxKBowNxza6zLMFcKo8TBkiO7oyqb5fksz3EnS9KOKnhdkQW3hHkvypZOlRSfyDppNC6zC8RBEB1LUXIVLAbj0YkB2jC8I3ArHWqpCHQoqWdIjdJ0vZJCl4S95Hb8nqNLIMGXo4fZspAaAqXpB5j6tRCCj9DhaZn793m+3+8zMQJnxja5mqQuv1sublfF7c+X2cXx0ketyIUAHr20VGE7QPS9kqXYKoISexgL0ViiCmwC9N5KlrpJ4UzNe2Ep2FTSsZVbz2eZnRClOxMYDaERzQssiwg382JZpMHk83Lz2/rjBp/n9/fz1WZ5W2B9j8V69WG5Wa5XBda/Yr76gj+Wqw8pSHJLFnTobejAWMiQ5jREFERnCLWZkFxPpaxlCSV040VDaMyOrJa6QU+2ky5M1UHoKtgo2UkWPB5901c2m72Ja6/LUI8TPM1mwJs4+tFt//JkhyjJRFUtlHAujkTJcjcuxwwwnnvPD1RJNhbXWJiK/pTWGpvV1nQbOvDckogrU/qONGcN8a2i8HozLKs4mgyiJMXTDACU1LTy3Zasew+2ntLxuBNctjdWlF+JXxWek6sTqvX6YeuZjY6SrFSy/HreEQDshEUlWOAaE3HA+SSUpzgZBcHoRJQxHTj+RjcJhZadYIqTq+le1hvHcZSLXua7d/ljSC2P8BMcKSqZqjHHu+Oo0pFicgKyymh6gbXE3uqHUXHkDo+sEfPQk6njgo0VDSX44foaUZhmLTVV0Ws5oEwp1FGaOeIlUxdH5fjfUYjdhCTJfScl8Pzf29nUM3fK5vdivcrCr0M3sh6mj72wjs56SqG9Uine/s1vkxd3x6af6+qeHPEp1jDgU0i1kOolpH8eD61NEUZUsGDvUlDYuk1rzV4nT/+HOt7PLLneaEdhTb+L5DmZhZX7dwDr/Gy3lwUAAA==",
+	"static/js/cayley_shape.js":                                              "H4sIAAAAAAAC/4yTQY/bNhCF7/4Vr+oeJNSVNoueGvjgOltU7UJuV06CnAKaGklEaJIlR1bUYv97QVlJ191LeTAMzeOb781IRYGddZNXXc+4u331Aw49YScmTRO2A/fWhxxbrTFLAjwF8mdq8lVRrIoCD0qSCdRgMA15cE/YOiF7+lJZ4x35oKzBXX6LNAqSpZRkr6PFZAecxARjGUMgcK8CWqUJ9FmSYygDaU9OK2EkYVTcz30Wl0iCD4uHPbJQBgLSugm2fS6E4AU6np7Z/VgU4zjmYgbOre8KfZGG4qHc3Vf1/fd3+e1y6a3RFOIA/hyUpwbHCcI5raQ4aoIWI6yH6DxRA7YRevSKlenWCLblUXiKNo0K7NVx4KuZfUFU4UpgDYRBsq1R1gl+2tZlvY4m78vDL/u3B7zfPj5uq0N5X2P/iN2+elMeyn1VY/8zttUH/FZWb9YgxT150GfnYwLroeI0L0tETXSF0NoLUnAkVasktDDdIDpCZ8/kjTIdHPmTCnGrAcI00Uark2LB86MXufLV6iZtByNjPc3w9wq4SZNvw/GPgfxU98JRkuWiaXZahJAmQrI6zy/IovSD+XgcmK1JslxqJT/91w84C49GsMAG1Ci2Pu+I3wk9UJrNgpvc2cBpUginivOrIsS+RYLvEEiTZGpmnIcl8Hp2u1wF8sYa+repJx68+Tgrlv7xqBYpT45sm9ZsvegowzebDZI4k1YZapLnckBbKfQizQNxyXRKEzl/gbU4X5AUhf9JCTx9/TfHwybGFj7Qr/W+usL+KowDPqu/kiynk+Mpza7izDZ5L8J+NL9768jzlCbGNhSS7DpM3IAhxgYV8Wj9p2dWiJWl0frC9hL6KXu9uvw+Zat/BgC5AtqLnQQAAA==",
+	"static/js/cayley_visualize.js":                                          "H4sIAAAAAAAC/7RXUW/bOBN8tn7FVikQCXUlJ22BfvbnBzdt73yX2rg4bVEEQUBTa4kXmlRJyo6b5r8fKEq2FCdFr8DlQVB2Z5fDGVKk4xhOZL5RLM0MHPeOXsJ5hnBCNhw3MCpMJpWOYMQ5lBANCjWqFSaRF8deHMMpoyg0JlCIBBWYDGGUE5phnenCJ1SaSQHHUQ8CC/CrlB8ObIuNLGBJNiCkgUIjmIxpWDCOgDcUcwNMAJXLnDMiKMKamawcp+pimcCXqoecG8IEEKAy34BcNIFATEXa/mXG5P04Xq/XESkJR1KlMXdQHZ+OT95NZu+eH0e9quij4KitAF8LpjCB+QZInnNGyZwjcLIGqYCkCjEBIy3ptWKGibQLWi7Mmii0bRKmjWLzwrQ0qyky3QJIAUSAP5rBeObDm9FsPOvaJp/H579PP57D59HZ2WhyPn43g+kZnEwnb8fn4+lkBtP3MJp8gT/Hk7ddQGYyVIA3ubIzkAqYVdOZCDPEFoWFdJR0jpQtGAVORFqQFCGVK1SCiRRyVEumrasaiEhsG86WzBBThvbmFXne02BRCGrzQQi3HsDTwD/Q809MF4Szb+iHEUmSE060DnxCDVvV62NJrhF0oRzNQqMCjajhb7IimiqWG0ClpNIewJqJRK4jKcoIDGE76FKn4S3hqEz5OrgbeB0PYEUUUIXE4G+K5FlNp5xIs1yhLrjRjjoAW0BQjaVZuiRlMTwZDsu5L5jApIYC7BCRNjJ/LxXFkeFEHwfhPuSacb6NW5FWDYkM3pjA9136rnzaGQiZ4AeSwxBud8HU/mszug8Xl13AJHWvDmJ9DiyOwRB6A2Dwf6hmGXEUqckGwJ49203DJWFYoy7Y5aCmLwtFcZu68F3Av6zyhqgUTSPvAv6l1+l0LAeHv6KSS1XHHGYXs5q3+7ucfwlP7une6XQ6Tcges7rSIu8AucaqKo7v1fkHx/QFvur5DzX1D3q9ozevR2Xy7j7J5gQeIdmE7MnzKMl7Vf7B+97/XvR6FQuAeoVWy+LCsb4s16dRBe48dSslIYbAsBEEYEm/MqXbiN704QMxWaSISOQyCJu5zQ9ymn3DPhz1mrGSfb/t/DZ9t317wHVO5sgfEHTX204oKmH7zlfVu6FqZR8pd2UPMEujcnNFeaGzwJaE29R94Z3sXrtD0yHn6L92yJX9xw619uGPDaqgv2hQu/qnDXJlv2ZQrfuDBqVR+cl09bdeU3gffXgGbKeWc3p/z7gB9p1yer/aBSqxDw8opYc1kdBrfOgbB80QBK5dYEsstZk+pHVLKoW9C6Ftuj1CDuusRmPvJrrf0DXBBSm4mcgET2o2SF8dvXy9JVTxCQfe3sFGlGmdbAOv02kAFjZHylyUR5yJU5l+kAlW0g+8qrs98VQhruaFMVL4YUQ5o9f3Lw+tXYEJM1JFKZpPhBdYHZ52XZbrhIkEb6aLoD50QhgO4fkRfP8O7Xx1aFX5sBbGXRoO/ypQbUBnsuAJZGSFcE7SRhERiYtshzES5gjl9dBI2HkQbg9UUygxaFhMBFsSg0Gl79Mol9oEfkxyFq+O4q+WQmxXnkaO1GBSkjqtLmhdaC7yKJECg8YFxg52VSIantsLyUgkZ6jR1MPW6plNjnIRzIxUJMWw/Dj52w3tt3c0l5TwChppNGODy8Cn5S+JGVk5qgz1T7Jv7mPOxLWGoZWDKI1/zKaT1nR2e+iRi1xQdojcdybcbS6n04Iwvr++HlfHrX77vAu9fwYA4f/Ev0ENAAA=",
+	"static/js/cayley_write.js":                                              "H4sIAAAAAAAC/+RXXW/buBJ996+Y6uYC0q0rubn71CAPbj5Q76Z2N043WxRFQYsjiy1NKuTIjhH4vy+or0iOkxq7CFBg/WSQwzMz5ww5oyiCE52tjZinBIeD17/AVYpwwtYS1zDMKdXGhjCUEgoTCwYtmiXysBdFvSiCCxGjssghVxwNUIowzFicYr3Thz/QWKEVHIYD8J2BV215wZGDWOscFmwNShPkFoFSYSEREgFvY8wIhIJYLzIpmIoRVoLSwk+F4iKBTxWGnhETChjEOluDTtqGwKgK2v1SouxNFK1Wq5AVAYfazCNZmtroYnRyNp6evToMB9Whj0qidQTc5MIgh9kaWJZJEbOZRJBsBdoAmxtEDqRd0CsjSKh5H6xOaMUMOhguLBkxy6nDWR2isB0DrYAp8IZTGE09eDucjqZ9B3I9uno3+XgF18PLy+H4anQ2hcklnEzGp6Or0WQ8hck5DMef4LfR+LQPKChFA3ibGZeBNiAcm6WIMEXshJDoMiSbYSwSEYNkap6zOcJcL9EooeaQoVkI61S1wBR3MFIsBDEqlh7kFfZ6B36Sq9jt+wHc9Xo9gCUzwCQaOmdCwjE0BoS35IwAAA587z+F0Vt96wWh2yr3d+0yzk8ks9b3isVXnKk5Gi8IE8ZxpPz/DwZByFGytX84GAzK9UlO5cZNjjm2wryDA98VYxAaXOgl7oTeBEc9gE0nn2kex64MniMl67CtfZacGuytpOIU4++/56ybUZ2OSMCn0OazbxgTHB+D59VbcK+v740dJQwqQy+oLAxSbhQkTFosljYt1MwgFzEj/DFuY7ofst4vXAV6r3CrZTI51sw5Ie3s2gjCro4xiWXx+FVGjPOvNznjXhDGUsTfuzcFoBThpuS/jrSi8U3ppuY0XDLpB/3KpqGktGox1LXTLSi9E0myGcrSoPhb72/R+qKpFN+FG9wTW/LTMj8IM23J9yKWiWj5OloVPPXh1+lkHLoXUM1FsvY/O5wvQc1+yLVqlXOJ+pUzYkHtCTp30PeujSYEVvD3opFx0yAmTu0G8dvNbWruwy6pd/hw7EJmxqKLsLQLDdpMK4tXze3tFpE7GKIx2nT8boJaeo4SCf+h+mY/+c1e+psfFoB5pgoouXiOEjgtkPnPWAQOOc+kLtRVuZTdxQ9Gz4um3Xp4cYmq8/gWC6FENaf0RC+ynNxIch+/g8vQxKjIbTsu4BjeM0pDo3PFawDNOHL4H7weDCCCcpE0MRkcVUi7Opf3MatOggcvtx2FpKeFkn4AL8H7b8X9BlBahLsf4wo1D8PQu6+zTZehVka7GbKks6Hil2iR/CqTR/QsEXcI+kR85WiKhC+K9AuxDdpcUnC06+yOtitUop/q9L2y7B9p8eVut8+DH3S4LR7Xr26mbs8Nj8n61GRw1GsKGLYK96E0bl6XyB+VZvv8brGeJL9yUd/pv012Pc79BFzXobSo3iLWPSvPSmvCxL+E1Afgj/RgMusmIKbEghH6jXunTFIQjis412Zxyoj59Wuf8JBlGSrue2PXGM+doz5wHecL98TOkc4kur9v1yPue8o1qSZXIdF+HnwJWq5uU1P5+vP9xTui7BJvcrTUeGwK4DY1T5FYKvAYi9tqViK3Pjvqg64LVm8n4/zM1eKFsIQKje9lVQvz+ls9rV8O0R2Uh8fdCa+/9djvd7TouV6/c2n2O8lm2tC91+qR2XVWZ6h878NkeuX1oTvMRo7pSJWzXfuMdbWQ8PIDAGADMaM49dGYAO72ruhSjhp31w1vDyEOfN/7tKmmE/cV+NcAbBToIiESAAA=",
+	"static/js/query_viz.js":                                                 "H4sIAAAAAAAC/+w662/jNvLf/VdMtb8P1K4jO263KGoYRZrd/Bpcmj006SNXLAJGomUiMqWSVGJdkf/9wKeoh/PYHnqHQ/NhYXHew5nhcLizGRyXVcNpvpGwmB9+AZcbAse4KUgDR7XclFwkcFQUoFEEcCIIvyNZMpnNJrMZnNGUMEEyqFlGOMgNgaMKpxviIFP4iXBBSwaLZA5IIUQWFMVLxaIpa9jiBlgpoRYE5IYKWNOCANmlpJJAGaTltiooZimBeyo3Wo7lojSBK8ujvJGYMsCQllUD5TpEBCyt0upvI2X19Wx2f3+fYK1wUvJ8VhhUMTs7PX5/fvH+YJHMLdGPrCBCOeC3mnKSwU0DuKoKmuKbgkCB76HkgHNOSAayBMrgnlNJWT4FUa7lPeZEscmokJze1LLjM6ciFR2EkgFmEB1dwOlFBN8eXZxeTBWTn08vv/vw4yX8fPTDD0fnl6fvL+DDD3D84fzd6eXph/ML+HACR+dX8LfT83dTIFRuCAeyq7iyoORAlTfNJsIFIR0V1qVRSVQkpWuaQoFZXuOcQF7eEc4oy6EifEuF2lUBmGWKTUG3VGKplwZ2JZPJumapgsI5kfclv0Ux/D4BuMMcNkSH3wq+ms+Xdu2eZnLjliYAsxlcbijLBVCmOa8pF1IpmxLIOa42iaVkZUbE/8MKWF0Ujl1B2e3o4nkXO+AxgrxnuU+d1vxMyXuHJe6hpzXXEjugUfsESUuWhQZaFhLn16M2KkDPzh7JHoIR/S9x/ogJlzgft0LBcVF0181yxnEuJOYSVuBiAWUmCACyzxNBCpJKpLI/TtICC0EyFK3pjmTRFLJE/4IVSF6TWCnzYPxW1tx6KaOcpJJkUOCmrKUVbGArJcKsJ3oFxSH8WqkHK/ORqA8UJyVDkVdb6eB+x4ED97PX2qVlUXJvsKpvujqYZb2NltcNFkQ59VhBhGEoUlyQJMWS5CVvFnOvs4tcjzz0KCey5kxx4fkNil69ffs2ihNZXkhOWa45PVhuQvLylpyU/FmM5vN5FCc3+jwgHA14Wqa8NNXgkmMm1iXfnpT8zKodyIFWEF0D+ixLlG1uyUtXoaRXHvS/Og4Jk4Rf72AFyFAloqzV9u3gDdgViXlOZLKLYQaLPmkzJG0GpE2XNFPyeszhAHoKtOjNAL0ZoDce3TntmuOMYqZ29nssNwmWmC1Q1kyzXTxEzog6dRTygP41oMOv5jAzbP5+GodbGml0giJ4M2T2BqIpKIj3c39F+SqKo3DT6yrDkpyZmuJDye2mSu0ES8lRtDuMpt1Yc2HmffhgdFV/lqh5kqgZEu0We4nc/o1IepLISPKG65y/pGnHbuINd3Ui0eUDGXkq+ycdselur9hWSYfb7MVVumlkd2YlBKcbNExtk3P9lAMl7oVZZahemlAunfv6Pt8r8BK3DJClq03R9NF6ZdVTzuq6StuV4qJAbeTrs8mGht76ExcbiHRiJgTtDZuntg9lCRXXUh1eqxWscSHIcCezRLPZLXt7Zdcbt/4ApBAkINcHE6xM5qYbWmT63P/18GOSE/ntt+UOxcsOdkbX6180a1MW+4IdzpXGaVqcZjnpIQnpyp/4jUtkOL+2Et5YLub7Ku5Riw1dS6XHTWI6yddgGRxozioGkfoBr2ERmODptNwt3qEDy2FqlyhD86nFi3vG61Vl2dt2fcR1gsgjaXv8bhCaj8IVZauNKrz+88pU3XjZy6BlJ2T6BS0UMhZI7Z87G7qaZMnOq5EltvK3Rj44/7uWcjQpfOQLIuvKNolBI4Al9v0glljHhVDt1Psw/gGxVm+m45tjlpVbVm9vCF/pbVoXZcmR/mmAKH6ttzH2hM2zCc39pKVUh2stYAWH80nHen/9+B5XsIItrvSeo9aaoJLoRd2vt+a0ayNmFy0eQGELLKy8RJWSyK3HAaYpvENMs95i9sBKDx1McWJvCYWLuTgscVppf/mAFfz6sbuu+bbrrnOm2XVa1kwSDiuYT16078qBTDEX8NlqpS+ca8pIJ6IN3PMJIk3iPEQcXJJ+fwhgbj2hGawgkjhXSdA1YAxd12T1OQZUUctsn9gDNRrUjGpgK72+A+1BuMNFTcAeBWM41kY2BvORPe9BW1PhzQoOlz3frfEtGXWeBzzTey3+0EMtbOiiFjZiXgsctS/Q0fl3zHlPuCC4TA/CJ2iIYNXKG8PyuTqo5P2ESqpabJD7jJ/A80JHEU3J8QzVZxwU985R45JPB9qj6WcwxhJQQ/6sFNTC/jNJuA/lrxz8Kwf/SA66C5O722CJOw2W6zw6/ZVpQGzS9VqV7PNki6vgYvpU32VJVS+LWEKzKbB4EmhqNXN4oXLMDIA7upnhHy3ZFMI2sJ0l+pbRtInehjvqZmWaQ8soTnBVEZahSNzlUTzp9sO6F4ym0OkJHdD0e9EUXONnpFHhWWZkLaJWwhbzW8KtEMuEqqllhDkv7zcEZ10gJ+tfoil8CW/g81jNfnVr/00P5SqawqJDZwT9bJX/cgT2ndP9iw6w5JQwqTWqZemVsfpXWG66Cmrlv4f5dA4/wRdw9uV0Af+IXJ/rp8yhT/IotsTGdI0UBRH1FIFGsgSd0fxTUg4cpbuCPIvSJ6cVKe3I+zl0zjZNaCc79J8E/WqviiZwPsYBXA2TJU1vo6lZULd+P45IN6rkoIPFfO7XlIx3VEjMUoIO5/PwiqU5OMSc4zsqG7SHdPG2s34hOWG53KDFfCD+MBA/bs+ecZZens0qwtUdEzhhGVEjYcAsA41kjG4n82BnhYb4YTLpjhA7xSGoBq6q2cJgrgrmGOi9ujgMvVUeI3y2+L8k56RCHVbB7VhVO1/G1LHk70PtqGXpyp0V/m9hv/TlffgQ47j5IAzwQuEhnpYchqpeQM6bdnLgzmydOraaHhUFilLK08IQ+TKaqCKMQn/uG7rRzBljJlnMvBm45DLMo/4QT73+uJIQxc8fB75wSNrB5XtRTceybAmEbAqi3qaKYg9R9zVHDemyeMjAPLzsYeFfZdB+0gN3jh0mQTar0Uv7qtVx/45KFCecbMs7gsJybre/rbthCJCioJXYFwOdwO/ZAkEgwHAs3YsGK+e/Ixx2z48HS9E8QaHm3i+Kou4z358TRcv+Hg1Cxk+wworbtoamxuji1MWwnMG9mZsuIoyzgjJDGQUvMmGYPR5i9smBZmo8eW1GlfbRQZWhjvxB7CnZ0eAhyIdeXysD9q6PXi0Wi33wg7LCKZVNN097jdsBYbq9qHmBXvm2MQ55jm/aYoTl/8LzWm+7+mEYDCA7vUi/S7KnXe+I7I3LRw++3J+eY+EYcnui6IXX0zy4Vg5isG01fdz1VNAswpvN1+4EDU6xedyrLdGrk5OTKF7uYSHJTrZlVX2hffaYy/k3eqNy+BqiCPYVNPtfDvrAksmDNd7SYlAtO2LMBOUbiI7LmlPCIyXsiFNcRA+jPFW/qhJsMYwAX4vCZirwxuP1yGEMD76Q3R8sSk7I42VpLDi6qg3KUpZl49CgKM2Tt/Hkk+vHJ1SPT6gdn1A5Wp+O1Y6xN3ldTyxDO6WYPCwnk38NAELlrcImKQAA",
+	"static/third_party/flatly/bootstrap.min.css":                            "H4sIAAAAAAAC/+y9W4/kuJEw+v79CrkGje6aljSS8p6Jqc/f8QK7C6wX58EPC4z7HDAlZkoe3Swpq6snN//7AW8SL0FJWVU96wO4255OkcFgMCLICN6Cf8yKumo659Lknx5++ulUlV3rn6vqnGNUZ60fV8VPcdv+7xMqsvzbz/+Bumq/DAJ3EwTuMgiyDuVZ/PB4+OnHP/wv50fn/6qqrv2Kujh1nhd+6IefQ5L8b1WBa3TGeyftunr/00/HHo7UQED+VNXfmuycdk4UhJEXBeHS+UtaFah1/m/U/EpA/iOLcdnixLmUCW6cP//7X2iViCRVJau7a1D9v3786acf/+CUVVOgPPsN+3HbEnICP3D+mxQTmJz/ds5Z52fVTz2s8+NPaVfkV8IIjzV636Ky9VrcZKeDV7Reh186r81+wx5K/nZpu30YBB8O3ld8/DXr4NzbsUq+XQvUnLNyH9xQ02Vxjl3UZgl2E9yhLG/dU3aOUd1lVUl+Xhrsnqqqw42bYpSQf85NdandAmWlW6Jnt8UxBW4vRYGab9cka+scfdsf8yr+9YYuSVa5MSqfUevWTXVucNu6z1mCqx4yK/OsxB4tcHjGhCqUeyjPzuX+iFpMchmifVl1n36Jq7Jrqrz98tijKKsSH1JMBLcPbr+kWZLg8ovb4aLOUYcVuBu6HlH8K2lGmey7BpVtjRpcdje0R3GXPWMX7dPqGTfX6tKRygmvjsfmly7rcvzleqyaBDfeseq6qtiH9YuTVF2Hk9vRbbumKs9MbF8ZOccqT27JqWSJbfctx3umr7c05InZb3gf4eIgROOvN7hwgluBml9lYn84nYJDXOVVs/8hCIJbW6A8l3Bsgw+39nJ020stpW5WHw6Uw4JBh7pqMyK0fYNzRFpsZTvB1FX13gv8FS4I8itvtxf4EUnKijPnyD64tc9nKqN9U1Xd45Uw8ZRXX/dMIDemUEIDQ1w4y6B+uaXN1Suq37xj9UIozsrznsgYlx1JOliSe3HXDR5qQpeuusVVgt1fj4lbN9htUVErHamoyqqtUYxdp/95GNgV4uJ2vHRdVbpZWV86t6o7pvMtznHcuaRvoQajK5NEVqa4yTqKof/oOxnDNND3nLXZMceiBobySrsrVcVT1RRMTTkEGQccSsgv3bca//zA0h++uHJig1vcaWnt5Vhk3cOXqxgUUF1j1KAyxnuG5BBfmrZq9nWVlR1ueJW/JFmLjjlOvsiV94lXXijBJ3TJO15ov6ciPFXxpfWyssQNo8VM77XlUKMkIVINbhT0KispGwpvcnviFMe/HqsXvekoyaqHL1dJTfoe+qLXwcuUl+KIm4cv+73gDSXNa+us9GThW+GrS6fCXznhVAEVMWDUxCksBiL3U4bz5DDWAUTBu/oHSMNAP0vxYkJGDjXZWiTBcdUgMnxALaKqS5vU4k6ImoyRbZVnifNDHJC/ff9wolqSkb9YkXHPX0fs3w0ZYHJ8xmUCaU3fEdXeL/qrOQx3RIM5Ji+u8hzVLd6LHweeQQYEXkHidul1qPCPBU4y5NRNVnbXH1mvbVOUVF9pu50/MDcGlZ00SsupsOFRIKoXC8obchEdPjqcsKoHSeypL8IM5S9pg09f9ujU4ebKFWL/4Hx6cFDXNZ9I7qPz8Pgg2zQrNM3m4BTx//Pzw9/QM2rjJqu7/QMv6faZPzwYyB7ICO1S8/73S9VhQCt2u92BeGbescHoVy8riUuyR89Vltw64nj0FpyK0GO+iEfFfOsalxghW3mSV6AX72uWdCn1g2Sm1m4aueniWjV1isp2vzh8zZLqa7tf3FiGhJa2i2PlA7dqnE8yZr9Ez0fUqL6HT+l3usQVv1IJh8e1Rsd07MonP0YN7lw/aar6Uj9JaUKhu6r2QLW7+Tk64hzgO3Ei/PFeoeBh3GegOHG61DWSEqCaJElkNLcfr8CQJg3a+mAoZYGptx/3R3yqGuz+yJXvnfEPzjh1EdaRv5J8bVR7aXZOczLQcAk05yP6FLj07yPzu2UX5IHMYB7ch3/D+TMmjpfzn/iCH9z+2/0/TYZyV/L5h9rDVf2iOHShv4y2q024XIhhJ4oXeBUcQM06nU7MNriKDzK4NTKhsnPDahcpMgE87SZcoh/C7THexQd9lGLuN3OuXbSnTsFEEWlg4/DCKe/SrOSe90GkreoXh9gAR8iGFvGarDwzDghIrzqdWtztvah+0dzSgI4YmkNcZEmS45ufFWevwW1dlS2ZKPhdeimOJcryp6w4S58OYgkxaqpLi3PmXzz5WYeLkRxaSp1CHdSx6yC7GYwcIl8sup1H3KFLu1/XLyy7J6m3Y0u7+tgU5mD2aRyfglN4UGslqPtuQQwcm2agPHf8qHUwarGXlcR5Okxkg5PDKVbEWRMPQxmnaRV8IDMMJl0ySO6jsH7hHoiYxdGk3sUYBlSzxTe/bbyqzL9d+1kUOrZVfunwgdNW95OToaK9F8qezkGbGh3iPKv3DY67T4Hr8P899hTd0pDZKTdduunKTdeun4aun0auny5cP126frpy/XT9LsMM95mWQaApSnhQ5jy3NHToHNRNI/FjIX4sxY+V+LHmP/y+mN+X8/uCfl/S74v6fdk0dPy+Sr+v0+8r9fta/b5af6jXHyr2h5r9oWp/qNsfKvelqfZXeYaiMkiMv8flMY6jGxURlZzPpOeniwlFDAN/RabEocEriVUGswfuyU0EWOVDXBs4INu41YcbVSuqcL5QurVMPyPW0oKlIU9JnIBOrB1dhj4kTh+W7NpswWb1gUtASlzsCG1UIHIqMQNcPkNqtKbtIHRIqSHFQFlyVY3yjXNISl3UL7e6tyxO4HDu+DlxaoExSCq7EZ9c4SKjOy7FjORTkZV8ZNyst/XL45VVILUlovXeOL+MlaPVh1ucdVhepeJTcJ+a5Byf+DIFM4jkm2fR9VI5jybwzBiXxB+TclkKzyZrk9npm5zPkzhAcSHTHbVbsZy6yeiCo+Ly3JCSydfxOEiIomV04sXbSxzjtnc/uGOElEy1OF6Tv7x4Vp4quCzJGSn4FTVlVp7hsjxzpHiCyvOQpZZmeXDh41lnGDXrhr3v2TgU4AhNWMHP47nnJgBE/bobkqDsCKPtacOIpfw1IRbL3TY53pAAsaKKwk1yPFJUguMm0Gmxi8PohiQoK8J4uzkFiCLkMjBh8GYZL3hbFWGYoMk6ikN08+n0kk1nhYMmxoOdNLjul1H94gSO5KfIC9DMQ5HXsi+5W+XyWB3Aw/Qldygo+e8ldyr6eyjJgYObn2dt511KOjQkPalkGNiTYakdRg0yzaUJzHebgBVk0VyPjY1D4ac8g/cJFKSrwbVioxFJuSWj7SecvCWdmyRX2Bm+JR2wkN8P2qw1IwNwkntp1WS/VWWHcocgyyvU0YFTOIrroH45xDlGDUvWx1DDS6QAfSLO86xus/bwNc06TNetCE+/Nqi+6dWrdIdbsu4urwC59HeCOuRVTXbOSpR7LEes96Y4rw+2vQ+nH5uzMusylGdtIZmXXfDhoK1xX+oaNzFq8U1aGRL+MVNOR/KPqPHULeTW36yG/iBUQfPXB/ROvc9R23lxmuWJtCDlXHJLRiVnGH1CAuR7ZFIKM7RSAre56uRO2buZmNRzDhu1irUPvXIg3Zcz+hW6j3+l+5x/DYL/E3y8+QO81+Bn3LQyCr++5Dk3+WqXC+VeyPu6mImJPglPHjmw6UGYlFibLNGlwUBYLPyRkSggEA5/BhILvz+ONY0tqI62jIHYGzaGQoYYadYYChlEUiKiPg5VpY+SkpoMMteHUZKQfWG7Pyz5o5ZOMrHn92dc5pX756pEceX+qSrbKket+/Cn6tJkuHH+E399cPvNQIqrH4uI4V0q4w4ZzYRXsIlWSwytm+xO0WkJjMzmisnt12MyrzaxGGO6RfSPhnpRv8jbCVnZ4s4JHLIQ4QSOvELpR6tHuo2qDk7DaKwOw3zaJw3EI0tKX6smYevne/pfD+U5SyTc4GnkWzRyc9yi7RFopDJoKHtK9A/A2LrBjiLLAFjIVHdxZYHVDfaYyHRaJE9Lqza4+aRYGzdVntOFdbJoxdmyINvdvVH3vu0Z2M0nfQFlZHOUd4GmX9lS/COaoIyxoen7kKQRv2Soi6evqC9gFtjtIrDAbmMpEEZBAJYIQ1ZkyPBO+SVL3q21flN9VRwcL5QWJhigxyDjKvdeWi906a+2EL+KRPzKz+LXS+tFPVzUw0U9XNTDLXq4RQ+36OEWPdyyh1v2cMsebtnDrXq4VQ+36uFWPdy6h1v3cOsebt3DbXq4TQ+36eE2Pdy2h9v2cNsebtvD7Xq4XQ+36+F2PVwYDIwOBk4HA6uDAVYSiiQVSSyDXMJBMOEgmXAQTRhdzWMuRFelpdl5uqVqzEtrSv2lNSX30prcf2lNDko8klhAWyjNGG5S6rACPqSGom+G/pr92Ui5Ac/dLvwF/zPk7vpxYEjb8rT1GkC34ZmrLYBtLTIl6lY8bQkRt+SZC4i2Bc+MJNp6BkC0CT5ApFEPJoyuXLZBoGeFPAtkIgMJOAjISQqy4xCblZax5RkgTynEhkOsrNSvBYRO+4pnLK2kLznEwkr5gkNEOuU9y6yUC85ZCRd8U+huUyIO1vkCIydkORZhEIiAQVhk0abejgGoomhTb8vSLZJoU2/DACyCaFNvzQF0qlcsfWkleskAFlaaFwwg0mkWjLLSzPllJZlzS6KY7YESGShrAwEAEiogoEwEaKCAgsLhoDsFcrMyALYKACguDrlRIEG5cci1Cmm2daUALEeaulQgFyMtXSiQkdlSTQQjLVUlMdJQVQ7Bh1GXUPaEBl9n8GYGf2XwSAafY/AqBr9h8Awk0y9Z9raALBxL1S0cLWa1cBS/1cIROnQLR6i0WjjSGKuFI23WLRzhiNXCEcZZLRzhr27hCPetFo401Wbh2sJq4fosu4XrQewWri0sFq4tpixcW0xZuLawWLi2mLJwbTFl4drCYuFEht3C9XyxWTgBoFu4tuDDsmHh+hyrheshrBauLWAL1xYTFq4tJixcW8AWri0mLFxbTFi4toAtnEi3WrieHRYLJ/I1C9cWkxZOApmycBLolIVriwkL1xZzLVxbzLVwbTFh4dpiroVri7kWri0mLNwAMGXhJP6OW7gB0LBwo4sY8hR/mMQP0/RhIj5MtYfJ9DBdHibEw5RXmtJKM9YigUwcS9VNHC1mNXEUv9XEETp0E0eotJo40hiriSNt1k0c4YjVxBHGWU0c4a9u4gj3rSaONNVm4orEauL6LLuJ60HsJq5ILCauSKZMXJFMmbgisZi4IpkycUUyZeKKxGLiRIbdxPV8sZk4AaCbuCLh47Jh4vocq4nrIawmrkhgE1ckEyauSCZMXJHAJq5IJkxckUyYuCKBTZxIt5q4nh0WEyfyNRNXJJMmTgKZMnES6JSJK5IJE1ckc01ckcw1cUUyYeKKZK6JK5K5Jq5IJkzcADBl4iT+jpu4AXCOiZOW3eXF62F5eliAHpaYh0XkYZl4WAgelnqHxVxprVZais3PkI1jqbqNo8WsNo7it9o4Qodu4wiVVhtHGmO1caTNuo0jHLHaOMI4q40j/NVtHOG+1caRptpsXH622rg+y27jehC7jcvPFhuXn6dsXH6esnH52WLj8vOUjcvPUzYuP1tsnMiw27ieLzYbJwB0G5ef+cBs2Lg+x2rjegirjcvPsI3LzxM2Lj9P2Lj8DNu4/Dxh4/LzhI3Lz7CNE+lWG9ezw2LjRL5m4/LzpI2TQKZsnAQ6ZePy84SNy89zbVx+nmvj8vOEjcvPc21cfp5r4/LzhI0bAKZsnMTfcRs3AJo2rus3zqXbHmOb8LcuBQ4nUywSBuAAIAN6oncMn7rmqb/R9tSRS1ta0qmqOi2pL5iYBROz4HDQY2s/MqHdPeqqeuJGitEI/foSa7J2ei+yIeLxHz4LhPtT1ohDcFLb4yqnFzCn4Gi2mmdFOVpzMrPmZH7NiXR5ch/cZBF+pv+V820Mc9g/V+t1O5bvxVWZ0NAhgL7JmYbmyZmGDoJokzG0yRjaQUNXff/or3debffAdECohUOe2cAhz2wfgDMZwZmM4HyvBgyEqNFA+HgVDZxruyarJfr2ZZcy5ftUJcmjRO4kJHhTeEf+isroYfMBAf/sa4CzIbScJ0y74yr/Jc5R2/748wMZuR++DCcr2g51WXxg/j89zq1e1I6r/FKUN3HrWcPj8vT09fhxnpvjX+LzQC7mkKznDKqh5wzyt2JLrdhSCzaeDJgLIIdjA3J0bKkVW2rFNiJ3UF8GFvELvBaodAaUAmLVUA0KIjhJ8JLejTE0gN81gVRAz5J0QM+SlMCKMLUjTG0IRTqkCECWkB2QZSBM7QhTO8LUfnXHqg3KdR67OswAU2HsCqGBgUSv0Gq7gzQiK08VpA5KuqQLSrqkCDCe1IInBfHQREj4eroQlJ6u4kkteFILntR+tcoq7eG6lV3UUzASgF3IMgxEaLTaxkkISZjf4YKErGdJctazJFFbEaZ2hKkNoUiHxA5kCYkBWQbC1I4wtSNM7VfirFqgXJOzK8IMMBXGrg4aGGi2gi0OjpBGsFt4kEJoOZI+aDmSOtiwpVZsqQUbT4ZUwcwRgjNzdGypFVtqxZbaLzJatUC+3GhXgmkoBcSuAioUSPBysYlWw+J4v3SwWW/o2jjDO4TusK8LhCt2q0w6ps9v3/UpL/zgPg3E2KeyWyokCV26Ks3oQWIGeESNPYRGH1KsR0TLEJ3vqkuc3gzin3yxQqJd0rQAQpMZAMiclgFA5vxsrLpkTnXJnOqSK3TL0VLOmLAG06Ayk+RFAndGyYFz95Yc2HlvyYHHr6Y2eTW1Ssmrco/vTk5LFy/vY3SOXkX5vQUlNr+S1OS1pMoFr8pVyuA2nwAJyVinNQm4vyBUY/LaGrWllX1wG+IKDve5xLW0IXjPsDEaiNiB6rU2aeTX8VhCcbDgGVDIKz3glhZBaPSKPgvKZgl0JFOz0gKB0JvooyEm3ynsGRBm04UjcooQJ0t6t7Bnp1iv/uvuMBHc85Tl+OGLKimt/vJsQEiy5BH5fikueZfV5EY7TyAS/GKNzsnqZaHFoLCiQM7Q6u8Wkay6dCQgKngdkzFVuwk/NyTczSeX7z0eQtneMTia5aJ+Ua6BOuHqdRWPhBYbMrICnXlUCCCIYIyXOB4JOAbcdSXFyf+Vy67BZvV4uAsYCGk2hEusGjlwmeOHq9YdsBt5h/dAooqRq6GMrWd7H8L6VWxyyXXfrchZLt115G5J5vrx8E54tKawcMF1jmKcVnkyBLJB8XEdB4eKBGftvu1DrRxxu2kntZc1aupD/95RTAqOrKY3GCUkLNwXV1ipAdRRex0PqFFWnYfyvPqKE/tt56G9Ij6jius1UYdpiBYZOEEdGVrlfkx6Pm85C6arT5JINh0jXV8MiHrgwuGypRzojAUOA8KG6Rcyo6Cvw6G2cqjJgWznAYjOpgW45tggu8ayeNgZGKKv3RKVugeAsAxwciAY5c6y1ODPOm8/D1yW+OgNYuB1GkTMCJ8TmdIIDmAAzNks5pV/Hqfts0EsFLCHH/aoQX9E6o8wt+UOS8sYCbxuOV0Uh9JMcKDDQ/ozASYpEgDJ9cGaI1gMAQjMo5lCAubgdPPZCNkWYrRZyG7Bun5xdqpTsDCcgpUZm4I7bDByuTRJGEY/Ae7q/p6BiMcFpan5WaSul7JLQ2xStFGp3xnUG6E11jr5Gn65+Hppkp+fbeTnZ5X8FLXeCeOEGAjzQruar5kZLSLWgi5rjZUAKupDm9LzD+v65cAnoAfIdaTuouw66hblAMQBJPTwfR3HT3FesyHKVTMEidwQKHl8qFThhc4DoP1wBJboO4J6hEOBVfis+l3cq/3uTukIRaA/yAIBvt4LJN2cxQx8bUmNYqbv1LnwUJJU5RUKOexNhb4Rm5RWbgxaDUiUby6YitdnAIrX50mKN8AriqeCKopnlBhRvB72H0bxQIr+oRWvp/idFE/slFm5Map4uGmqxlQ7ngwoHc+RVE7AKgongynqpkGPKBuH/IdRNYCef2hF4/S+k5qJrTgLJ6xKpgCxY1TmftGgfkbQ+d4PX8FTNV7darfBKBqJLUDp4PMheT45Y93TMgm5ATj1BS0FKyOIRpOag1AS3JOKXb7QohRR+uv1Na3g/VrFqs+rIV5BsU0DPQ7jbArgiS9I1fjs1gjtGmjVzXFDadtuvKAUzVQfH418mZtKuX6shIv0I6a15NhE1eC+WKm93U/hVVo9odHJTVBzcWYi9BmMQRsjDNonurZVLGZEcICC2VpwkCNyHbty3vgBLFcYMxDLSoe6rDF3lVoODAjEfnztUrp90fvS4sZjc0lGEl0/BVJbM1FPoIzlGx70Jz9/OaT4csp32PegtfLzIj0tmt0EH5WRyJUJvQ7L37AAR5yFRf3irHSTH0awC2MFppT5Yr2F0ja+ckSVG1gh5oro4Wdcdi2jXiwOB/56dThlOY0Nm9cp+sRzfl5Luxfa02L6U2OUUo+/6nedckt2K7RCa82JYYkKIkmafdKgT32SJDqR1p+grmpcOvTdq6T6Sobc8znHdxG7Oe2i3UIjdrPc7rbo9o5kgCqmVKAqgkidVggB6YLIAB4P5XnmBGIAxYDelJeEnmVOoTdRDOgBpkv4ee5UBZDkBKg/WoM/rwYxory+Kzj+ESVnfFUg7G900aLmmw1jzzYcoC1ABZEsZ5EkyUYkyQzjaTP6wVxiQxyhhc6wcB2eot3tHckY6Y4CmdodeeqM7sghXRAZwOPZ3dEuJrA7mujHu6Nd5HB3NPFPdJYxyYHd0ajBn1eDvTvO7Qpad5x6Mo8WBR+NsS5K6hrOVyolRLKcRZIkG5EkM4ynzegHs4ld7LYbrBMbbtfr0+0dyRjpjgKZ2h156ozu2F+ygZABPJ7dHe1iArujiX68O9pFDndHE/9EZxmTHNgdjRr8eTXYu+PcrqB1R1FstDuajzAdbPdaNALEZReBRZYw/ZZEQr9lJpGEGYo/i7posV3ES320CjabY3R7e+0jfY6iUTscSZrR29h9JgOHzsLZnQziP9i9NKzjfQuSItyrNLQTCg/LA+xMKmJ/BmJ7N5qlxVofEmVG+xD4Hpl1u0MjQOyBSIhkSYokSQwiSeYTT5uh1HOJTaLtKtC5dcSbbYBu70jGSPcSyNQexlNndLL+DhmEDODx7N5mFxPY50z0493OLnK485n4J7rJmOTAXmjU4M+rwd4X53YFrTuKYqPdEXrfz7orpJEgtooGPMq0nqXIM3GWosyOadKcNY95dCanCId6R4yTCIW723vRMLbswlBpqy40cc6iC7+6B2AyGTt/xcUiGXi9Rcc9sdxikbFlsUVHPrUSYpUVvNKiofdnobd3u5k6r/U6UWq01+VZ+avmeU6edDQf/hGY3P6XwiuSMEPrKC2jLw7dtaCrESSpHf2WVEUiWN1Zl+q+TWCa+5r7eDQngF0TnQqiaHavMclnT+3ZtxrIAT76L9vez89P5Ov6HscKKdq2kNG3hYr+1ScuKc6XVsb90mqks42MV+KGjhKY163ErrR6QDGQUHyWsKlHEdRD7pdjkXUPXwZo9QoPbrE993jpuqqUs9X9fZTga7/BAl0B4ZlOQG5kOIRDqDlM5DO8flZepYsMcZXnqG6Ho9pMz0QygVbvRYks4oOajxxxMZlvzANtYLBO4C9W7P39w1jm7Y/8Tb0YK6/rffzX/FudZnFVts6/ofxE7i63Hw9tE+8vTf7po+//RMDbn849nJcKOK/B50uOGh9X3cfHVxT63z9k+JS9fHx0yP4x6j59xMURJwlOPOI7EGl/fHTvwPm1Op0kbOzzHgRdJ5fvmgu+n4j2+fzDAPD/9gA8X8LfPp8/Pt78HhhQCX4P7wBums+QpPkK4+RL+MO+LylaVBXZJT7vUUlfh0UtTthWddW+6DDnBn1rY5RjqVEeajvcZO2v+mOaD3+N0IMMWOeXFgA6KkD40lQAUIBiBazIShBZFEYKXJxXlwSAWwehWm35jPOqxgDoJtipzcBlnOUg4EkBPOeoBWjEgVZ3cWmzGIRT28JuDoGACwUwxajpQLiVirBDDQi2NsA8XNTdNxB4owCTowsg2FYBO2V5AYKpvO5SL0fNGUOgYaCBgkChgS9rQd5oilP9CgKpjG5wUT3DxC0VwN+qihywAiFXJmR1gUlU5VKdTiCUKpA2O5coBwFVkcTVGYTSJNKgFuR0pIojrQqQMVGo6wEMpkqjyyzYNHlUKAHBVGmQ6WpeocRDOcjnaAWCg6CqSC61FVCVSlYeqxcQbquNmeibF2dNbGHTTtPHGiOwSYtAAzw1GJbjQhUQ6S42Pi1UIRGbBYKpQjrlCFS0xVIfxJI6rUoMDqELVUTPVX4psK1HLNYQMBErCL2BoC81CKtK6+9NXCWgoBaqoI7ICrnUhjWYWctQhwLZtFQldKzgYW25MMAK1MCgqpTqJitB3ViqAopRgRsEAqrCIR4HCLbRSMzBbrZUBZJ1KIdt61Ib1siEkrtIAPQqMKHZnAQCVmVDDy+ymRUEHAHA7PwjCL4AwBsr2UsA+m+XtstOoC1frYy+D4KttbEswWVnb+EGgrbTrDkKKMZk9PeeswRXYAHNPcvi7tKAXWutSrFAtUfUHOb0WhNMQvgGAi40UwUr8FqVBU4yGExz0VJkaYsqA3o6GYRTuW/zV9Yq19sO1x5ZCfqKGrCfrXealNpuFH4TaOPfCGhoWEAQTJVPjS4t2LLNQmtZBY7km6U2DDVW+lZm08fA1yZnx8BVeeG/4RjUk81Wl/9zU9mHmc0OBLf2wm1gTN2oJwnChubUzA4cAR60HXqhOeV2SFV+f7/glsy07fArbVQ6VXZYTYRxg3HZphXMuQ3UQLsLt93qTRyB1b2IcgR4p4oQNU311aofuxAAtmrHLgKgYQ9ptwBAba7XbmkOfjbnc7fS+EzWRL3TJQfnOrs1BN0WyAKu9cKXOEcFGlOoUJvUnzOQ0aE2p88xOoFgms+cgVYgDDSj8g3TlTUQdGWAxnnVwmhVbvFdU3vTN/qIXcJotTEL5bhMwCWIUFsHaFCZVNCCQaitAsRVUWDQAIfaUkCBziWGASNwrAT1OwwXILBFw0NtXaDB3VdsoUJ3BKq6JkKI4bWdMNT96JzuE9lEHG4gcJvyhFuo+4j7OWCJHVRiuGEEldGXEJIEhFLleLzkeVo1INnaKsIR53C9mgxJs05ZjDqQc9piQpdeimNr0Y5oBcHalENbTEhRmVjH4DDamMCW0T2MtiasheCdCWkjV1tTYJZownSEi9BeyEb+IrKXgZuxWNhLWJuz1FZvqyMof23p4WuDS3BVNtSWHTrU/tqCcBt9SQye/IXaasOxyfApRnD/1hYciF1kfgsErK05JKhNjxXsoIbaykONatzEeQaKYRmZ69LWleRQW4Wgm78QmLYCkVawtdFWIMgLVnUGN19bxGvhhqvcPx/hJqt8byt4tF4FBph3/ObRO2JH2CCswtEiFj8pXEVgMXY/EoJf2OGtdSxh0rquyY6XDlzCC1eriULW2jRxlXTyi0GhrXRHrkYlDKgvhrMtXutosdrB8PB4pK085NUZ3g0I16G+Vgqu0obryEAIbxqE2vJEib96X7OSHEWBgHX3JK7gUUBfpkDgskKorVLY3Iv1xsAG16qt7hW1pXtpyxP4xQaorUu0GNaOjS6Wqq6/eQm464nDTQRBW1u1WUDg1r2lcLOE4G0i2KwgaJsktNWKuMFJ1hGfE6Z8o+8Nle0JHlb09YpLl+MGNAObnbEPAKPcGq5/3eC2hZmsLVJg1FgNh7ZEQeFsY5G2QNFVXy20LvUt1Q4cFLVliTaxrnuG2qpEOgaq9a/Lscu6HB6WtfWIlp4EazvcWFDvAPCkyo+gbHcBAL3yQhA2BGDXFtgIgN1YYDXfsKq/UW/Vs2x5hDt9UDxnbccOntnLrMxjCGMbieFuAxSwbieGu63W8zB9NC6z9L7dzgRPcJwllwo6RoGjgAgqRg3uxiK3BMORJuV1yvrFErtBerNvKUIzHJQA6kswYoMcxx6EuPXnkKFYfNoZZS1KQiABFLi8WILs0YNy/IDcb3Qb44WkBQf5cNhBDiE6PAS9lmKo0ggMgmH7iMflpvus4iCPCJk6hJ+wB2k2wjHH9I+ZoUZAWD1yiImAzSyGUhgZIRS0kFHjcBL1ZKrAOUHq0Xjv07d+qSqI934Zz1nQRQXW8ZOMDHmNCMw4xLLd73zGZf2knclHvCJ/NdRPefaE4Cjf5EylQwOzxjkmi1dVl04eujJib2+OW7Q9HqCXM0xCxPlZIIdpMnQc9jDvZrpen3iFDrm2DJiaIXtuaJADGAJknDhxPhggb8iyECgBAEeKb2/HBzZy9Lw4HPqELwDUTXXOkv2//Ne/k6y/kGLkYKH/5yxuqrY6df6ZdF1cdp9wSSn5+YTyFj8eoEixxOF70sY57ewqBUHWYZH3yr43HvrTwSoYAbjycbKRAqb2UMyjm+pdo+ec9e5kyJBwlnwMY/kpe8HJQaErOPTxiFgoIzGs73bBTRqJdL5ZBqZL7TCT6folej6ixqN18qBHw00ZDqU8cKu9SzEYx94oPwyVKNRMVqbSTmqj0utDxpnvLI3GluK1UR7oyAHdGAG3acptOAUvH4gXHgVwihZ0USyh1XqE9Hg9VAE/d2/UMph2HYt8wQHAZWQ/afGcwEJ69pMeSgksZeQ/SVGXrMV4/lV0gOj2GmL7oWO4jkFh6WWOzxq7h0RD1M7wEywlZYFh1sSlh66q8iNq1NyVluvoJPTpUrjBqy57DiTpkJwCoHtS0MkU9QRJ3C6r7pP8kNMjS8mRmqA7to9X8OqTLMYB51ULAmiHvLNyNoywLt9TIrn+Wo5R81CRyQdFsXW33oDWKCItBQlSMnR6LBqgA8yQGRtYJkTEsUmIdK64M0porHkn0fCaBlJMpXojx437pOaABt87ld0WlejPBqhy22k7zMz4FHRrdkgvP0+gCSMDTxgpiCx0/z6B7sZIGC433nN/UXgxwCiSnzUXh3kQK06h5uz0b4L1/s0IjsDhWG42U2S1UXaP4kmTtuaaSnFTlbcpX7zh8zYH7YDICv55tAmfZ7fj8wxWAAaUPl0yTKNN26CZ/ekxb2S403CNGZyrbYgiqxZzxjZoPLKTMmaBRkY4iRhzLJ0z+kFSege7AqIdY/eIxXkFrtfYorvkZZglk2dWQ/VKQbFD1BkenjCkLyfKAwRN8HL0jWzMsdnncHmbbhbuW1yjBnUYxGyMZmqO4pbQwUmhxItxngtyPtwm0LDgptJw9kuCOsQFJm7ktg9faJEnKBT17AJSQGr1Vq3sH9uneIzNdkZKWH6Jc9S2P/78EFe5p8a/nrrvLGHR324xKOvnTPLar/URYxU5vaAu43eNXCNa/TgIYTcV5nd9VcdCvAXGbMIMQLAhU8/3WOiyQpmUzQIVtNneChplzwi0jVEzi+gsk99ZGgIW2NWN5E6oW1uMV/kdHqCyUG6BmaVrs1ox9dKVhS4r1Exds9E2rgoW9oxA36lrbXGvrgHac+zUBMsbEYMBAzDO8IeMSu8to9L1Ki93mhPC3H6A4tFbFi8NrNfJwPWT8QDUMKtmFH7rC5jzX4IFCB8ehZsYLczRwYosP08HejENm4kOfmtjHMx4cZmt64+4EuoT76aeW7M1T5b7iVMQyqL3KLDmr5vQ+vrPvOXBSTzyfMc2u7l7AWuUr8bb7XZhyZMhE6ctF2jlTDbMgJ2Uk7GGCCynzlMby4Tqlat9Y/y7ykc9NFjLtkzfwQNwPx20WsAhEQiOLpEAuwsQrNjlAfP49gmYZ+69vFdvv2qPzQCkv1I9AZ6U6Pk68dqRdsiFlnnKs5HpHt+aZnBPaArSfMdlKCvk03/fs2vPbZ7AJm3+68cIDAC13oljCIf7DxFAu/0lenb4fr4rf0i09EmMigkrr8eHpghK9Oy95xEcIZmnrDhfh/XVXlW8Dh1bdcyRvQ9JRBSSaJb5ojMv1+usAH1Cam+Jph79sTwuZDg/zpId8dJqY6LQX8qjTXC0f5WS0gEdKFWSsJmnKNzk8/+Ge6dqBB/0rQrJH9cYiKc/hoUzaYlEFWlgKzIIVFUJA+oJXU0/VpX/qn6xIpg+PDGc/Bg9KGFpw+hC3Vij9KWkWdDDWRfDK7e131QxG4SublY4+V1IoNO+go1PaGoomNMXf+emq71GhDpl5NRZnmuDlpoxtFgXooD4nGdX7SSuCqC1z0iWG2VmQkf77Gf3CI62Q+RqDtRzhyyJavqatbn/ZBs5btMDxGvHhe8xHNw3Cszu/BJrrCPp6weH8d4xp2d8nwHhOwwGv0NTbQNAh44eP+v3RD9qVOpxRxUYPmswHWVKh66q+v7ua3a/2FE+wAWXXsSkB91VTY2G+mCXafKwITDqTZYRp0ylsVS0oN82oj6mWOFdEsqFr+q97J+zNiNbTdqZjpV0zIMd/Fgp7LR6heDLxQE7rhGtVq74f+CHw8uAPT1t3FR5TmY2XXWJU6MpNHCtAP42YyxS+CC9w6sdStXOfei1GgFyqRo6f2A381DZHaTlYTl5uAHAZ4r9LIHzXQKeaiwvcVMPwnZV7egF+7Oy7EnVcRj1PK3OsokdxBt9chVlJW6eVI10hxzvlF+yxJ7/ZNJmKyuptP687MF4g3ZEM/4RqA40638zpXZVLuBoJ4Ro15rWfgmbvnpvqhKoFfrhcuVcuHxJaBFMkzNR1TSJV+UgucoMC0re9/RVGwVFKBlJguLYoDKR59X9kgub8Icrc9FdnkdTcyBZCxWxZE+HNPtCzSRbnwbdcxSkrgnAlFMFMx9wHjgvjgFaDopTbVCfe5atx35HmCWZS2o8Fuab7gvo+tf0LZL7Fim0RoHnuRUIxxeBLMGo8lE0SDkytl3C+sWK7vOAWGLMck6P5gJRnKa+nmF5cr/zN0RTvX59kOfzJUb5nW3p3p7yyL6u0QNx/bLVZr2RieuX3BzbbUM2IJnHDaldVp41H1cHJni79bZRQpvvTkE5xkUe+V5jKA55Rhpv7TVeDV7OKqaNFOOwxhrn0FFmuJJEdYyFxH2gqY4+bbcrFR0oDbWiqXJJ5e6MvEUCPCE/3QhCl0Qi5F9RhFdp9JKBeiiyLTbrXXvgUfUZfjI8iZt4ZWWGT+2O5h7eFZnUWbf+yhjSadoMj6BqCr4LyfZcZr2sbzk5YOLUT10A97vpyDMHobRnpJ5D0ddqhiIcwMvREefX17SC7sy7Gla+DT/KKzaITuxVzaYAPi0AUmU7MSAN+ebC14AmRa13wjgh45gqxD6ZOYG3aXNkKJe5DDZPQ83Jo+7AHzTvXh4LA3MmOPsSgkTEXUOlNjjbV0qCNyyTwNNHuMrXH4jmaKVN6vExRyrAnzO6Kt4nUDBcgCVfWqXkziy3k0oR312Fh2razRsXKTJzY29YjTF8boWOeboizbD4RtbMF62VoBQqBm1qoT13NgarOTswDPTO1/hrXhZ0lMEcz4b+sYL2Ds68xgB78aNg79YkglFd0p2EmkHjvTsjIYqW0Th3lBAH8+DmEGo7dSCChbxKTZTrHPOayMpMUKzMQe/mojE/tb8waMFgLAXqANTu3d3rqSCkQxkTMHPEOnGEg3FKjQQy7RoANY3M3mZ3/NlTufvKvucQAVcza9yYKPraJr7/CGOpZ96wM1n41e18ywBlba78euiEgg7vVF7No04EICufcdNCz58KpZsaDziGWV4ACKsxF4aZdcZnDnmyFzBB3YQXYAOfaA/sBbylSbAXMAo1g8a7++gKrba7cSKB7jgBN4fQ9/ACDDUBvYBoe9qsJ8pMUDzlBcyp4h4vQMdgeAE6gOkF/BAud/E2Ghet5gGMwMwR6ZQHQHXtXg8AqMk2bGtrwXcPgiPLzPxk6Wt7+t0+y104pmUz12d544A212e5v+hrm/j+4+F8n+U1hV/dznfxWfTqRnwWCFT1WcTRu2ODURI3l+LY785sxRo8cDpHDz45dRhc3jccqpIPtcnLrhrM5zwzIo7+9NcgQMFDv2NAw3wcFHYqWMTpJw6xW6EVWt/8Gp2zku4Ow+u/2hKvCMEZykFJ5LYN+My2ablEB9WEtkbjUcSA/RHLgfNXxXdU3FLLVop5i0Npg3KtBLkjmbSx6qrv7DgZWpZOQ47sJORIpWBkJXUsOIdZ6zAqmEKFc5C44wMUmDcOBqfNdr0cmUdImKXxHUgllYIZUKvkQiPZUPuUoqyRQzSENzbXuEYg19uPvGZLlSyoPSoA0CjZrMDpo2g1YyBGTqP9iyNex9PtV+71DBWSy//6qMPT2MAzcRHWxGXv7EA+0Nvkbry29/A1WLetk5vZU/18PdLP9brbwuQhS1N4CN9MNjGNcNDMn+Dgws5BuG4rB43sKQ4uRjgo6h6OfcDGVPcojGP3HIsD21WawdvBflOix4w6O36yrF9eaQq1E0qrvqGOZg1Eyl3XB9lgJzD6JX7phuaxT9pC6fBFD1w3+DmrLq1UoE+SCrGDJxxAG8PUJLUxxshlZtBaZvob0LDF9vhV6fVy8yNcOP6a/GeBC6mTbVYflOgFxypP4NgFcpRp5W7HdGSFI2oxJUeVvx+tcMEJ/yVt8OmLYJqcNDvUNWdKXWVM9ymSPX0JSTvjTyPrMX6ZvqM4vs8RjOxMCo9YgQMaomXZlhE2u22yPQl0dZMVqPl2tV/9UeCgatWsqZ0eVqa9xDFuW+sKrAYHVatmTS0tsTJZeaoAoMVyt02OMhBUoZRuqy0KN8mxR8TfWgTgTotdHEYaHFSnmmWrNt5uTgHqNQSVZ2it5Qe8WcaLWAUD9UjOsdWZrKM4RDf/iJIzthzRGR5NkN9MINEtN2ZwEGN4UPuiOljYej4wSMyJgqJu/gu7QU800/bZuzdr/lj3ZicsBKRyUIaaL2KdEK+GiUF8vXZIQj412ezaf9bhQvj2DK98I1DkIJ4380KxfpdRlJZnjdS9+NulOFZdIwW3WZi3imgSw5+VKW6ybuTSfo/RSUNX+vLT8KrgkEH1I1HawfZI178oCG7yafOhGUDMGYmGvshVCynKl2nZs30OKhPoTIzBLHZcemseMF3SuLYggYorJ79Vwg/DsHP6Y1wc+LLe+OyUFX3sskRZbon0v7StRcF37l97TR143oS6fazvoTx3/Kh1MGqxl5VedekO49lSw0icAnf4dETggkGf5aPbUoR7NBTqu++QwpwwKUWsdsGhGIb6/RjVdPFLumJw0CwzynHTDbPD1f3X9aCFMorVSZfq6TmtazEg9g9b5tSHbw7zVLv8x8U4GdqDfFaC1q56Krwka4uspZ7rVVXjBQzl+PSVXNuATK4vsPIe5Q3UqknfBAyjoW3LqKictJFjlGpr5SqozFMOiNfkrwAc9WE02ngiSBvBAxMWrbZxEipwk1RN+ToaYTwRJIyjgmnDwRYHRx10krwJn0ijjieC1DFMFuKWi0200iDttP1RDGK/4m+nBhW4deqmOje4bT12q63JatxeT01VyKT3yk3uuTrBravAXHLT6/bH74jbFxivelwY+X4YNCTN3pOYuiBgvlH1eJgPevNlnlyNmK/BB9ESGtpkJPalNJrYZ7BWv3OkkZ50N0F98Gs2KGAqafOcwF8zc3iwZ0ksYiqTOCrTjIs4ojrCHtR4/cNGy1WCzy54y2L16ESrD65smcyEVfDBWnosb6Mj0hMezVt3//+knaom7bdL6uQJOXGfQxOckBMqs4LNJaABwolah3HDycpTVmYdPtxdQu1nMyb/4zoHIfin7v3+tGtinVhcmZCpXvqfAv0fF+j0+tWETAEE/xTr/7hYJ9cHJ6Rqlv+nUP8nhEqXlFz2j3eskm+GG/5bRda6VEiH/aPc4KJzaZoMPSrGgx+zeqrj33Dc6aGKWF6KEZmhX8WlaidwBsxP7BFGenVZvdQV6DA0XVl7kWC8PGu761Qw0mEBVFt0iAI96E9w05dL749MCgSkvH+Vy6Bj1hsnUwdwdJzmJTk9VsjEiRv4EJBRkVgSVnZBYZjP6uoxUwu2LK5LhnNyRf+Y+Y6e0OukcEboH7Ngv3RniOAVgWVt6++uLcNWu8i+9zgOdJeRJ1qJs7JugupXlKPNmRKVWJSbTy8R0t3EjheyUCpfp9gitDxiQ7X7Ocq8rfYbmofADvdqfgoENiXs8+86AWxFY+0LGsAUOWrfGKiaGnf1jSwdPZ2HTDWSz2nQjNIWoNeLi5S2ModmzgztyVZ5YRx2KUm5o1R8L/mIOcVU8/gUBc1DYId7vaA4AiuXRP48cfGFbysau8RUgClyvpfc+KxhsplsDoJmlbeCvV5orLyVSTx7psjYdoANi11iSv4ELd9LXqozD8ZZWQFunxIeovcstTfJyNG20ogUE93nNY9uY0JL6mwbINS3AQJ1Nd0OxalmUy1511VkCJZZYyWNhkIaOaY5cXpUqhsMcMyv9Wmbnaxcl3U5vo4G05G2PTbmnqmEpr+NpWWeqqrDDcSWiX0gNbYUHLPexiv4HC4n6ck+QQwAEGM4uZphFQ/Gs1EGGnk+58yc7I0ErpmlGEr9ObJXbz7bMmdeOJfhQj8/j3HVNt/tHxXmLaJRtl3ly2twW1dlS0850RSrWGmu+iKTBZP6EotR7vpGwRgInzrCJTWlkT+dLnkPwt9Wj1qODIa/B8VvqefOtqe/E4/Td+Nx+jvxOLWp//uquPwC13fUcFs1dyvee9D7hmruVrvfhb/pe/E3/X34mwIW0HZlRmrLHNrkV7f0Utd38WZ0rLy9ckIjfc0elccof0sdSqlTVXXfm9TX13FPi9Pfgavp+3A1/R24CpqL99fkt3bBN1Rxn469ndBXV3Gfhn13jqbvwtH0+3M0nT9U9yo8rBJ81qYqRo5E53Vszqt2jznWzL23hDrpVCv0WBZOpiZdPSBHZkXEHIinrnmaORK9EsEAThjwpvpmIRjAiWK9qb5ZCAB2JG/lZ3IfP5O38jO5j5/JW/mZQGZJHAWY1tYZY87ryoOq9qra5pQHFe1Vtc0pD6rZ2ziZ3MXJ5I2cTO7iZPJGTiaA2ekfth5nrDrIv4Kz4wgM1ry+vlkIJshL39q+9L72pW9tX2o+6zdRf47s3JlT/Vh5Q/VeXduc8uO0pW9sW3pX29I3tm1KjoZjZ26s8BLwMbWwflHyHXDLDIh0pb/RDGH5rCLrL92ZkP2eFtxaEJZj74MuOvK+2QwvV0XKN48A5xSCm1O3/bVpBttHYgCec9ZgnjQuTd2eBt/hnoN5Jk9nobyXUzDSPnAEdMhMhbFw6VXH10Yx38mlUZSv5ZKKtL+pAl73VWDu5dLIZdRxzHdyaRTla7mkImV3P4CbqzLAvfwZuRA7gvZO5tjxvZYzKsb+BgVwe1aDuZc/I/dyxzHfyaJRlK/lkopU3EgALvGqIPfyaOR28Cjie4frEYyvHq05zq84z6/Sq77yAXjorReaP2G53nIKR76SO3UWZwqWtc6hh/L/fqk6PaxCkiSq7PS7sQyBlw9ndqKl8cIhDfJB4dpCCceggtElQBZ9QH40UooyEvkrKM4NGAYroH/YJWL55aSqRnHWfdsHfnQ4ZXmHmz3K6xR94hk/R8EjJ0ME9WEfyvkwGft4MBmpwhVc4Sp4vB0vXVeVvPWCRYGOatAq+JFDoS2orjFqUBmL2xxFlaDcq2pcXg3vluYpkXkGD5iG7JBeF2YvLx+0p17ZKZvh9XRxZk1/+VW6qG17y/kgXtkMOGH+CSXY4Q1IMpRX56tyH/tUNQXjRo46/ClwHS9afXg8eEU7DjBe2rzybdTq+AseDKW6dAevqH5TwPtvALZSICsbnAQEQwgmZeV9LApG+RNYmUO6hlKPedHHeKZT3D2ixXiQY6DcHbd9dvSPmaEMTtGjOQrBhxoXLKKlWlw/02gFksjOs3o/xBl6OagvxjIGEPikqerrK/vQEpoVsdFIr4J2nWs//MCDT/BoFMvK66wxi5fTHj4dPa75A16Rv/Ij+eG6j2tUv6g4RSwa+b3+aACST14aR2QFzr519MypoXW91ZJ0lE+Ytft9isl3ooD/R45SweyVZYJOG65W4fjHrvzsSw/WUXGb1+ECoJyYvIMoWOA0sww19J+Hn1f1hUX7a3NKp2dZ62AIREYjkDk0nJPW0cEe1z+OO9rlbFCiilY8t7gIAviFxt0ukqjPBeU7Bu93VZV3mdQV0bGt8kuHldfKtauL9IH9LCe9g/7M8VhYk9BfHmb0QE6J2vV2MPxOLtBVSuQnbyEp6orGn+lBzUuhMjC/aSqA+avoEuoRzPKVVKZ+dsxeVqrR3aJAD224nREJZt59QkVxdo9QsC5BFmqa6iugCYzIQISxsb9vKDLYJVra6xUxOVpV6hC/Cj6o8eelUZSRIN6SVo4zg81UqqXoJup+r6rYM8m2uvpbqW+vDKyILsIGHw7qO6sk1c5MpWKKdapqiJ2iZmG9J6sOROUHafN0qmbxeqpZ92wdMupVZrwTNXu2ptvU6G21WWVs1aQ766urmr4WYvZ5qVGy6xUOpoC/TtyPYZu17CCoXgHBM+LdjviPpucrHlN5J8+XWtigfgHKz4FSY8U2Bcp7rhqGiU0CRK4tQIHIN82Pmm9aHCVfdw2VZ1+WauRcfUWBNUQ14lv4XtWG/B3xdY/kryaLftRxgoHcflY0rI1QOnuIJ5/qv6t979GpAzVYdVneZsPUKrWLO6FJJCdKhWMBavkDNw+KmgjE8BgWhkZ/Fs0xlwGViaHNkETUz+RhJmT6JWJ4G3qKnYfDIN+DoXizCRR3BZV+IBigGDAvlGpiPSjU7IVWjWy+NE5YLVu0eoSJARjAuimlgTMvuIMgveUMyXzZS9eYwOFda7F96Cfi7/lpoQdoPZ/O2WU/SaDOAYJCF714mn1M9oytWlWSA6FxwuZaKKKXaAHazqi6h4R+6WbQlJsfo6a6tFAs/yGPTw8MCH1T/iCFhtYKP/n0gqFiqk18wAKfv1ZiGzvUcE/kw5WzWMhgDguLrFgXEwe/12yklyTWhpFaN/hZjycEY7yKQ3p3ILa5R2MCIOiu3KxD+RSx6EI2BFQpYaK4/2BrDWsscxLGauFwEjINjnSCpsqnXMR+isN5svowa9Ff3tkI5s55lUvWRkTW9aNJPePD7GBjlOsOpcVru6r+pK2+OMGjPTsIgvDRIVx8nBEbrKscynvXMepwHRteGW2Da4y6PfvHexGcrpvqnCX7f/mvfyfV/kUsZft/zuKmaqtT5/cktB1quj+R1rRd8/PHH7ZsKTX46Dq4TKSMYMj4V174L99q/HMIcVzSKLoQ3i/rvo8QGDfG5LB6sxB4Ha4D4v2+QghsQtjeIYR+805PZ/t48tr8YcYLEfOW5fTK+JO7ZMxybZna2NtnnvNvdUoh4hQ/NxVzOuZBMgWEhy3iZ4hJ7eoAv1F5ZztgUnuv7vb2prMG8Yl/8OFuCvvqrmLVsX45yBv6+lwVcPTocH1CRZZ/27e4yU6jVOjPen78axQsdh9HeQGWQR8Vy5VkMeqqpgWkK6YohNjeo+7XL1YHsW6vudmL4MNhPBwg9GIZQJJjefP0IE0C+3Dgw95Bv15CCC27vbfb7aBnF7TwK9IjM/Z9cmmHzPnrbnyF+BFulPCd+tUE3ppIak00EnhFlrh4ksIQHfM+VmIGQH7Jx0mGRaiD/LKJEotRhn93V4IRTjeRrvqRinlvs3yHAc596yiwkDRyYYwCK2MUUB+9WSizml64FDbqJ3NR8EEXkVZQ6tOSDG/kEApGzSl74cOCOyTQOZo7vF8zQPQpOoh3yi9ZYgLydA5OJoACpJ8Muj6x3F5aNdlvpFjusAQWE0WAj8FwNMeO8LbKj2igWE6TwGg5T7xS9TSkKQXtUBxViZ77AuT3kCzTwD+VTL4HrcGIVBVUHCrTgft0Dk6fEuyB2FefJU6jSfl9EgeSN3R7MCXRnLyLAZmeqr+NqJBFYSTFmKEMgKBnCVUSlyoiUCYW7itcNvkKMZHzizBlf6y69OazEZJvj6vrqDMeM5Lj70qH2Jw/ZEVdNR0qOw5CHaThvQwFIM0SrKxfKLltWn1VCVOys5JvS1+lnWpx1osO3RQ9Gcb2wU+Bgw7mMrBxaG7s9fbDcFOVVWMj/WAQpBCOTqfsRTsWc/sjOab0nOGvBIyP2gl+zmLMzMvN5631Xlq3/90Ww+8iGX7nZytb+8MD/abOZr2hhmuowM512rkgSNbrusaa5zXVVwVTKreoS+wFY5zncknr6Q1ukfuG7Xah0rC2mNswCdJoWFvMblhbyA1ri/sbxg526A0Lw91OaVmRzG2ZBGm0rEhmt6xI5JYVyf0tC+l5iMcrpLMTbZAgjTbk59ltyM9yG/Lz7DYYPYd1cllz9V53t85ylG1xD8oJbeE4i+QenL2ceOmRoWUYo+omK7upIYgBWcpMqIAKbGgBkD2iCBRa1gWouE0deCs4d8YbfuOWXN0zDESyuH4lXgAFJ1r60368aFY+46bFs4qKKzW86LFBZSI9He/359GiwF/xh0n3fHIIbK8zw6l+3waXx69qDMSYnI2I2mh+5cpVvtjK11VjCIXgl49c5UuFF1ygEOwmhSt/qNDikgQF4FdTXOVLhRfXTvh8/1S5w08Vkl/joVrmINfnP4xI8pcywQ19K51B+EMb1W/+ORCpfvPPvsXKJ//iBA8f0v0XrfqBZClFrVICGVKUeiWIPkGqXcpmnwY5ctwE1zeTpNgmGpCUxFOGKAWubybpuBOzusSoLjGrS4zqErO6PjoK7Qr2UBHuSBgJ1x7zwR2JB+Hagze4I4EdXCshyQiRiZ3IZITIxE5kMkJkz1XgYi+d4vF1FDcr60unDtYRfMQIHrpkZPyBV4qS/Z4/BKaoHYKMpziv2azNVTN4RR59mlvLkynxThgnxEDoY5W1xAgy3hTO0GhgqIwUN03VmJTzZIBunjNKtRiRLfBWRFaKZYT9Uwk6zX0GQHWfN0q3sDvWEiPIrLRLJt2hBvcJufKH2CSSk2Rc5mz3xpYXyNDLf2gGjh7xoDl+ktEndZMn5JpJomozg/UII6OtUQm9DnfE63gpHhRGLvCysOTq2K2nKMeuXIwU0bbDlmpJ0SwlzQitLoqH0hOo8s7A7CFAlNaeZpTO9wX6ifxA3EacPdD8fwMABEmBZviVAQA=",
+	"static/third_party/sigmajs/README.md":                                   "H4sIAAAAAAAC/5RVT4/jtg+961Pwh72sgYn9a48BFv2zRXc7aHvo7KIogqKWJcZmRhZdkY7rfvpC8mQy2ymKaU6KQj4+Pj0yh/8dvp4peLhTq7P8+npQnWTfNJrsmWTnqObUNyfreFxtaIT60dYnqafYVy+Oroy5HGEH58/q/9efmzd//xhzl4OABCzc2rO9c4kmhUBdsmkFj56cVfSgDH2y0wA+2YViXxvz6tUr+AmF5+RQjDl8GBAW7IQUN5r7ZqNzksyxginxmTzmWn3gzgbgM6Yz4QJ8BB0wR5zQ6Q3Y6MuFZzePGNUqcSw0z5aC7QICxRJxeEc6zB38TPd0Vacvl7Xj8bk0zUL3VNXGfBWEbwpIO4W5pyhtKdziH3acAkoLnhI65UQo4DiqpQjCI8LZJuJZYBbcOSsoGcgqjNQPCgOGCVaeYY4ek6iNnmIPAy+gnHNgI7OJ+P56TWrMh8vxBlzgiIVhwomFlNO6N6ZtW9OTPvzck3557Xf/3Dg9aUnJyF2xXkZ07HFvDOzguyhqQ4DDj+yxPsnj60X2uD1eU9WfRPYnCRT1KrjHMwaeMEndM/cBi/YusMwJm1kpkBJK49lJk1Mx/TbwovzFEN5g3OA/CkIbpxFoq9OC8uW8KQYPdbInwOOE0WN0hPJpfprj1mlBGCnScX1sGhbSAQ4f+0DH9R8tM5IMzM0WcXtX1ZC9XWAIPRwpILSbvCPF+iQtLBRCrhChQ7DOoQg9cWlb2DQtHDl4TI/myx5xNoKbRXmkP7fHLsHQrWB98Q0nSDjyOZ9zcYFj4nEDdpzwVr6lYlebkl1zzfZdmqPm2MKuwyMnBDtNYc0gObPPEaBW7h9s+JajJupmpdgb88uF2eUWMyGZu5E0R8CBRGYUUHL3qFfT/OvobTlVGbMpcfZ0hprmECDh7zPKS5FyilQ1/GDvEbLJtvnTjFDgs8ky+GRFLgI8oW/jCk/L5u7SHONFHsfjWJZB0amtjSn7beCAsO28zUwkcLaBfNmS3fqwk8oIwNvN/vB98ft/H5ZNp8Pt3fvHWds3zbIs9UkGilrSquu2zJwx5v4TwpJIFSNQzAjfsHsEwFjnFTihJ1uGO39rSkwFr9X2Ah6l/BM827qHARO+tBPH40QB0zb1J9kdOe0ul1VVm78GAOwP3xsMBwAA",
+	"static/third_party/sigmajs/plugins/sigma.layout.forceAtlas2.min.js":     "H4sIAAAAAAAC/+xa7W/bOJP/V5x8MEh5osju9osUxuj2JbeHXRzQLnBXBEFBiZTMRJZUiU7s2v7fD6TeKEt20u0+++wCzxdbJIfkzG9eNBTnLFwlgRRpgvD2fFXwUSFzEchzT4TofJUwHoqEs3NC5CbjaTgqRLSkWC7y9OlcP49EMUpSOWI8iGnO2bn3SPMRJXrQXkkRF3b2EKGS2o7pJl1JO0zzgFMZ02J2jj1qf1DtN7pNGo58CPBWrcaIXIjCUz92lNNsQXzQjayzDV9LnjAU7HbbPVCb8ZCuYvmJSymSqMDllEJSycm2kDxzHRAJ42vX2ZdjIhGSGIDkXK7yZMSOTWIlN3aSMl4grKR6T4MFapageEvtkM7IdkmLwp1O6hmMRznniNqCYUhj9oWtXad82LgO6JZ62u8xsIq7KDV5C9McecymMl2K4CZF2MNeRVj3meQKRh8C4BBCBAsQcE8qZuCB3JciQEzubc4iXsCSPCAMCYkRhpQwO7ODdJnFfP1LInn+SOMCMt1dCNXd9HrFk5DBAlWY2QoyvA1owUeOq3j2iQMhWdoxTyK58MJr3/MnExyQ5a1/B4FGS//Oj2AWmJhpSputd7sGvaprs9uZOLovWW1QA8oTlKA+zRNe/NdK/k8mxVJ84+MxYnaepvIjj0SakIQ/jahdNtASHAzmsO2vRMw+rfyyWSCsxjM7XUk/XSXsjZQ51dp6J5QP+iv1XOr5gO5tusx4UlBFQBw4jeiJuZMSaFsh452gu2wW33c9QmuXTKFuascgDpw5ntb41FVm9xVyKEDCCh7hibDS1z/QQKb5pkTlI89WcaFgU1xQdr8q5CfxjRea+yKgsUiij4oXfFQbJVIrYmIOj6RD+/uCSwo+6fDr+Ve1eOOxf9UZm6QeRhrIyeQOK7DG45VNsyzeaClQAE/wiD2fkAajeTdczJpw4XZh8vc8LrjmujjgqDA4KvociRChr2R5W9RMYbWIJI5XXEtPTiYY5WR5K2uOn0qOvyQJ+go59oo/wG1Rqb5W7Uyrdl2GAJmnSXST00chN7+ljM8PlBxx+cmkQT21uj9oFrDRnETl+vCNHFJ4Zex5ueYzD9eKL6PSeLyucIxQAJvLb9irvOGE9l8d1X7jJK80km8GHaMNChqCWCS/ppFC+AWhA4ZAOz1lfiIIuFPtewcQgkOIxlq9Nf6Xi2ghf0nCeMWTgGur9Pyr5JR3cZKUGL9pjfQBcbtIV3nAMahnSfOISwxT7CmXGYkQTf/Cbbn9pHfY7WoG/uwdfqNyYWfpEzK2gmPidcwuGTa7n543u5+02f0Mb+EdceA9cbzn386RemGINWe73dkUzqLxuPIMtCBahuJrLlEjjR60y3frRf2mhhmeDFFsaooNzDCGd+bLyVrA+wmxX1tG1zP7TZ7db2Luh736PStTSeNPTyKJRBKRd9D0vQ9DHkjxyH+vPIe8h7ekWVrR3Qspef57GvOcJgGHGbaOT7/s7QY/E/t1GdsyzhlpnkoBliJBby+aTvjZap4xPJdWKZnXZBLY66qxUY2NN/RCf90YyWttJB/gZsj1D+KL9rublukXR9eXWdWwNWHrSP9k2LawdaQfgyD21Lq5RNPJjWFcC4zhw3HjHrSvyoQFadQmrA8wdfDlBwjsdW3ZbG0JCOxN095Ywggw/2goB3F8Vvah9yk6evo6m2IX9cMb9qqDn6tPqSOVlr/P8zRH58Ypc3QxKo9JIz1/xBXFOd5XR6gg5jQ5PD+p1LE9g9anPgiI37hdmiNKHC+4ph6dTDDjMZd85N/SOyVmtXjB5ZuVTOuDaW8bY5MaEt3TzWgIrTmodr8mU8eZz9ypAzX5YWJGzqb1WJUqkabjdGpgzGyzEKPTCARG78D7q93wIFoOyPOa/zSfOu7QwKv51LWbpXqngSFw+Kv5meOe9SfpYwGZ2jM9UinpQcSxqZmq28zRyLabproNOQW/+XpA58oC9eRYJA3xF5pI8TaNY6FayMfuIBny8R4O82fX/MBQbdPM7igdUbyHg1SywyYEwJo1gjmd+wa7adTO+lIemBub4OxAAobdIzN7dH4Hkj+6R2dmj+57JDnO+0luVR5ydBWTEjG8B1OrHQVq+iDlYSgCwRNJaPUZp0obSdesRIhoGdR99VfGjIBQe33h22tghNqbC9/eADdCfmAFE2YxncLza6ecFJLDrS3aJlh+83jZ5qcwU1/rygg+IYEVQtXaTAizQvCrsQsStK3NhRrb7/cdufIDuUwxMr3vW55Inv9fI5LZ+/nHxcu+U7gu/9Ex9kt2f5i9S+7RDpj0EMzj0eRHzEvL4Q0aGWuMjDdGFhpiMotNuMXxBbUL8Y1f+PrPC6+dOQq+29ZCmGFo1cGswFAHtwLD1ljb2lyoMew61+F4jAL1SrRetvMf3usldq2NQk1aI3zh2xGXv7UGjjDwanjTH/6M8CDKL8O1WgrhlwPbgnfx0rXDk+v9zfymOPoqfbmrNPlAX+l4+5fK+4IoMfjq/87AoK5iviMo9C0n8EwTCTsmEnb8K+z4V1+Iw/fuv1Ooy1YVPySfmXP8ZfIMxBQRorA2vGhI4PJIF6cRmk5CfBl2pI46UkcdqaOO1NG+J3Y/LftHAXHMEr4bk+Np7d8ajz9R6GdOAX9rGP40Mzh+gvpPfPjnmcW/KFrsVZ1Ddf3cTTM0C4xncvGrWApJZtVHIZWRk+pZfyGpkSlWfq4XKsjtHbTT21KL8gbdAeNo1ml9buooVhmjkqvc8E3Cbni65DLfINwya2d5KlNVUjJMa2ZTIkQtt/XXnGn9qay8/g6I4xlEvToMhrd0Qlib6/uqtbaMnkD1bIyePdaVLAw48S8phCS4pCc3oSVPvvmlVdkPx1b1P0HKikJsVf/YYyXxkq4R2+1mlg8zy8f7qlalPKESarYq5Anvd34moaFkth/E+6AM4aVI6w9FwCFU1hGpn4X6EaTPGtz3Oz/DA2ltajKFWDdhqRZJ1E+qfjJye/ccxr5yyisxD13lENmqWKiPXBjCQWqVam+u7udLN4HAoI5OUmdu2qG+XUICKWR3dihiyXM08AGO1jUSA3VAPt4+XJHYcEgVZ0r6q7gD+BzxbiWJDw8YFiUzHGPXH1yeEXXPczCVdabuG7syfH0BiyNlS716lT0etqi2HGIoqvYs6mpmFnWVQ7fOnee396QUGC6rIxQhP3Sovslh69hA6XJ9e8TWsQHscSu4bvxo3vCVo9IRsXsA4mC8YQYuFRwawLJgrUWvkDSXw+VveFs/jwKEt0Ga5JTZC1r8d+qjc6OE7sv5hNmC4d2uoqGMKZqtYO4AHdynvsvMGrymZAx4YqTdSoqchzkvFghDd4YoPq6SRCTqjgnh/R7vRYhKbRtkqhQPt7TYuM7w+rS96ZU5G/Cg9m4EfL06HE6yM5sa1yvjcY/g4AIGDawhEiERHhqoZSFnjmcUJ1aSBdViQ4pOs0E91xHkOfDGY9SX5EQ14xGup/1RfdulpD1pYnqaYHg8rsjUFckJugqHXiEm2Zrqcc8caG+U1PXM6YsoRWFcNqnmwC2T64B5V+ZOoXcXpmZWl2DuFA4uo9wp9O6U3DOjU98ZuerOSN/QulM4WjcDnUt+14HhegDXgcMSS/e148BBgaU75a/2e4S9/x8AGQsso7orAAA=",
+	"static/third_party/sigmajs/plugins/sigma.parsers.gexf.min.js":           "H4sIAAAAAAAC/9RYW4/jthX+KxoWcEiYUT1N0Qep3EGSbpKHnU2wMwgWMPzAy5HMHVl0JXoua+u/F4eSLMlj724f+tCXGfrwkOf28VxEs12pvXUllWxPdjVEta+s9iTtNyKFW4+yipTYW5PI2BpeSAVFIuPwv0kr8LuqjGT8aD/PZlThfxF+MS5j6X1l1c5DHfaGn2K8x7hqjjL1GZn+ZQuJjPHf4UB2pbEVaA+GTLU5HAjhtdtVGrnbBfeyysHj6bDgT2DztU/mMm5Xh8P1/8AKg1aMfrUmSbE/yipiKOp4A17eUNS/9htnbGbBGOlBDNtxDv7HXgolp5yE8XVcOgPvbO3fSr2mo6N6bQvz3hmo+THaiu3lUsVe5u/lBmLv3rknqH6WNVC2Eir28Ox/dqWH0jeMS5bIwSwYgrNcnVjiDBRLuZrNzqvTbfMx6vAm3YV5aqU1hB0Op9TMVYT1aJhuIRGPEARxmRPurS/OsCE18JGGGzGoeu9+rCr5Qsc+Y6mJCyhzv36zmM2ojg1kclf4P2WxA2GWi9XYWYyreLur11SzBtf9yRuVXF0PLsyo5OpoOiqhUMe3BWyg9PVPL/dtYCiR3j+iJMI4TDT9TdZral55UiGT3EBQ/lZu793v6hNoT6co1ULF1hwOahn82Ydx/wAvieZBYqLi8L9p2PA0NnJLX4uUcXDpFEapXqqVuKL4eCNbRsBmMzJ2HrFlJG/WMZSZqzTcv2yBtu+by4mXWXKWCZZ49QodrQfX5gOmBnSeRWM5fRKmPQYBiuYMFLs8Y169RQVFh6ZUzmYUxskBI20Y40W8lvWfIbFASCyWIlm3aFEU2IkddpQCG67FGsX+Yqu6R0kLkjsqOXm0nwkn2hX4NFKbUd0eNWJJKsJJTjhRhBNJVq8i2HlIT62SrGGpisOVregPv/5EDWtaF31dma2rLYogLIWQMHsCWrMkz4STF8LJ5zMaDbxLuRJzeK0ZS1GN7BvUqO1nICzNggr4Q8yzk/h1z6u9M/+WO9cS00yPrby9G4kiv3D3uCp8Gt6++RpEweRjiALb90qefeNj5LG0TYe2jPLDgebhzQjFOEI0P4UoTCGaB4g+ICq56RIazQNEzWDJw/83RPs4wiiA8CVwfAvg/NrqhxLqegBIC77jxmUEchV0L7BBCPEX+8o539avM7Uhh+eMsOVixfNKbteX+XC3ZcSG4CIfbrZsITVe5MNdrEcm/wIT7hLGQ8lPgt9ucXkv8xpDd0SbuLpaT/zx/q4DP9rOyfOmKGvSuhcx+ghVjWlk4Dn1ZssQMvJ1vCC8CH1HdyD44uTExpm+bZDeahKCvfnCga44oYlty5EWfcF629HEZtqkhjtLMXjPicFJW7HvtE6OBgbPJa3ufHQ5VsDklbg2sIb2Dt83XQxzWvaR+kQdf3WQHXvRcjaj2yCuCKlIlIy7EQ0vEY7x7aQTHNqYnkrZ3mb0yZbGPcUfb9/95v32A/x7B7VnvSh4iqY7wT2Sq3Q4+qP29hE+tqmN7aVYktv6eVP8LVx6f/9H/A+M7inxhzNEJFhdudpl/khbpZmrqMLWRDJfvexHyk1kU7lUK9Zo6TX2dfum6Tl3RdHQkM2uNPPryj2RqVlR6XxU77ZbV3kwPNKyRErhpIn8GqLMFhC32DAcBOl9SITA4LgsUukx+7lHqCpr4NZuAgpu6GsaJdiM/vV5UxDGjTiTRWVcQb11ZQ0fb981LJkytdkcXfCv32//kFUNVa+Airf4+5fKbe5Ce02Hq+7h2XMit9vCaol3tRo0XMduCyUlv769JxzLDIfQRLuyAmle8MGBXssyhzF+/i6E0HHguEOO2UxRQ3XbIsU1lAh0uNEJEidd9dFMnL0mTSFX/d45L98glCdNCMUbGDqIQliha9ZiP05kySvXcS3k5U6+q7ghNPuGg9DdgNBCUSxSeKNSNZ8zs8Tu+STt6EJiXVmJsDeMKH2ETMP7HqKbZCYKoox+bONaLLgRspdv3uh0PteM/AXxQ66EkEu9CnkAtZ/NurEGqcfCpho+7llGwrgaxP3XklohDZ/OOhdvD+PTIjX/HETM58xmdCzBDBL6Nh/lmBWOKRA/wMtKQDfv9A+u4eearAsuDQ3QItWDFno+Z2rZGic3sGrtDCJGDjzfT0yM5cdW6QK01JwkZK6xch+hcDjQS/zv7yjeuVysGP8SH+15Gj7G4Vg7xTU/zk1qClcdtDJHtIAQIoxHJ4zv76gOI9IlhjAoQcNHI+AJGOoni7lZsr2WNRDlXAGyJIkSxFc7IEIIlaoK5EMaGGzpIYeKJOFX4cq8W2aFk75bG7dTBeAlc9VMAvbh15+Sc5l1+cPqhlS5kpTMZfzJ2ZISTticMJIgPZDrwmqgC/79NZuyNA0WEuwZILMlGHLVZye/tnWMzV5bY77DZRJBVbkq+j6S0aOsrFQFRFoWBZiobQwjWYQMGsGzrX2NdQ5LTl44JYuo1m4L36XHq8U+ZPck4xl4vU5y3nckZBFfx9ek4edUg2csbfVsFi7qfl0JcaTTjTO7AvotMdjSsBj1pUhhfJT9z3+GlPSYv4HM1XzeTL11zOW1zTeyq8ZhHdk61GEDupAV9mKBHO+8Lep4+5DTlq+tbxV27u2bXqQTeuunU+yPmqEuLa2F6r8tiAXHYa3LCPmbLM0wN4n1MltxG1sj8A+37SdH4kKGGUzp6DT8P07FlxhHDNTGz2JKjJ+5jV9OiS+M23YubjdwyW03VLWksGZpb1k3kn7dsv4j3EhJa27wTyJpEBu+zQpC5v2a2+4DbUts19/gnVfqTo06jl4jY9vvvmEQjWxZe1nqATwhjLpr/XUBsqKMfzWs/QFpQs2g6Ipv9NvoKDbm7dEGihpeWa1ves0EdhvYqgWlsT1KzrQ2ejajRmhk3RXFMRubm0dnTWSoPhwUS9rJM+M5X3Mb8tBp9BRD+MchO1DFgaWoXoSspyqqvs8HGs6E90MVY00zevLpfwYAwEPQWXwYAAA=",
+	"static/third_party/sigmajs/plugins/sigma.parsers.json.min.js":           "H4sIAAAAAAAC/3SSTW/UMBCG/4rrQ2RLllUB4kBkIQ4IhChFdA+Vqh78Mdm4uHawJ91dbfPfkVP2U/SWvPPMeOadYd0YLfoUGd/SsQApmL1F2vqO0TE66HwER5XCzQCpI8UvHzXHPqcVnb+JLyQmJA5s0BkcbWdZjuhDkcPvJXvh5KBzgVwoF68As3AWXvdZHXXoO7by0aWVvL36/hVx+AV/RijIM+CYI4mwIqeR9klnooVpD6mfLPonuL02D2CRb7W6o1dl/RjezEUXi5/yvbyk4lx8+x+xCt7mVFKHe+2+7VJmhvhINMe82R41d/I203fmnk9Wo+2Z5dtp2pFjCJM4sU0+lBQPVmhhhOXbOpwToM4sY7yOewH/9nTqyLytMg5DyghOEKtjVULSjmAPpPMBJG1BpgEio18+L6jQ4uKSC5ApZtBuU1Aj2F7HJRxv551SCuRM3FSiaZhT326uf7wMwWqsDCkWWMAauagWFdTR7g/rIzNymfXQSxtAZ8bF7r9WZY7zDzTN3h0u0uyTlBNGVZfnWsxUetfeEd80zCpT0TEELmzTWGaenx3nkwBZIDrGp4lLq0Ng2PvC278DABkyINMmAwAA",
+	"static/third_party/sigmajs/plugins/sigma.plugins.animate.min.js":        "H4sIAAAAAAAC/5RUTW/jNhD9K4K6CDgyQ9tJW6A2WGNRtMBeupfetCwwJEcyE5nyUlSDQtZ/LyR/KamDdi/UcOZpOHrvUaxovYmu9gy6tG0oaWJwJqbrcz5BhtC5gtkcFQSKbfDJEK//wpBomS/4gi/U+lRBscNotmz+53dz2DCUDA+HNAURaF+hobHA0xS4lo9SShQV+TJuN/keQ0OffGQozBbDx8gWMJvEfPkj8H+DlhPQ8j3QwwT0MILU6j8O/F+9Ht8DfT8B/XA8EFZXbpIslBo3SfaFzeHujqG8WUsyli/uf1IZJBl/d8O4yGDzBZLsw3ygNZ9hvlR8hvnDuD4qBXxQTHZhpfOF4uVKDwi90vmD6sdaf9FbM80Nt9BpiUwDNxKZgVFtGjuIkLHlvYWZESGzQzNRXjJlZoe+Ql8yOrP9yR1pKDVLZ/niQCLwYS3HVSvxVDvPUp7CLIW0dwVLW2+pcJ5sKmX8e091kTSu3CHEbahf0jFOXJP4OiaWTIWBbLoe06KNrmrE/rlkR5zYV23pfJMev8PIBbey69evigK922EkebkSyC0n6C7UFAy64/tseo7FSL/XLwzun2Hu1uZnudwwFGXA/Vb42lLDQBR1+BXN9nrfELqiDmy8RYnziYXjY7BDrpXE3OZaKeiBowhUBGq2DHh6bnClpRS1/6Xe7SuKdHc33TGAFTPyiRng3zIQHQei60CkJJ0tauqqDh/msNGsylE4q3JSfJiXlOIGVqcwM7NrffCDgbdfgyfSXe2bfKtkoK8tNfHjOflbwB2xAqAfxiolHQ5dz7dyNjPcyVLYNoy4wwFFQzE6XzYsvfb8w+0oBf4k0+G/5sspaYSN8+VmquQx1eTnolrdpPv05jlY3WghvrZoh9nMJ/+5jfxZ3nQMr+RbXQLZ1tBEFq5Prltjrgc2B+cOSpmjSuNDH20zkm2U1LlRcPkn97zrYT3lWk43h8Nn/UQmChMII7GuB/76Zjy7qmIIvGDQ3yjJd039+piuB4PeUPVG31cu0Ar6HoTBqmIvztv6Bdb/DACXdfVCpgYAAA==",
+	"static/third_party/sigmajs/plugins/sigma.plugins.dragNodes.min.js":      "H4sIAAAAAAAC/5RTwW7jNhD9FYWHgLQZ1i7QiwVuD0XQHtKeFmgCwweKHEm0qaFAUrYFr/69kOX1Ommz6Z5IPr5HvTczomWHOlmPlJ1IFyGLKVidSG5LSjo0UFoEQ6RMfQu+zKKtGsVSHfyBnPeZjRn6lBnQTgUwJD/DokvWRdHuKjrxROu6ymIkjL86CxNU9Zc3EOXViuIFO9mSTsQAaCBAiOIAReXu74vMYkwK9dXQW85kMEM4ZI8h+EDJ5xqyd777NYH2TauSLRxkB5vqLNWQ/Q3F70/Z19cJy/cqZFoar7sGMInCm54bWQjtMSmLEDhII5yK6bfaOsPL8U41EBSvJHbO8VoSwq28W+a1/DjJr4Xw7ViUKNoApT2K2BUxBfoLW729Opvb3pSRneyXL7SSShiVlEBvgINQxjzuAdOTjQkQAiWN7yIYf0DCHRu9LdjAd/JmNOz9PQURoPF7+Ei8ZAN3t+LRlpFKVEG19dlFpEw4wCrVufm0/L9v6/esj0rC8TuMriW8YbwQHRYWDSW+S2PzCd+NaISULFaRns7sR1SFA7O6W3I4b//wewgWq9XdcmB8bFIZINaUsYE3t1E/rq7+TtKbHO+TLlF2dDT+I2EW/wqzeB1m4PgtS8FOpQ90mvdCtKqC5wcjfFlGSE9QJg4X+OUKf/Ytt/JPlWqhfaSlUFg5YHw7YdHiN2z3diC4k+sNb+Qi//lTkzfz+TQ4KHfrZsO9PB1XKI4zO0fRz7a8X42rfRixLQ+Azytc13NyJJvx9DKderIZcifaLtbUs0FLqh/cerEZf7Nn9hN16+W0v0FnE3qcoCObTysHSeFKe7kRv9ygF3E/Qf1F3PNKHKWe2QeYbXklegkzO9ez7avy59d+7iFMDd3+Z5OHgQmtnKMHi8YfWP7PAPT7vGrGBQAA",
+	"static/third_party/sigmajs/plugins/sigma.plugins.neighborhoods.min.js":  "H4sIAAAAAAAC/4RUT2+8NhD9KiwH5JEsa9VWPRT50EPVP2p+qZo9REIcjD2AW2JT22QTEb57ZbN/2DTN77LA85s3b5i3kHYyMmhrCMz55DHzwWkZ8lK3JJ+MwlYbVDnn4XVE22Zed08CQu/sMU/3mfaZsSFTKAfhUOVlgpkchPfoWefE2DOh1B2G3iqSG9Rd31jXW6tyemkvYH4WLmuopIoibWnH54X28Ufz2ViF/oeqpqi6dLNEg7vQa8/SGREADsPkTKZL5DcHtI0qLZNoAjq+25etdaTJtMkQ2qqpOVZNXXaVqPluT/VayMbJ96SFKzmJimH4cprA/2oUvlSihrmrmvrtjcTLfySuXlZ+U8MqKj8Vjby+klE2XlbZNP5GNj2vFbIGWC5eO9AtaXacC7i0SpiMWFHEI1kU/9O9qaNcLFSfeFxZfaVWj+rrHlUc/bymBegalSnowbPx746skWLjMHXa+BzozTPbRsfzTXJjcgQ3eMw+CF+5LmBTey1tYD7ZETcM0sBCU91gxZZP5dpNbdvrlhy1UfbIHu9+/yWE8U/8Z0IfzomMxm5PyuSYNuW19EcZ9DM+3jd/oQwwC17ld/7lafgmiR4Of7Dv2T6n78FvPwAjoKWz3rbhgtXXIAsI7nXemLvpTUSM3iJFkD2RMC/LmTkNw0Ig/fPU6RtwO1b6EvhpHK0LqGgmhYlIfIdZ6DFTIgiWnxOgmB3RkPznnw45behuD1QxaxwK9eqDCCh7YTrcvunvOOeKJcZDZBQFidtG4QgkmPz2cP+FjcJ5JJHoR2s8HvAlAFBZFJIALFQxj0YRSCs+LTpW3wTjnXADy7IAk2IYTiuD8t8BAFG80V8+BQAA",
+	"static/third_party/sigmajs/plugins/sigma.renderers.customShapes.min.js": "H4sIAAAAAAAC/5RWTW/bOBP+Ky4PhmhPaLtN0L4RmAJvUSwKbBfFdoE9BD5Q/JBoU6RLUUmztv/7gpIj03ESbE/SzJDzzDxDDidTreVBO5vhLWobOWqC1zyg/I75EaO3SyjosKQADgJvGdm0TZVtLavldQHCs/vvFdvIa979/995If212OM9cJr49zK03o7YHsRRzQb9KQxIUFDibUmUNuZ7eDCSKihJIUttv7FQZRjYsLTEUBJuXCMPpn5bhvd7kL8C1gTv1vIIZ7SVf2sRKipnV78C3zvqAlBJAHDYgreRYEUvryZfWajIty+zxYd5Lknt7uRfLiumojc02mYKA784yNw1mcI4V85n0UVJF/nlTZmX0ymWXbjd7uPe6dtHhEk5u8QTAfzi6OnMivd5kaHmR8u8RCAituwAe7DnMpGEeX7gYg6DQ3gz751x7bnpnJXRWXlwVr3g7JGBCwEcwzGnyEEqT8/s00P0QrPaWRERq4hYHRD1K4VgRP5otWFBembG4xOR2Lb+5rQNzW53BSXNXl3sXWBB7nZznJYWKirS8lbH8paxvNWxvGVSXk0XubrRuX6hvGVSQD1TeFKl5T239gQl4UaSdCRJH0havUpSE5gfj/vvU1qeWLW10v/Jgna7HbmK+YOmYlLCij7yohJGuvIOea/pPFc363z9Qt6rJLN1zEyneZ9bTw7O4OW4KltPF/iMv+fshwsSmI/UrSJ1qwN162eoA4W3Kulh6EEa4+4RqJNeopI7tCBvh64Ac3izODXPgbw/2nvr8Q5EKe1E6tAI4SSI+0oHGWNI+x0qDOPrFyKbitm7WKLZOxCzy9N7vngF9LEPnuK/inQ5EbP/DVgf/iNWV5YN4zWzCNZgW2MwhEo3pHucfteFZ/6BbqVta+lZiM/VnfSNdvYazckC7feYcGZMFjdhSB+Nk5dRqwy1VkilrRSI0vCwkU6NGl3WDIfKu3vU/Y90M7IujITkhnkpXtyZxndwkKrO/XTuSRu0achmXWY9HuHM3rGGWCdkg/oTWVAGnG6fvrgFZafv4vEJ1Cor4hXWNSvl8ENab/omUNFEBXqQqt1uAatBvI/iehAbzoyMKjOouNGbqKkpv62Web3bZTUVjre1tIFwL1mQn42MUoa+fP0NYahJ4zmtoCbOGsdEOlxwZxtnJDGuzJCXcRAZOTvqsEZxMcJQEC+Vl02VxdHktlrSuqfJUn2z+ria6esFOLq60R/1bHW9gA1Vk3Vekobdye5VT49r2R3XjriJefrwnc0DMd3uJ0b2JUaV1SCOrehiTt5/uLrCk83Egjy2oETvYDOxk7eT8z0XC9hM3KMp3RYRvWyC88/PInh7fnZu2fL0aKi+9CVVGdp4qfRPhHc7hKCi7Lacokb/I9GyOwzcGed3O5UhIRVrTfjDCfkpKhHujkfRTYef+mUa1r2Hn2gJpv99QMtcPDJejMdFxmANBirQIDDw8ZgPmlXUyEEUGESabZ5eIzJc/AwT5fxnxqssvRQqYySOtMDIMNIe/vuRFu8PDeVT2wRXdwsautVWh2vxtJckrST/dwB4exUCZQsAAA==",
+	"static/third_party/sigmajs/sigma.min.js":                                "H4sIAAAAAAAC/+y963bjtpIw+n+eQuacpRAiREvqJDOfFLRX37LTmb6t7t57do5GJwskQYoyRcoUZUux9EDnOc6LnVW4kOBNkvuSZH9f/tgiUCgAhUKhUCigLnuddRgsqb1Yd/qdJ52f6S394KbhKutEoZPSdNfxmBe6NGNeJ0s6QUpX846X0rswDuxOv/MPlq7DJB53hvbAHgGOTTZP0nHnScS24brzM3WT5Q53Prghi1227r9LOq//v//XC2lEnU6/8yp0Wbxm487rlx87vct/M/1N7GZhEpvo3tisWWedpaGbGZNbmnYouT9gh+QgLrqHZA8z7OMAzyeO7UZ0vWZr2wvXK5q5c5babJux2DOzebhGHE1I4DdeEHe/vz9MQt80oJY4MAjJdiuW+J3Ffr/ohPE6o7EL3z99fP3qRcSWLM6uFuQ+ZbHHUpaux9PF7DA2pomzYG7WeZKmdDczCCFveYK9SpMsAZx2lnzgddgujSJzgbpds4RocUA4IAs7T9nviw/47SZxRsOYpVgD6nYHF0QvZUcsDrL5fl/vU7DfBy192u8N0QMNuts18iqNMO4EvMlFTWQazBBe2KGH7kPfpFP4OUPZPE3uvuFMNe68lLV1jG8syLa+MTo0Shn1dh1gj2xtfzORpPKYH8bsXZqsWJrt+HBhI/QMfH9Low0bQ/kDOrBoze79JDXnZDCh0/lsguaWdS4Sw7DmB3SA8nQKudBmwQ78a82yLIyDNYnZXafgJjeJ/TDYpNSJmOnkUHiR/wROQvhYK/jUyRtSxs/zzFILENYrHV8MTqBfhp4XsTuasnVeyXT2QCQuXbKUFgjuDw9EkHPHF0DxjqXPeHs+HZfoz48pXbL152Ix8H3AsrEmn1KWbdK4w4dNUm46mB0OSPDSr3MaexFLC3lFhbxysEvuDxNgQqcTxh1qezSjyJ06MyJ+T53ZxM2nGqF2RtOAZQKvEm0vblmcmdSGCYtddLCdUIk57JclyXSGPTLAjPhSPkzYY2/iWRbiGKnnvZfApj/1Zoi3DXBoXHUSiwZrrzbreV0CAe4rRwecQtJsLCqtyaCFmBjdbv5DzBH+YYMYMWWOJHnK/JSt5yZC+C6MveQOusbp9CpcZyxmqWmkbB3+xgysDWSYz7puNyyQHBBfHBxNilPPEzxZG1QXe1yOQIkLmgYbkKtKHCMusSgZTErMYhgWnU0QtawJJfBxCH2zBEFLArWoftz5OGcdAQXClTZKVsmgblWa8WImxQUxcUX2VBpBXOzaNxvqZSljFWyQjF3BfIabJKkXxjRj67+vPJoxr0RoTzKl6IQJWMP1kzhcAiQ65KNYlQDQBBBmB6wPxnUYRQ2jAYsRqfEevar0aUzxBRXUNQR1C3wl8obrziYWgsGzhSLiYDXcjY2FRYVPIZd4kgOwQ9z+cOI8JoOJ0++LGQMV5hPPmzozpIbMYxHLWOdoDVgH0iVda7bKEV3tdsV/U5C9TFtNJDSxutC4CG1VoIKrgNzn+sPYS1w+IeyAZVLneLp76ZkBOozblJJu1yzhCA6Ir+VcF3FJYIfeJNcGXDW3cmrB7HJnE+Ra1sSF2eXms6uAcWvzS/VasICChDnmNs6x0Dc9YigSaQTgcvlK/Bs7Wp0iCWSpt99rGbD4YEbUcgPdvApsxYQFiaoTOQcal4Zapc72eyXk5bRTOWhcyajMewbMckEIK82SGo2KaRInWWfF185o10mZz1LQ+WHSSLb2uezwNCmOWVn04KAqBKbujPgt67NfUu7cA8I+F0TdrvhvTg03Ct1rA4v/HzIaMAMbXrJxIvZMT+L5bxKv9HtdhpXZlRQASm5ZKnPVT568yVTqJlOJXnIX55juYpW8WclE8WNtzMpqRLtw5OMkllwfYb8uJBtn8lExWVC/TVC2zJMmYXlEUAre4ULJJY4dxh7bvvVNmgtC9zEZdLuOvV5FoctMFw9RXXw1Sksp6+qiTa7vRFuXoJkU53INw94CpAojVc2Ga0GUDLBLmNKC3Md0Qi0LsSmdie0dlMIDQgi9MoyxkS1XhjW3jLGBKSGwEFwZXIyMVWbHEvlC8/JAK9SnIvKJ/jn1ZtjPp4xp0E2WvGdrl0bMQN1uG7l9IMiJbNmnspZmpwK51jnVea0jBva5TvYuZX64xfd3oZfNx0drmw5mNgfDcxYG8+w0tIA7oHG5gW6y2j2kdbDXduxNFkZrWJKeJpvYo2nI1rpoKhfwcwVIsKkGaccwYU2E71cceFwihAPI1+P77Tiwl2H8T7wTP37BgkKBvaTbf/ZlpiQET/xFJP5yOCDJjlIMXrPdurKUoTaurC96wKczmBAuW6/z/IKd1tfh6kWaJunaQChLd/fHypvo4MJuxAwRrNXrJGJ2lATmN/9N0ziMg/o6CsVhKXVTup4zr5PEHaPAZnwj9vid45VO9M2XgGrUY0RWfa472JUa2imSOsRVJHUqJD2fZC5vvWqnpJiH7itoblnqJGs+ic+jpdtES1VNGynLjdEp2US7moaNnZIamO96HKSJzFal1SUD7BVc6j12J+55JGVTt0bC4PNJyNGG3mkqlurnmmcH9not6je6/z+JGi1EICm72bB1JrZ4YRLzTN3GK5duv6HwAR1aWRMW/uqkdiaaRG7QDLT1orC9VGZ8fVtWmTvODFUKqyU6LyqVaT2TF2vUUpp2aeUKBOI5Xb+9i3PF10HdrlYS7EXyszBrAsXUtmFdMppLslatFFd06s7GalmUZvP7A6YIUN0KSz8xuKUf9FSl5xkXSnsU3Ac6otQXn8QdaRqHxZl54rQBtES1keK9ZJ0gShwaddZusmK2MWlCzrarJM3WV2ZT5jLxNhHrdsV/W8J2u6b8RcoZxEFY/hTtJY7cDcmvAyrUCYR1vbl0KqEyOo5Jcfk4AkRizQ4U+uYQ1MJKercrVqGiwHQwQzogfHMbEhCslAo16wlgM8qlU7Wix0OOJSD8pAKML/DfpOiKjilXsjPzsnOJpKEvyAUTbAMnnkWGyCcB6J/Ppv5svzfhH5nOEP8We5B7uWGB/dghJ5BbWzhAz8bhqZa0kVGsNkJ+hrnOc0GIN3GhmbC/mZNw6kJT51IcB2BFYmSAfQKJqhi7IMSfMCgGyVM2s2UfLghxut1AdEzmoQn8IMEB/nW7oOZryPJ5CWlCVB5vaA4ObZ2JkXumtBI3ZTRjZryJIo2W3qfSkgsWcpuEXgea7VzdH8YOX4RYuW1MtK1CQ0HCe27RmGOPZnTsgAXogL0aTT1OU84xsFxx2nk5Xc1ArVkLdH8oesZ0BQ26Ri6G2CegWwdka6/noZ+ZnCfAALRIHBOJ7L6PA9tLYmZZOLCzcMksAknuJk1ZnH3ME+64fg3fpJR7aaq8/X6IcGDTW5bSgElIwHgpasAelEw2cXYl//9ARM74wsUXXm7sxQ7ZNqiOW9gmFs14rLcJ3W/VVpfiARhDGLkYTJyU0esD2++3ghODfGn0roIxcEdBQj8/Zihqv5PLMcXUXmc026yJkW5iUAEM7HS7JtXpsp06/aHeREx1ShEduGdSjWxI4E8FHIyaZxqLxPkAaWALvjEpQlj2gmosHdQ0cy7pbpFDbqd0hh2b+hlLr3ZgCnbGMP/V0gn5vMSGXFzkXd7m8xG4I/vhqe2DbvF8k3JFZII4DzETofvQdOEUs6h1h6AaUSPs1+3Q63ZN34TUvFr+cdhcmZnqKIszlnINxkB4zTgRkk1mBniA0NgDI2myMrROz/V5zLAPfF2dwoKbnpCLgZyntDh8Ecri3KRCacMr/gs7CE2ewMTZ7PdF6/i4GAgHJpIqHNhtq0cuFDVZc4F7oB6ohIrV5R6aJLZQfElCDTZQaM3V3HTxyrxfJM4Yvg/QvLFIfEhr77V2XRSt5WpGB6yKXDs2jambxCn1wEz5c+LMOv+dJnGgqToGlx7XQNlq2W+qZX9OnM5dmM07odd6yMLRNfTdQT65D70xxVwyDDBIkPEAi+k3Nu5omPHpp82s8QBrUmc8wMU8Gw8wUNA5THJiyKG7KGr8FGL4ZGV+0XbCAB9gThJfzv4nnifmvo8QjPKT1oHW9f18loTauak8d7gYNk4VsbzBRKGFFcSZOCB3TcqV8E9hJVDqW8nHN3nTO7zDtzPsVE0wonIuVNjUmYEUIfADRNpTex6usyTdQWoulmEUDPybMucqCfoi1mgoJZBC1MB9vs2EFRxUeFg7Lwaqrdu2Voa+CRjt0APFE90ztRI5eIjkEgQb3qB94uSUgpmjZgycCvhgA7O/aRnhRSH8ycq8xXd4x6dVTqFC9XWQA6K5kVoOF89NcxGKcIKoX4XZ4xZ8inbw5w7+bEFD3IA4KjfxulhVgbf3+zv+FxYBhcm5WpkOqqzGkepa4ykZHXa7TfsBJFE+ndLhDM6Ga2K6peAVHYJCBj4OjZWZFDASOhLcwDskxLdQCi8IgXly9ZT7Ikyd2VjyGiRMGgdvWXhCbIpUzT/iNyBpuUzSosC2HJ6D2l4Yxm+R1Mdvc1cFT6zaMn1XTr9T6Xc8PeDaW+zSzPwNHRpHBa3vQtBNKbp36ZrlMnAckNTcybkw4VlKi+JZd6UszpvjgPwmEz3m002UjeeEn/OXzvTes+DFdtXtmnNCEb4AT4das5z93qmXQbyQg/Ccb3nC+pHOHJdOWLm0hPPTSasSEKB7t0RyOG91FdVg+yfXZLcyMG7LwLgtA+PqA6NV8Ztw0dK2a7kN1n/MJsyykBleuVMmpdV8LH/bS76pmCOU79ogJ5c7N6A652y40pVOmP1ebUJNhC7v9YcTCmf3tN9HheOOAgbBI9x3tBRtxrhFnTf6auYJhlfTnR5Z1abKWEirxkJH9POGq1MITY7odveOcjySSpsDp6uypFAKHUJzgVa0OtVarcZcCg45hlR3X9D6uy4EwXOaMTtO7q7UDxONTVhF4BvZgdCYTT4pqS2WlPqqLNIrepghPDszIbpvy+KcS3cuhbie+ZTcl7YC49EAy3UGfMFw4+Z7UhqW/b48TKWDVdHbh/uEUnQQvXhO7ud0/XPijK+x0NzGcyyX1nHIfz2JovECKzvvOMIByz5kNFuPExyu3wuhNF5iN2I0/Ul2LsZwJj528CbmP1wsbXpjY2AP7YFx+Pp2t+eF3U0MJHmOxjT/fThlcAt9vRk5a+s2x9zECIqHx9yIpszTj6InHEKYOYn2G1ZOrH1LE2jj4dHXlRalVng0Y2+SO1L3PDx7QpXwra6DBn416X5vGEhaiwzbQHbKvI2rWev5mq1kA+/RFVcR4A8BK5BTqSms044MVC9r3bEsejiYqITBjxKaPUuipMGLkkwHeIAHOdmoFP6X/8+/X6IrkxLVpZStIuoynoENA2GHPALdSelM0xVN1+wld6Z05zR9kpkDZGm/8fB7hOtAQw1o2AY00oBGHGg2PlHhWbgetQF9qwF9JypE44I2nV4aOPSq0/sf8xJ0B0oa8zo9czro/69ZD3V6uPXDxHYPXf0P6vT+r0sg69Si0+EMW3Q64n8fzWYIj777vudMB7Pe6LvvLfExnFnOdDQrc0vAsn8Wo6yxmp34/ppl/wTltNvNP8F5KqI7luYZ4gvS3ShkcVFCftbq++VYfb+U6/slr++XUn2/FPX9Uq7vl1p9z1mU0eY67+aMRTxfISlSoAaPZTSMRF5ffdYqeMubWporuZ7Pd32DCQWXOKLxjejex2SFsNOQ8Yr5GZyEq+93NGVxJifdfZasxi6OmJ+NnUNFbhVuUqXTY+2cBJpj/6oB/iRdphtTuXxuzBGe080Z0m+5LRt7cqtdk4gdsCWPCCHsygQl1AVlbAz7JH6Gw7pdzcanSTNGBooQaoHWaSFLIHB3pXX/ZAd7U+U02h/O8MWwIlTF4v28hbjFeIOHqtlKRyQpMwEX1VWyMtEEUTtly+SWVRvkqrPL4+gqayddQ89JQ1p9nRXpdhTGjKZvkpg1LFAd2lwIvHJSmoXuy7ixVO9UubebrLmgOepTdLLS5uIm7ZER+mF4ZX/Xoz067tvf9cx+n/ZM2h+h/rAFr7txjnSkR4+Uam4HVEl71Boere+sPvToGDph0j4ZIY50VOlFlFDvw5yWHG7kSY6Y8uAuQqVeLQBNFxXr95onfUg2Kagd2IEJ4ibLVRgpaB9xCzhouzzhHQVNPoMcTO1nb1+/e/nqxa8fPj75+PcPCLMrf2x63a5nfsM3Dx2BLIyDjqgKbFS+9Y0x/sbSkL6M/eRVEnBTG7UF6xf1i7O4Wr/fpUmQ0mWt45iJrgu3QtV5CW2qmxaDif+Do5RIy/IRtWmWUXcu651jZ+rPxMEXKoq45SIgGJ5kWRo6rxKX723MOY7kz/VV/mvqz8Y+TlbZr5RDrznufBiiML5WDZyD8ZDTRqYUFJ9jar96+ea/cnIHV/OxybpdZhqC3GHcWYlSHcDJHU4MS8emSD3XSK1V3UDr1XVgGnrCkmZp6LK1gXBTsp2lNF5HvOOkSZWdDjHXIzH8h0Ebzg7NmNIka0LDhS15TbO57SZrk8LhOf9ah7HmyepcTV3c97CH3dlY/BzAh6q9rVbueUmalfCrKeWF6Wwsf0EXTiBcbqIsXEW75kWZuFej8SOYZdNBz7MGM+zLn8MZDuTP0Qx4eToUAKH8OZzhhfw5muFrQqcjARDJn8MZXsqfoxmOiaOqSOTP4Qyv5M/RDN8QR1WRyp/DGV7Ln6MZzoijqtjIn8MZvpU/R8WG6mrKerHl924w6yWW30vxvBdbYe8Gz3uJFfbS2VhBWEEvU1BW0Ntg1ltZfm9tBb1bVcpa9DJV0lr0NnjeW1lhb20terf4uhdbUe/GWvYyfN1LrKiXWsveBl/3VlbUW1vL3u3scGj0Lmm68iqt9VNjCU7iy+Q3Axt3zLkOM/AqN2YTdbOI5tKg272Qt58a3a/4OfQxACIyuZ+gZbxvAjFm6oKVS2OXRccQPGuAMMCCXgdqqWtyrLX7vXm0M7XbK6Rpi6zm7JJuzQEeft833b6DYLWRyJt1PWq6locO2CumOXEtD7MDOkagos2N5NObzC04qmYwEeEfZa5mSALBv9+bLTlVt//ihKTkuKWZ2j7uVkya21pwdvqduznNwMySpTtYUbOk47AOd3ZWxhfgceqAU7p2a0oYzgps6yh0pWd5bg8BL3/hOlmQVaP6AbsN1hBm09UqEpc2S/dlul1xqwHOOJWF+ZxWIIRKXoe8QcUndrUsficOg8fTOTP7061YLUuh2mhISlNy70bcIWWAw+Vyk8mLrlj6g44vhlgeR7yiDou4hWVs/PtgMDBUxgsvYE3pcDmlKZ0j+hD+xsbDbzErCq+5OmdgP4mzsUHTkEbi40O2i+BGNo60JkhkMpGjM/xwyzwt5T1MlfFQJHycp2w9TyJv/J/4jjlB9BaMmnS5AjVPQI6wk6SgWAK2gd6Ppzy91Js53N/5kbdV+yi39SdIBs0suau2WMsqoY0Tj/E8mRrzuz5aU/RMUaTA9/RvVerIHzyXEz4H+Xff9/WyLSVfVQHkWKb0jmetgVvgC9gg/4CG8g+HX0CGrOfiLQjgqHnoMZ72Nn6d3HImc2l8S9c88SkU4UPw3WAghqqSPmSPMGg7YRy8Tjw2NsJ4HQKZ4O9rmgZhPB7gZRhDOV7C/g4v6Tb/HEImtFF90W3+9Z84Szbu/EUMU8GDPiyTzZpp39rOVktlsBleawm/JckyZ62h/R96uf9bzxvZIw78OozH9uD70Xfii27HI1E3QGuHEIMqplIeL/EyZmkW0tYMUfEj0dUmYD1DAddtE+NHeWM+0lUpMaWB+gZ88uYREPgi/wKFFT4ZJ5q8UZt/c6bjHDPA8l7RyyBOUjFMF0NcuLrDF79a8y65E621v+OipZRA1eK55g4oo8HgMClLRVI36ptlCGFaQuerZfr6c+ztAHV7T39+AO6V0/Y1unIyPmn1Ga4f8J32FuZLGFTY5jUMCsLool7dft+gNhQYhvIircFPluqeMnKf23DfUDmoGh0Djak85PQbHGUZ8cFfjKlXAHLSThl4ItfSuFtyLbXio+wcKm5HWB+ZTXx8bITXAl6c7teRZwGC/CpB3k6k3wooWh+UXTAqXtCLFi/okCyAbhVcoXSJnhdn7DWIBv/oGkzNWXouKNwEiCa1VDI/1NKET3Vba0oXH0oAVW/rRbu3daXsgrtet7JB6cWNM7iBT/3rM5ii3R/bI9cNreejeX10NBf8ij001AzBghZ8ufE15wg35icx2++Dh4170D7rVAcajR7C+5xq3udYPI7Cr28cAFP12LYwbhS+J1p9SK+7cs/GgyMyZwqu0wUQ91ahcrcByA8nLsNcmcVvdXmf1JNEZ2rJ2ltWhKLxVz+ip8URfbnm0uszkPRpy2WxFbw/YHbefnAAbv4aOwQCjXQZa1ke635d99LvquN1u7nDmwdrn3KnV77k7T6SmjNnjkJ6Yios8jN3MeRgh0aXnIZmBgoNLAwmf2IDXdFpMBv7ZoAmc9KEJZchwRVt9v4DPzHwyg2EzTmEbsyRx2XwNCxLP7Fa22zpME9oN+vaCBKm9tIeat1Vr5oHUzM2i1l0L5wHBNVrXhWK+tpNIN3byG/2W/li809/Ler3noHVunnzyolnzUKV3qFla29lVD3MJoy4uWwrbCGF6PSB0+EP3OdpAFST4KB+eQf9mpy6rKm5kBllqQtPn9Bu94LWbz3u9/k1x8Jfhh6EXa/BbQt7jamsMdVvTA3IfcmEogwrBzxvOqYLpnR2wGHpkF9QFu4EBuQ+d9py9vu52N7waQOvtfG9Tf7F817CWwPjxk54wbHsMH7DwmDuJOkRIHiW5DQUjaIzoLQKn8GtqJMVtkPpFbZCHQqWZAgWZTEPAo1VXeQTano4wC4s2nxyw2Lu44D/O0xCOIF/zbJ5UlXzGy8JNO6CnP2+acckN0Q5/qZdEZznFY98wWXsJYc9/aYXvweFYRo2cq3QqkJ5ilg76mnrXDXNaeywu98/OtJhXmVTb4UOBnXDZfQs3bhZkhr8toFHWL79vPCPksRLmLCKcprY30w8AgVgfeVvaImCT3IHMyjoQEHt1rFoo3jTNKtVUZAZEBK3oKbncf4/j1XEuJgOgo3RKR4R86qZRbwyPfjzI+0cwoXhxOMMUvj6Om2mfyd/BMsA5LDhBfHUWW7WGVj0VQneFslPpgsnpVCwrMVrs8mETokXjSrHD5IsFMhC9/vhUbIAhhaq1Eluh57WExCfohdzess6tCPgO6EnyvOltJCx8tmGgsy8PFC57eFS5Q8NAM0LzaT+kANfPgyE9OUv9AyxdzY9de9CunF7hTd2BU++JhnoqtkA5ZUfx8Is3ixZ8eTl2LNDT/K2XV0swC28TazYtUXjKHRt8TgKXV1EAHhQr7aaV1swtLxiiRU7VA/h6tADKWbEE/Mc1dgYDMyfx8aA4RPZGJb5Y2zcUF4cuez3F3UWF1mzKn6RrFcTCz6HmvhEOFKd2H83VieyatWJ5HOq4ygLTac2STm6cybpF5id6ihLfolOyC/Trcxc96yZazZPXbc0dbl8qcxe3FpQNrIoLBLORyD7VSAQCVUEaMyvTQvp58paSF6dK4vlj9fKWVeouPJ6DsLVMXb5bGyTTDlTFdysrL/nwDYxAjq/qvwifYsk1AFlYdW6s4CPNO+s8uX21WXvkfadBXykfWeVP92+1tE9C/iB7TsxvrXVKC9hWW2LUo7Ksur1tmBpAiljqa5KXpqsGrWrJkX0yNqk8LQsTnWR3qghNarlxSU3vh/TDO8lfNzo45EiQwiH/JJbbnirQOhXlauF6xeXhU2vKoGUm3XxLq9ZAYFapGjjy3xDtpRz0BD5uCIQFVb8JmTw0IROi7rQKb/c2zDrywANbD3DLTVI3mqvoRGggTtnxcaiMqLoaOfc2en+ubNjDdBgSibLhvnRqLZ9wvw4orxd1PSTRuXknPlB6qhKRCjnCEtpMz/r1upGDtQmjVa4PmkaHqOujaiXy1Avl6Fcnca1hxXPKYvy4+aH1nqCq/TWFWUb23lW4eaGnlX0BG8/oKVnFW5u6VlFT7b07LE/q/D5La2PfrOoyyH7/bb1OkehQOri7iyQckVVeQSXdktP4Tevd2rPWpvTsEZWV1CeX5cR9eRCOrdIFHxssh2fWMdZ5OhCdHwVOr4EVenL3WRLBNY3Xw+is1O1DCGVVBAMHYGq0i/PqNEuz6nRrQkZ73cjsnJOjVaokSNTRr3y6lhekMCuxXvHL9AJJc2tqmXqAXlQIfkWGOVlObHOKcs1JVc/0GtqbiyeSa+s5hdtL6fUNEK+tg3Qg46La7jU8nsEyydc+9ePxKf68XPtFaNmBSY3egsiNakqnuacofdk6swKG653OI6lpmOxIGUlDUsawx1yb4SxMW6e9eA72MzChymcNxh5xIIqI+P2MXLkqHw6/cVzg1MVuKPxqY0W+ucGdEGRpgFg+RNFU1cjOTucKFilOZ9VD5wGuo73udOgpIX+WacBb+SpaaD3pHEatGGBMCQNh/zS06DsZFDxL2gye5dAxOvFJPwdnAzCwskgr7R4QJmEh7MewP3iNxkkIYwqYdz6M+TiXmmbr1Qp7t8DIrM5J6KB6YbZE6BFEIC8CE/71aVLw6IQ8OEEhlWldKkgH62tUmV26gd39ybiATObxkHEVE4Ra0k9cFa4SNeciuDe7JWr+wCZHhrnb5OUx0X33ks+JiXvh3ybXJjAdc9wA+kCZlKIYuHc55OpsTWwsTOwwXtmYIN3ytAltd/wgjqbgr8wBLCRO/94s3RYqk0nAbDfh+s39I2Cl+9CfvMPoDh4k/JLwlN3Jo+QgYtpR+Cyv+GejaIgkQj0I9FKsLam+FjqrP4EWbmjzT9Cdlfya4QJwOMI8TtR4ClKr9yxvKckok8Qh6c6IrVgyYm8kM3vJXtK1WsIahESTylzWi5PMRFeFDdfC4ZD+Lq4AqslyycIwRl1Xn3QKyDzKZvhYOpaxtaYEdM0g6nDf+/3A9QX3I56C0tm7PSMHepdo8uC/y3TE5FFAORyJNDuNLTV0ot+S301tHP53G2BlweUmxFRXnzwbE6CVXJnFghwZSaUrjcYSKdQWKVQOGUzvTr+/eAaS/cnDFSJ/3BqcsPYv0vWYe0edOFSNpDuxo18ETTzhWyDu9+bHumLrG3Pt8To9AJ9DDBTELueL4epDIHw/XZs0p5vOeUMy8O7sen0/D6tZLDDyb6LhH+Rzpu076Ge3zedPkO9APU0FLuxKZJ9S4CVsk8TImDZa5ql4bb+ulP7DXZzWGqjQ45esS+RzSWnLvabkhJYTWfskaN33033+N148GgspoZ3DkneMzcTq23T8wBafAfFQfyF74sBwl5j7gA7PJc1l70cYedyxCH8FohvBX57i4O2Gi6/5RA72dP77VAsE9s+s7d9H+/k967P7F0/wNuRlm/5lmtv8W5UgrFce6eiGAl2v0kzMxdMnr3FI2Rp3ztr1AvwCKHD4fDwoAs5hR17azlC5l+OsEcce2c5UlhfjvizIbsfPP4AyfYHVw0tu/KvBuPh2IdXD2rRCvK3dIiIfTr5VgY8pfZ29Jg4UxGUaguxyLbDH0TCUCbshhaV9RegO5EjQUc8wVV+KQ3PJ3onGgJxoaMoXEt2Ay/H4whZ5doKceyI3bLIGiqplcIVbtOxRdwqRdFcelWyFYERnjfmb3MVoZKxQ5PyM6udwdglc7i3o72i2hlCouVXk0cK1gr05EcFtJXfMAnN++3YxbuxJyNu+Yo/gwNm2IFL/zLm5Fp8vQKKIP1NfQd7cOkg9M1A0OuHoABUozMnLjib2m6Sxjx+VEgGeFGoOovH4SQEVUfoVlPwu58R/qSXWU5jJvzHAUJY1q1no8m/cR+TwGay2dK6UHpQnyuH4D0oG+wVDebDz4gDMHI8CmEna2Jcc77iePIkNJ7m+q2X166/aJ8/egMaOGFCM5UtcOsk84kHRQqSBWQA3tOKZOHjYBJYFnJlE/xpIFT6bpfXpSfzeif5JTBAvyADfE3cgk4S7fXjxWTBQ/YVedPFjJ/9i/FoyimlaI7u+lPlalsqBSrvOLh6D1QcOIplX8cLcRnsw82GpsykCGt8OHb3+9EAK3qNvf3+W6xqz53DYTQOop/3qySMs4+JwDaue6aDbKf2tk9t0BdBslN7p762I8izVN5Iz5NzZdQT34cDDtdPtuH6SRQGMfPGTW9V2VtuF7K3o/0e5B3/2I0OmBYFPyard9DmdTOGvJAUroCMxzPJUXNROnoMoFeyd8O+Ermyg0PZtaHs1FB1R8Ed6hh/KGGUiEYS0cgqahiJjBpGWXSk2lA0qtKYIqOK44Cj5I6l8PjesyRJx/XXLxvWVqizz/HrKyy0sc+7PspVmvutoFaRiXqq7ksH73jrrAKhnqva9h4+K43T7ultxw7ntu3QErQYO5yn+LK4Gx0OOFUa0zM5Ieqd5IpFiRIwT1wtPW8Fr1x2byr7JztywOJ7JL5H/Nuxt6JN/IsrMmPX3h3g2aRiWjbw5lRiF8gUbovmukcpXXzlTHM5aiugg8zw9DhWlX68DUdqPVLnJ7W4ubcnS7b39EFtP1nNcQyH2UxIpkZO5g3kWl2fcm0P78T3TnxL8iuIRxWIRwrC4YX78PCpYD1eWHxXIIYViOFMMOYqTcAQB685NG0yYL72HN7NXc+xd+iykA1ORe927F1ZHrg9MSPg/+4g6PFM6Zbj6m5XLbCeuF48hWfCQCv1Jz4Y6CEryN/LkW024Q07TEFTrOa4IkcZ6AN724PhCuxdj8J2Th6fzGX6XKbztS/MX26St9HgE3u53lnJKIxTyzCulIgaM4oteviYRN3u4jG5PmC3kTa6qs47CWTkGdiDGD6MU0nYc7xeAZJTmr9XjzVZdnHhHQ74mlR9B34NWLIkC3kjWwTm30SR/HapO2fk/mbD4FXzIU7ZGmIPXAwPh8m1tnENm6+zXCg1vRT3WQ/5L0qOxYNP604Y+0kqnuHgFtIgvGWxvPIDezNhjYTTvYnW4DDfDuBBux4+KZitEgBJ7IF8k0JEuoVdUoFMPim9mbQn4l3+tTNmmD9ZohKEAU1Z1HnTygFmj5BTK3PAOmV5Y5rf7SuKXAW8mTDXdUwIzs1BuSthpCmj9WeweQzWnz+8fWOLE7HQh6gBuW+7aLrNW04I8VG9X7bozWRhlxQ7iB9nOsTFjCzsJr2Nv4drOsTjENXVHHInmhwQ/Z2bTOsndmBDgUN13gfg1yLgRCglwfR61jQWskM+rveDhDj8yjfyYQKQ69/hkO66OKRTdfLmiI8/5q0xl64yHocWl75t/hBRzSyqWzJ0FxM5pHemob/DxMOXVw9NOAA8TGzAIll9MtykqK8cqrXlv/rSdwksVxwQbmjAzZWZgqxed7ul1/fWCK9J8zuAKQFRcGca2gNJBlIUWoZr186fKbJlAAfzFuFbO1y/Tm7DGCI0YY/cVm10Tb1NcFPnVtwW6JDrPpjYXBL1PXuHTTj9ubW3+73Lf+zgQYslpOAYPvEtP7ADKQRqv3vgD7GuUv7s1XPxVthVNcGEB+XFAPJTMjhNpDZEt4PjSxpQQRV4u3osHz/Q7U98hWmiO7q/AVQNdNfpNJShHBpIUzEVS9JM0ivz1EA05ouxMG+B7W7traXarD+gZaCeeWtv+0uEd+Nbe3cEaNeP0QHfi7eXx4b+ALWBPfVMV6W8er7LQPzKTSLukq4uCHFRt1uM3i1X3m7tHSxjjTNos+Lzx2maLW7j3OBcXYks2TBu5g0w7zVnqogzlc5gSeNArZoG6njDk6aGr5oaXokrebaM4ZO2FJ4xX7LPweHCE2pfQ0Z9wQnZElYP3n1o6+mV6ZHhJci25ofuDDijyB9PvTMN+eidgbDSpvNUujVgMnOW7nkIlGJ2easOKqsEFRUWZD2fA3C5udqoMS4GeYVwa4+cEgzOeTK51rCzxpaL7JOix7G3PXPY95B1q2wW+fcO866MWSFY3KuyaNEkzcu4Jmta3hzk4ubrrgSLhzBgUyANk6LHg6vhpWSugh/HtZQvxKF/MU/DQvX7sA3fGuAIL3GME7zCNzjFa5yJt9Y2hMKz3/iOuJPz/MFaApWYG6zEeIjwph6ew3j+9vVr6PUHN03ApX8BjWyE5MThQVxOQgnl1jsO5CV3sYH9ViDZ7vlxLLDG4YDDeInLfRrbQGHdZRyS7zmAr3UrxNGgJAUhoS1NcUXqlGyFLFHyOJSk5HEgSclWIEXJ41gEJTUytoNySraeb/9RGzb+GOw5GzbSGGXIfFo6TVjRgP2zD6fJfoZ3MuGXvmNnyepQ1/+fm4b+IK+BKjF+8VwdD78gVDSVrfGL/OVMeTj8rLSBuiNDHJJn9hYvyDOuhz7jeij8DohnvoAnUPENCewtTklg78pHynIBquIcaWXn/NdwBrIdsDDAgl0yt7fcqa1c5zV5JpxYcESeyTVEb98NcXBKGF4TF2fExxuxStGMxiMz66d43b9B+LbprAdydXvuun+DRw2yV2h9VS2+eQA0Sss9lgIqb2bUyPzW7ZpbkN2l7dpvCOE7OUCjsXIRV4jzAeQnnvXWyquHDcM7xE+qCiKsGM9TGhgIb7tdcwdAx1bEZ7AiPgNHkZ75TG3antk78X3e/qxCkvLKx6lxRwY6wQPlpL/rdk9zPX6Kf8R/wz/hl/hn/F/4FX6N3+C3TXNhC9z5W2Wv/BvCv7XYKLbcRvG8ZqO4yyfTjzmbO+RHztw/2jv8E3lWVWKc/g1m/ZRrIK9I2P/J3uLXZNH/CSwOry4IsPh+/5r/kBaHYlI8y/esr/Bu/PqA6uNatvj8aG8L7adQ/H+0d0WypvrXsHmcQ1B5rhd9/Zs2pYteu+RvfEr/zd7hl3UKmDfWGl2O6u0yUysrZZTUtacNmBzLbcbELL8d08+6qPD7DEO8iv4G/1eTsIB8XVi4fQeExeUtdshL3uWX9g6/IdHlf2GnR/4LM/jzllz3f8ZB4cvY/zn38lmHMf90idMLLNabY5+wXtB3enMsbMY+fkWc/lN7a4X4NWH9p/bOWmDzDWcKPmf2+7cXREnI/f4U3yQKFK9yYVrhJczzx2+lXvvmCDccckeDRnlZNgJoc7R4uVLOQxmIuyLfGqb6FcgnOMAYXj4/tpn2i63K88atynN9qyIp0WM8WIl/qSjjEM/MG8X5/Mmp7bXTPM+clnn2vG2L7RdDfHqX9AymXV3ANDWlsSEnd0HPtF0QQ9YzfRfEv9UuyP+kXdDzI1tonMTPkuUqYpnmR4Dud9ys9hX3SSFe4Ia9Et5giK2/xTv8G34idk5PCcXPiIOfExe/gGOYz9s+PcWC6dcZTTOuQT9t2F3oIGKD0QrFYk/teVphRAydk2ARo7fsJJRYdYJP3faUCXBG90/2/ZyOn9Hr010+2//20zcoLSX1UN8qvve5m5uUxR5Lmba9yVNsEX2k4c6Y9hRzfjTn6U2vIWl7/8PkEYUyGsYs1YMO/fTx9St5li0vFBnPcjjomA/H3vKAXG144Aj086+zCQK+LK6plUMym0gddIuLfvKpI3m/zpFfSZyxbbYm6vzTS5ayO0VasgKqrolXlOH9I3pukXzywlme1+0Wv8tX0NZmkYPGrvaQ39v4g5syFoMrSnG/s5a6SJxaB6RzAjHUoBuW6hAnJFy3q96iqQXAgfVd3ncOs+dvX5uGYBwDyzu7CLdkr10WM5WtCC86RcppHLICxyP9NAKisfmgGnkzz6jxC7SsjRRc7681jEdhquDhkBJOmDNgiIMK64mc/X7acFLdZAxRkRKC6tWrOSleTs0ZNpiy2RX8GZdQTSFpVmqb8GWAGGFzszqdyl2BSVjmNYRU+Lu6+E556B3JnNyRRgTjEWQQuSLaixAbsKhpuhLkcIzrQu43zYxSIYgDxWP4nCykNcLUwhhXRavm4SKySrdHHXEPdNIQZ6PQcWBGr0gh1PANqV5kxCkxG1oJHkmVue1gIw98xc/wSWM+j4UFvNqcLaJogfWznF+WZg6+F+0YN7TtgCalooC6EmOLH79qrKNd8d3vy+nCjALwcKyLdIYrLpeatGko8b24N8Gz+E/lr8xTxG+1qnBzhAxsy/IHwkDuIiFQ7Tld/5w4JoObKiIFdCyRxIsdkd9lWa/3ATT0LGXCTapEE/1ylqn1QWs8yp2KSxXAVRvsF0+X+NLjLJnCe+LiTsDU4+8gpMLzzyWr/GpsS+GAl8EXyTRQ7wp1u/wrf5AxsOeh57F4v78xFRAqpQnQIq1OMuk/1fDKqYObli+QcRzBr5XlD8dko2R0OWKbAR6T9ZqxTyLZaXzNr3PkW9ci3YrBQVPTq4F6S/VgghAMHCuEwpmEOe0ioJ25BGrtVvA45BK0IGQGeCXGriCYllJ6grS84uFN4d5JSN7yq9KLhcC+0zmPxj42r0loDdt7dTFAB6yXIgss+Zx6HrD5HC80qaxdWWnsv9tE4uO89YXpA0+rr1FDA3nxB80cPm8U05pLMY/KLeU9aFA58EbwclNDhK7xO7REVrQp+yRWDCtiGavc7D+y7ElFqPHiaH66JB4NkVoDeDW59jrbReBoKm8kG9RZJ9EmYwZ2YbaLUOCbjMGJFl1zzQ+a0jcsB9XU+uKl+0Jlh4WBxd6zeRh5pouw0tSkwesVXMB4RtfMLJYhSSiODSTvM5FgGiOwdp+lA3ANpiF6k7oKK6S3TzT5jQMyBOZwxNUw8RCCJuvVvkZAExeN9cxKpxN+vPXf1TWiGewnUb+jN83Vm4a410CRu9/7F6QEkIerqA4Jqo2RN5ODLntlGautgdvBVH8FnDZ8TUUyGryhS9Y8sFX0JfbirTEwmIKhIoRPFxEtM7Cbl4FXOINuN6cy28py/Pp4AJchm94qOMJKXBWpXVKf5JERa/Q2nj15848nHxoJRHMCNZGEznReasp5aOOrdicZDZy3Pg943XDg3LYnQBOHlPckycpEE+Twmszyq7QS5gipmvq5oimLeTRb2TQhOBpAUel1v3JeKStnBDqbtBRphD/gFnuRvnIZ6ASU2rkfh4qkqv8HvZh0zALGw+x+pgGM4/iT2b9Ktpy/jGGaMaxi09L3QcXbP0dJBlPjXZoEKV2Wo9YeLQVT5eGloK4fo4Rm/J24B1bXWrC83fHwJ1jr+LFSC4R8ivJigE6Z2AosD7aetVrjWpulxNBfxrU/uXEtfz3mAba1ShmtCWaDUs1Ftn4XLU1ctl43qBNY2tR041nQFC87KIkXLcZavuPSpiOqvj6s5YG9pFy4MpdR9Y3ihsKU+LmZpeWRSI9HY+E7um5XdEiQhZeb5pmzq/wnD5Nv4JaKVRyKeg655zjhwh5qKy2qFbxK84dyoR/qnbWH9oOX0/vh6e2vU121v55D7vPXJFBbaVFdtf3tg+jzd8zqdPdmmJKjNGojRFshCr/aB0fkE5APPOPRiGeZCn+P2e/evnzz8UOP2U8+fnz/8unfP774AKfzU6cwEKhhokVACNScWbXMsfyNY8jGTYhwEwJ8tD/YaW43DpTNtiznTszX+mgJ7ipGq40jjo5WQ6HSaLXlf+5osfxNak7zo3U9mJJHt3FVydtwolE7yeAExgtiajK4QWmAe+tN9rmIVIzd4nE2E+FlkyB3yoIcx5Xzi2Xl/CJpzpfHI3jVnC1PRya1zC9xgJFoBxjitAFHJ957i/DJN+QKE83lSLf7XI7ks4z+sdMMv36a4XN7ZYJqBvhlswHebF+eBaNM3DYTfVgfBc6JNXs9JbXQDFXJjWnuw8YfYJ6T5tVzpuxxVRnFM+XkCorXEMFSHlhhby5nG1bRtS/n+lTGpaDE1ben1RZDVKJL/XIOmXMNWCaa1/CQ1uOg2zWv7c1awbYVRnguJ655jVtgMMNFKN8S8THnru04wmecmknfs9I2DVIwWL2UU+C44nzAR+ItqIl0uYo010HuVDQOsMtj5vr94MD7TRop3e063I6rgiA1nHi48sSjDcWV6VjWOWzQzkMPZxA0NgPiP7RQ9VjGhXiP5WMZ/hhacSyDDkgz6BSHNH6rztPUS3/WwEF+M+f6TWzbSFt/dpqV/VN87M/akMtR+9Mx+AFxkbpC9wvbiVjs/biJXXNhf3j/7Ncnr9799AQv7LdvXvz6+uWbv3/4NU9FeGGLJ6fNhf301Ys3z6sCvaoPzRt0IV9TJBpGspJcGckFatGs+UguaiNZwaaN5KKxFW3I/8QjCaeKMRKH5l/yIL/NsYFiuc1xsdqu4fP8GoKmQO9h0UvlwxGWt+kHTNEBi0dr3OqjNeXjxwZ7culAsiH/1BFl8DlHlFU1tumEsnj0uP2M0jtyRul92hmld+yM0nvoGaWnn1G6VwbbrlgaQmU06nMqGGM4uuR+OmuW3jKprz3d+D5LRSTUc7YBn3Co+deB5tc80NSOGNsOHa8ecPT58INPUUIN3Hnnq2dK1084hX0QZlS1JyjSoTNIexuyu1WSZkeyzAGWISt4z89tnM7c55Z54H7+z3ukXLEWyJ1xU86zt6/evv/16d9//PHF+1+fvvzY6PbTWF7kNJc/Tbu/TrS/yom2pqMa6DjQlzjP/h2PsyeSQ8jFheSP/2bO31695xBhHMh1ewLx12mbFqLWCDCwX5gXtOSUJFZ41O1W0hvUAITqx+se84lz1Xxg3uzgcRZNHzDQWhpvzb3YBY8f4WLzO/4OS3PouOoJAA91i2e49Tp92EI8SyKYKrYL//d7ZhqeuJQGiHgmv1M7dS0LAt974pVF/XtX+RbPLKokX/0Y4C+AZNTj1oB3Ly8ffQl03xboDliM4bjuRSF9FwjnHbGqvkpcKjU8g/6q1F4D9hCtUGthmpu3Q7iC3DhsBxExkcCnloP8PQ7hQU4dZvNryrjuLRp0fQRQbAu5e+8RbHLRXR6B4WqUgSaMUDkthd5sgpURbC3yk9pP3r9/8otcVDDj2TzrOc1oNdvF1H7+y5snr18++/X5+yf/DdAbUf3INxdYhv7BXq6x5vlD34zw8FILPyH2sN6RmDzl4kuA1Xa8Wq4wvD/yb81rfDHEnrAzbwFCmB/+wdKMbcXwiXMN/1hmcCxzfiwzhMxbLZm/2MlS08cjTO0fX7198hGrGGCFpOjpZy72018+vvjw67sX73998erF6xdvPuJBG9oADz8D7X+2oZ1/FtrhqA1v+Hl4vwe8cMrBIdYmtT++f/nkzd9evfgAzJHRFMJDYXCN2sTZfq82/5eVitABazaicXMsVhXgsiSho4R6H+bUg6mDpwZVGn3nlrmjTiF4JgbWMrlc7wiB05zDBU1zFhcwkCWZXVSlCxU9U5SSQqIpg0sGPWNJs0cdJXog45amuzAOoJ5vO3nDtNRRx2UwnHqyQJ9SL9yseTrcil7SMDZR556HkIOcDpFU6PT0NnKcinQd0jFVczo9qO+RWRAWd4YI2dsdFBOt6JCiaE/vYJ4N6E3xZW+xAun0SkS0d52+7BeESDGwoTUo/2kZ2BjZg05PdlU0cGS6ydqUQ4VwZx3G+VcVlZn/vizV3+l1AHG/M7QHSKEd4k5/yDHk9Mvr1XoaRL++K2qAcTMLeg2AZAAlhsjlY5APK/9hOx0CT+SaLu6MvvveHqAJh/OjBKyEnUuVmhcIHlogPbOAYLjOJemMvvvOHhQIaId0hiLhYMzsRRLGpvE/sKBS+x8v3n988c9fP/z05PmL99XHSCtTdpUylz/43VkyL9wsV4J1vwLjwy/B/AXCgRqfgT3AndIflM+EZYd0gujXH1MaQIAFz94WvFkMpBf6fsEP/Q5/eWRpw5yBv1Znae/4b8HNod8xeZHHvDLBNaIKIDgpOsyiNWvJbib/j++f/A0EdD4AXm0AlI2d4qmD3RlceTe/uB7u03WWK+JDXRH/9qgi/ul666cp739Czfa02ro4R229PkNtjf5kamt4Qm29/jy1NTpHbV38TmrrX5ppTYOUp9f/m6iPf7COWFH6GvQSAxvmGepd59LAxpnqETawMYA/QwMbKNeHwjgD35sGlbNQngTWv5SjT1eOGrSdNr3iD9YbyjZYLa1kv/teVxv+A0sX0ga1AXzDxHyfE5NO/SIc8BDiE4fEmfpCnViIn6BJXBNXpUbEValgyBFUgps9SyTfHAxEzGClNfDHAg3huWqMl8RRSkbQpGRoj+4ZwrEVyrjnlJEZ4yUpoF4UDTks29SeJcLelIFeFKofC/XjWv2I1I+5+jFQP5btwHWEefHh5xUffOniD+r78POKF7V/jk6pdMHgtNo5PK5VKrDRcZtpNg/d65it14Ve2QS2DOPN+pRWqeuo0Tk66vIk0E808t+FBrjongf6WjY0OUP9Xf0h6u+Hj08+Nmq/1ye0X1B1edOP68DV4OZlJKtzdOCoqgOXIEb+rbnE+TOzbeGr1ekBf56wEUl8Gkm/huWPsCK3ZS7aNe6vosjP8ehrKPJfwhTchHfxWXhHg/Zt0mfh/fZfxnQ9LG8xKrmj5g1ILtGbs7kk/5NsXbSMUacsx09kv1a9OG/3U7Vqa1TS9yL8Ymu6pBHcKdQI3enrg8J3C7xNcutEFFk5rlozuaXaHPItkwRENciKaTrflOVpVpUvzt3Rfdn93F+bs3+Jzdm/fZXdWcmqO9K3Z4/O3Z417M/U7gypndqO79Ty7dl1sT2LStuz6KztWfQJ27PoYduzqGV7FrVtzyJU2z+Eta1Fw67jd9paFDuL04p+eIai/7sakefH1OhCxw3PtfMGJ7XO31ED9D/flBuFMeN+0OajmiL06uWbf5Hz+4fpKv9iRtO/Ftn/cy2gNE2Tu3yR/V/6Ijsc/l5GULcozy0vxZobn7Xmxp+w5sYPW3PjljU3bltz408ziS5rBr4jP+JPMlwuaybI4devYvDnqOIzx2L49av4PQmVp/SHX7+O36EbRS8+09FieFJxHR03h+t27mPmcPlGwBFTuMciuitM4ces5VE7yJxRr7CAt0G8KxTy+LRmn5yrs6/OMc7fnG+cTx9onF+fYZzPjsDEicfUfafNETi+lv4kKH375zL2JyeM/esvYezP8IO8ZS4lUBnJBn9XTrg95whhdfII4eZLHCGk/1scIbRlXh/LjI5lLo9lxr+7/9HoT+h/9P3XOQ4ZDb7Scci3bXivPw9vK32jz8L7qPXmwfLz8LaOW/xZeL8d/CscC7WeCH3aYRBXd5qzuJrz4CMkUFrac959QZPOyeOnXEloysw1g3+Rg6sO6VROpvJDqabzqPy0CaiO+MEWWK4+/5gKLFQdSyLu9DoCqz62Lch7nYH9vaiiBl9JyG1k0CdzKDzk1d2NDpG97li88mpP2472SidqvB15HzRueFh5PkVkiiKFcHS81PmPQ59RCfSy5DZ53uHeXybHv0yOXy9seuk2PG55g6f5TRzMiGsa4h0gA/EoUz6hU6YsixP/B9cUTzZ/nKdsPU8ij8NBd+KgaD8VVXW7pkcMP9zyvhFVVuzYr9zcGviqSB3rQHKb0/OxY/tJnBHTNQ348QGeVREotE/L6Bhjw0CWBw9+dAxLZsMtetsPo4jDEb6L0tsjDZFX+c0Tt8maOa60VyZL1B/hBr7sttjDpfDmg8mptzVmlm89QrWMnTGzvMtH8GzbHxVlnzMGf0+7xi4i9Ri75I+BuqbBoX8sRmO/l+SX33hRY65rQqeL3GwdfQlWuZ4oVgmvwpwhIskQpt5OrYkGgnF0WBDG72g2N08wDH/t+unfHsw3vBzvQV4Yl3CCYEvu+POijr3mH2/5+0b/hAcHSim/aClPo01K/jP/5KhJA2JZJ8JHJ2yJSRece/vR5ag/QpjVMndFpq9nOvaS0fUmZfrEQMKAYkWXI+va+g+EA71IZH2bR+qWKZcjawRjAY+YfExMDzNrDt80dcUn9qw5ZpgnRmHMgSwfs+q3FZRS5iolR2QFmP/tz8vIVI1ulKxZiTdMlBP87DEa8PF2ktRjqWDixwM+1uewHvx8yos+mPHeVIuKrufji9VY4mur0kA8wPkzFTwyQDMtxLMnTZG2prTxPbj89Y/JUgqVY1x5ejp+mhDXi56U5IJW1nVNkgvaWdEDJPlDAr1UKdbyoF1Fuk50mh2nSk32Cd7wFG/Iq5z6Hc7zuOJTjlsbo9joiQ0k0N+EkV3FAWGV5WYOKdoRKA5J4x1TvCCs6aSSH6f66jh1XjlB9YsT1LDptNQnbjVfnYz6ZHEAg0WaXDMxXj72CrcPQqeBdjiMvdJoeUo6OtNADBj/sQP3LE/JMVfluVqeqNBEmJn569jqBe1ut0R4qTnm9NfI/nXG2N2kt+yvUT5zlPNA/8+AbB8TMwez1Mijy5FlqtHv54Uvv8Wm+rDcPHVk5Rj6BYZv8VE++jqcINwr/ghOwNfVMQGXizwBL0k+GDFRRMQJyYm0IopM+IaM7O961zgV+g1/byA/6kn6SzxCVv696sd4xIP4Li3IRD0z7d/0I3SZ4ozEFgDoaRsioG4uU3xLRO7NZfqHcfJ1G/MucawJpTXOSmLI0xYsvw3F2trgzLrV0Vj2971bnPXt73sbPb0v0q1qeoFBX7M8pdV9PWkIDEvJWTNhQoUI9J78y7H/Q2TPijxE+twUUystptZaTq2saWrd9JPS1Er7Kz61NiSxIBP1zKy/hmmU4VuysgBAT7sjAmp9meEtEbnry+xPO7VqK0GCV3iDbx8+0TbWHb61ttrE2cBM2uJbmFd3enpfpFvV9AJD00T7fQ1jy9DzInZH02NvSfKEPFsrYqeMnzGcMoSI+O61OO48rImWqKJAxcfCrLsQQh7hhMSwI9vE3homtt7ygGVPIYOmIVvrgVgo18lXJLGXYfxPfCN+/IJT+EG3/8Rr8eMXnJHEhvn8mm5hTth33LMBvm6hXvGGL4LJfwff8uldSOCPki6IkWyydSg2ZLFpSDeD17BFQ1ci2AHdmreX+U+YgUOE74qUdf8GDxEa56ERzoHG18SMTUOOy8sgTlImrUWDMeQs6RaEnEjb7zN0uUAWZEBrX9M0CGNIHyCcWuQar/rkGq/h1w38+gM7Vm87pIRxkXJVBRFNLMOYPhnggFRBITAGqdGnXy0Osi+oIeWFhxD+QjUT1otyM4uUqypI3kwNxpzzYOvVdDQ256qZRWq/Bna5aSs8xCE0U7xkv6y+ZL+cerOpo9Yywj+p+uzNrZCztygcVQtH5cJRubBvBVhBbI0ZMVU+LGdmaq3Q5Qj1FjnMToeBBdJcWzcC5oBbp3u7JCI0ly/ggKcE0Jz0h5dAav5vQeDvNf8biaQl/weS10VF5/1q5+ck51i/RAc8RxrRgmq5sCgXlMuFCEf1TO7iDMpJETFFy1mARK2V4b7QIBpqZSDnOn96ek7mINJCEsK/+1zojedYCsNxiEF0jhfw75fxNQaxOY7g3y/j5eEPMtc3LWJamu0mq11TQHbsYQatcSzDuCDUMgx0L6KnNaxULriF5qFzJt5jd+JaFmJTN2dp/ltwNFbpOy19p6XLSaKy1ElStXbFsidqb8R2OPxBUYx5/EvKQ3+FSWynmzjmUbG4pu/Ung0nA8WAtWBRhmF1LIuCTjRpxC2j++YF5SaAhzN29SDG5VjEoli3W4/V2+16KshxtZaWuMlGvFk6LNWCL9vbbrchddeYyo/MG3PEY4+iLR/nLGWd5WaddRzWoVknYnSddZKYdW5pFHodN0lSL4xpxsSD6KwThLcshlwZmbmsizFQoypR5TyasTfJnZkLhGtyvx279hbvxq69k0FjXNFkzJs3dkUzD3hBItvb8KwYh1oY2LxHkc3oOoyDK71OkbSeqsz93siV9Zfx201mzMYqD/tV3nEw06q9Mht701+iywJoPJywx2R4ZbpafDpyMcSuHSQfE/N+O/bsLY/R4dnb8TXvvWfvZMpufJ1TQg6ezBFp1yXqyEGUECLtWlIMYRd4M3qi2OzHlC4Z+GfKJ+OPTaZpMMMNkXYjO4mfJcsVlO929S8Tln+HhCZDuNzzQUPPr+2tZXr2tn9tb1HPqVDh2t5B7q5/be9EbhNFJCUs5bLblwmiRBuVJHWgFP/RlwmilKJcS9ffsDtOxG5X/4JV3vbh10uPpOxmw9ZZheg+vKAYEAdAyb2EHbfBYrFjhTDdPNKsEA8GlnEYxxH247F/wCcGkMxx0AJUDq9AuUAbAn1y0SMWgv2+JjdoTXoBsibZJaTx0UbSWe7h1+2ajfxKz+NXR43ADCt6EUIcGQvXdGSo1PKcbBpo01HxLvlGsMTweVaZ8UsxLRoo/aRC7Ny9kats9fO3KzqON1GE/Xqs9zwvOEpYvtDD+tQJEOt2g6krwkNdEBDMvkrhJLkgxN/vzRMj5c6axUlO9/OGyX3Y8HiW9WWGSLGZ1zJMc7puGqIjg+MdGRx2enAcGByGhCLR7TIVmvmCEHe/91SKGiEPIdGDC6XMXAz/MG147Rot4ctLNFS/Ow6EsoUJ7hNja4Rxx4HFk16Jf/Z27OOAGLt6zm4cCKVO3NyUugVe4hiCzCfEt+Z5FNYVCax5HoYV35C5irAm/ik3QRPuf+K0yM5jyK2SMM7MG3uLb+AB4tA3UykGZeQ5mK1pscESKnLomyFJgbEXJFTa+TUJlUIekbBQmfFFKAO5dbvJ40U/6nYXVvQ46XZXj6/h69qKHq+63cbTikXltOIaTKo/RGJPsQQVwyODifdDnGvxsnnc1PQ45iGdIBbOfWyvV1HoMtPDAxzCnu5iICyih+V+H4vI3SE6yEkTH/KhdEsDy7/m+jUbzgUv+H0KjzvUzKvR5NwodK8NTPkII74owj/Z5quWAmAMMURQvnEwHUDw8XbAdQ55QGjcDPchowHYLIqueZ/QGS/ZOBF79tAuacXO6VgF/ET3NOh6J5nJ1/oj3SzFEybTWREFKUSe5AyIYcTTQ3J/kFG9vWpU71rDkk2m99abOrMDmqhy3W5bAdXf9dg7oEPRF9+kpzsjBkMTIj6IjgX8uYbGR4XJRE7ySaQ2v4SvV9dTZofejDAcil/7vbkQhGBIpRGmhTkL0bWIoe8renlFzCf+oerCEVmo6lW1dTLcslQn3GLqzg46Cv80ijLpfYFhcYT0ss6C9osDwv4DxsqHsQKyBxi4ZCIuCObz31VXBk1jmWzWbLMysF9JgyORemqyyQzMikTB7xKtB9NHpMuwldhHh9JxATd/aCazUliwauRNU8+FkfvTLLpwWMr9qFoXXXTvFuHYuP+lOu0UseKO5k7U9rfBNxXDSVrz6YkKb0oPfAH1TUNcruMtFaHLc+3HQ54eiN9kU09pQTOIy8+DlkISrrZUjamINYk94C/4JUdeY96qTudiRoScFoY37BdGLzX/fTmLvCnYu8TsnrqziWOiA8J6PZvsS1Qj5EJRW72inJULduMwJWac/P8DAAUW1D6hRQEA",
+	"templates/head.tmpl":                                                    "H4sIAAAAAAAC/7RW72/bNhD9nr/iKgxwgkWkEufD0CYZPDfDvBUxUKcrimEozuRJokuRKknZEYL87wMl147btEt/xJ985N17746PhE6fpOke5zC2detUUQY4zo5O4KokGGOrqYVRE0rrPIOR1tCleHDkyS1Jsj3OY/ULJch4ktAYSQ5CSTCqUZT0YecQ/ibnlTVwzDLYjwnJeis5eBYhWttAhS0YG6DxBKFUHnKlCehaUB1AGRC2qrVCIwhWKpQdzxolKoE3aww7D6gMIAhbt2Dzu4mAYS06/soQ6qecr1Yrhp1gZl3BdZ/q+YvJ+OJydpEes2xd9Mpo8nEA7xvlSMK8BaxrrQTONYHGFVgHWDgiCcFG0SungjLFIXibhxU6ijBS+eDUvAk7M/sgUfmdBGsADSSjGUxmCfw2mk1mhxHk9eTqj+mrK3g9evlydHk1uZjB9CWMp5fPJ1eT6eUMpr/D6PIN/DW5fH4IpEJJDui6drED60DFafaHCDOiHQm57SX5moTKlQCNpmiwICjskpxRpoCaXKV8PFUPaGSE0apSAUO39ElfbC9Nz/dubiTlyhAkJaFMbm/3AABOgwqaznvPnfI+6ncqCgiiROcpnCVNyNNfEuDrzSdpCi8wkA+dO5QmGbVApYzKFUkYz2aQptvsU63MO3CkzxIfWk2+JAoJlI7ys4RzQ0EaZHNrgw8OayENE7bimwU+ZBnLuPB+u8YqZZjwPjnfMH2RxccRCR5K5eTbGl1oea4x6PY+yG2fkwu4D7+0LogmgBLWJBDams4SVWFB/Drt13Zpc1zGZaaE3Z2j7Qwyd3blyfn7uL6LooPp8gbra5dbEzwrrC00Ya18N2nh/a85Vkq3Z9OazM8zNP7pSZYdDrNs0MkYbEc66MUMAl2HWDn4Zq6JEdZ4qzHgg1k2dvoa9xx91j2f+OWBduJCmoVnQttG5hoddZy4wGuu1dxzYSVVyjnr+JAdd/yblY999hDfRvWFU/Jb6t435Nq3JFWwbl2/NeC0ji8H6vhoVPTo1zbtaHYv7wNflT9xiTPhVB22Kn0feyeiojj+j+22PZJFNwd+xI4ydryOOiELn5yf8h7qXtyHdrr42GG7yNs+xxsvfK6V77XX//b01QQopTU8uohXGEQ5dyjeUfCPSidsVZEJXFgTlGloHT8SZ2Ul8QUusce58/eHEHqDNfPLgmfsmGWbMP0R2DnOnRJs4fkRO2HZh/hLJnw+ZAv/jf6TQz5kJ+yIy+E9HDc3ZOTt7d6d747c2rD57lhT3Xngt5MerCX+tC+taOJhHzBHKNv9vDEivlX7B3DTpfRpg38kBkyDLQpNZzbPBZol+n8HB0xoJd7dX9dVMmdX6aZicMB6kLFG7/cHKIJa0uDg2Z2i2ztR/N/380nj/w0A2Ol28eILAAA=",
+	"templates/query.html":                                                   "H4sIAAAAAAAC/5xUTY/bNhC961e8sJcEWEm7Rg9FawtwvS5iNLCLtdPFngKaGklcUKRCUtYKQf57Qcm7sZp+IPHJnDfz5nHmUfNXt7vV4eGPNSpfqyyav4rjKE2xMk1vZVl5zK5vfsShIqx4r6jHsvWVsS7BUikMKQ6WHNkT5UmUpqH6nRSkHeVodU4WviIsGy4qekau8CdZJ43GLLnG65DAzhB780ug6E2LmvfQxqN1BF9Jh0IqAj0JajykhjB1oyTXgtBJXw19zixBCR7OHOboudTgEKbpYYrLRHB/Fh1+lffNz2nadV3CB8GJsWWqxlSXvtus1tv9Op4l1+ei91qRCwP42EpLOY49eNMoKfhRERTvYCx4aYlyeBNEd1Z6qcsrOFP4jlsKNLl03spj6ycze5Yo3STBaHANttxjs2f4dbnf7K8Cyf3m8Hb3/oD75d3dcnvYrPfY3WG1295uDpvddo/db1huH/D7Znt7BZK+Igt6amy4gbGQYZrjErEnmkgozCjJNSRkIQUU12XLS0JpTmS11CUasrV0YasOXOeBRslaeu6H0Ff3SqI4zqL52XgV8TyLPn3yVDeKewILEfb5czRPR2x+NHmfRfNcniAUd27BGl5SLIwO+yXLsigCLim8aT5ofjpyG4gi4LL4pS4uVCtzlkXANMOaDtZ0sSkKwfWJu+kpVlT4c9m0r5M5nZuOYHhXqAcXWuIIFwf+3k4YFbs6vrlG+Pfk4psZg8wXLBS+9PlK4gXyz3yzL3wQJidh1KQGmHt68oOy0C7ksKxM1rX0r9lbUsrg3liVszfz9Dlz0jTN5SmL/u34/XL/U6ZpfdN6Bs1rejll365wPAwrSn8Io37eziWQhM0P8TE6Br9YKDj5Apgac0RH+17apDDGDw53wsrGw1mxYKkLL0akjy4Vwyf3Q9CUPDoG3ze0YOF+6SM/8bGKQVTcOvIL1voi/imMYESy/yP+2JLtv5M5Hd/tXwMAESqtK0EGAAA=",
+	"templates/query_shape.html":                                             "H4sIAAAAAAAC/6xUT2/bNhS/61P8yl1aIBITY4dhswV4jocaK+whdhfkFNDUk8SAFlWSsqMW/e4DJbu2kg3Dhvpk8v3+8fFR4ze3q9nm4Y85Sr/TaTR+E8cR55iZurWqKD1G1zc/YlMSZqLV1GLa+NJYl2CqNTqIgyVHdk9ZEnEe2B+UpMpRhqbKyMKXhGktZEmnyhX+JOuUqTBKrvE2ANixxN79EiRa02AnWlTGo3EEXyqHXGkCPUuqPVQFaXa1VqKShIPyZedzVAlJ8HDUMFsvVAUBaeoWJr8EQvhj6PArva9/5vxwOCSiC5wYW3DdQx3/sJjNl+t5PEquj6SPlSYXGvCpUZYybFuIutZKiq0maHGAsRCFJcrgTQh9sMqrqriCM7k/CEtBJlPOW7Vt/KBnp4jKDQCmgqjApmss1gy/TteL9VUQuV9s3q8+bnA/vbubLjeL+RqrO8xWy9vFZrFarrH6DdPlA35fLG+vQMqXZEHPtQ0nMBYqdLO/RKyJBhFy00dyNUmVKwktqqIRBaEwe7KVqgrUZHfKhVt1EFUWZLTaKS98t/XqXEkUx2k0Pg5eSSJLoy9fPO1qLTyBhR329Ws05n1tvDVZm0bjTO0htXBuwmpRUCxNFe6XLEujCLiU8KZ+rMR+K2wQioBL8jdenOtGZSyNgCHCmgOsOcQmz6Wo9sINV7Gm3B9pQ1+nMjqa9sXwrrDrptCSQDg48NJOGh27XXxzjfDv2cU3IwaVTVggfvN5FfGi8vd6o7MepMlIGj3gAGNPz75LFuwChqVFMt8p/5a9J60N7o3VGXs35ifkwJRnap9G/7T8D3FPIY/B++Pv1Wf22q5rKU/OrK69586+yNEvuovgPwToCXlZSML9dvtnD56cByXM60VhOH59tR/Sy2HIjfHdHDtpVe3hrJww7sK7kPzJcdl9WB9DpuTJMfi2pgkLfeZPYi96FoMshXXkJ6zxefwTS8e8r6T/JuxKUdP3Vf7UkG0f9+rz/5Tl/aP/awA8nvbNfgYAAA==",
+	"templates/sidebar.tmpl":                                                 "H4sIAAAAAAAC/6xVW2/jNhN996+Y1Qd8aIHQSoOiKLqyATVJd41mnYu9G+xTQEkjiQhFKrzY8Qb57wUpybZs51KgfjGHM3N4Zjg8ij4QMghDOJX1SrGiNHBy/MuvMC8RTumK4wpia0qp9BBizsGHaFCoUS0wGw7C0GVfsBSFxgysyFCBKRHimqYldp4j+IZKMyngZHgMP7mAoHUFP390ECtpoaIrENKA1QimZBpyxhHwMcXaABOQyqrmjIoUYclM6c9pURwT+N5iyMRQJoBCKusVyHw7EKhpSbtfaUz9Rxgul8sh9YSHUhUhb0J1eDE5PZ/OzsnJ8LhN+io4ateAB8sUZpCsgNY1ZylNOAKnS5AKaKEQMzDSkV4qZpgojkDL3CypQgeTMW0US6zp9ayjyHQvQAqgAoJ4BpNZAH/Gs8nsyIHcTuafL7/O4Ta+uYmn88n5DC5v4PRyejaZTy6nM7j8C+Lpd/h7Mj07AmSmRAX4WCtXgVTAXDebS4QZYo9CLhtKusaU5SwFTkVhaYFQyAUqwUQBNaqKaXerGqjIHAxnFTPU+K29uoYDQsaDp6cMcyYQAs0yTKgKnp8HbgyhtcFFRRlbQMqp1qMglZw8avIbuIWuyEkXSWSep1QsqA6AZaM1ICjJcRQIumCFJxOMBwDbkEouIZHGyKqmmff2/e2Rv3dHNotH7U7UaMhJ5+jsFqOPkhhBCiVtTRaoDEsphxSFQUUSLtP7dQ5AlFhjpPBVKCvuGjMAs6pxFHTWBhYcdK1YRdVqB/PGCri2qFZR2ORtnXKI20uU+qQ0ckwNZuTBQRM3DW+wyzCnlhvIlKwzuRTEyKLgGEBGDW2NUdB5ewcDfGI/Kgn/F4muP0KkayrWN0MVmmAchW6zx3avXIDI8i5vTaNCYbsJ8ev+yRFneykl0gxVMPZthQv/EmyBUcjZXvI4or5hhauArMuDUmE+Cv4XjH1pUUjHr6ZXD/xQ8pfrizdTC0Xr8nD6J+c6DBGFlm/sKMzYYjzYM9bLzWLTYkEX3VPirJmaxLcscNQaEmHbxT6FnfhZSWvcTrIs9HN3pxuPjwIf9hLQN6Yt5ezHLs5is78O2cc4CHmrmNmFWzZ73rUN07TzNdUBakhj/Lf684GQf6VBTm3fKw/+Mdv6gHDtPH5d7ef1RKAvHS9IApzJ1FYomi/Ku3TggOi9pAEHH1B7s5lMdXhtWXqvDVWGxJrEzWfef07AUFWgGQV3CafiPhhvQl99ntvoXgfiq8k+mPdAfDV5N9aX64t9mLe0YhvgVIqcFVa9UF7P/W7Qz/P51T6W2z1c3LYE9QXIzbTf6IZ1V4cG7f/TE4rs+XnwzwANkACH1woAAA==",
+	"templates/top_navbar.tmpl":                                              "H4sIAAAAAAAC/7RUwW7jNhC9+yve8tQCkZUE3R5aO4A3m6JGtzYQexvsqRiLI2lQmtSSlB0j8L8XtKSt0qDH+mJq5r03M28Izt5l2STPce+ak5eqjri9vvkB25pxTyfDJyzaWDsfplgYgwskwHNgf2A9neR5Yn+Sgm1gjdZq9og1Y9FQUfOQucIf7IM4i9vpNb5LANWn1Pc/J4mTa7GnE6yLaAMj1hJQimHwc8FNhFgUbt8YIVswjhLrS51eJXWCL72G20USC0LhmhNcOQaCYt90+tUxNj/l+fF4nNKl4anzVW46aMg/Le8fVpuH7HZ63ZM+W8MhGfC1Fc8auxOoaYwUtDMMQ0c4D6o8s0Z0EIujlyi2ukJwZTyS5ySjJUQvuza+8mxoUcIrgLMgC7XYYLlR+LDYLDdXSeRpuf11/XmLp8Xj42K1XT5ssH7E/Xr1cbldrlcbrH/BYvUFvy1XH6/AEmv24OfGpwmchyQ3uyViw/yqhdJ1LYWGCymlgCFbtVQxKndgb8VWaNjvJaStBpDVScbIXiLFS+jNXNNJlt1NXl40l2IZKrrmT0uHHXl1Pk+AdBcRXYMuiIQGZloOKAyFMFd9ovvLSnlmnUXXKHhn+JKW6lJdJWbPFT1XZNjHD+5ZDUqXgEIHA568iwzC15b0u46aazmMVHpe4Wy6W+yz0rSi1SDwtsusZtLsvyGA2a6N0VnEU8Nz1X2of5GiqyrDw4SFM85nOyr+UtAUqU/PlSvLguyBwhAnX3Gcq2kQzYlp6TCqDMxCQ3aoJYWzWbL9bpan+P8L/N15/seDvJt75MqwI+Mql2k5qP/IKRxFx3qubq7fK9ScnqK5+vH9Nwtr0ZyFPRmj7kbruwjVN3fdezbL65tRfAwbr3w49oeXF7b6fJ78PQD2oLprLwUAAA==",
+	"templates/visualize.html":                                               "H4sIAAAAAAAC/6xV32/bNhB+119x5TCgBSLRMfYwbLYAL8lQY4U9xO6CPAU0dZIuoEiNpKxoRf/3gZIdW0mL/UD9YpH3fd994h1PszfX66vt/e83UPpKpdHsTRxHnMOVqTtLRelhOrn8AbYlwpXoFHawaHxprEtgoRT0EAcWHdo9ZknEeWB/IInaYQaNztCCLxEWtZAlHiMX8AdaR0bDNJnA2wBghxB793OQ6EwDlehAGw+NQ/AlOchJIeCTxNoDaZCmqhUJLRFa8mWf56ASnMD9QcPsvCANAqSpOzD5ORCEP5gOv9L7+ifO27ZNRG84MbbgaoA6/mF5dbPa3MTTZHIgfdQKXTiAPxuymMGuA1HXiqTYKQQlWjAWRGERM/AmmG4tedLFBTiT+1ZYDDIZOW9p1/jRmR0tkhsBjAahgS02sNww+GWxWW4ugsjdcvt+/XELd4vb28Vqu7zZwPoWrtar6+V2uV5tYP0rLFb38NtydX0BSL5EC/hU2/AGxgKF0xyKCBvEkYXcDJZcjZJykqCELhpRIBRmj1aTLqBGW5ELVXUgdBZkFFXkhe+3Xr1XEsVxGs0OjVeiyNLo0yePVa2ER2Bhh33+HM2ctFR7cFbOGXdBT3Jfks0eamF9xx0VlXh0w39SkU4eHUtnfOCl/16gVk1B+iikRGcan+TGSlx4Jdz022nXwjq0Lnl0Rn9D1WGVCE2V8PglYT6c82xnsi6NZhntQSrh3JzVosBYGh3uClqWRhHAeTm8qR+02O+EDUWJAM7Jz7w4Vw1lLI0AxghrWrCmjU2eS6H3wo1XscLcH2jjvI4yPCQdgmFGQdXfaIsCQhMBvEwnjYpdFV9OIDw9ufhyyoCyOQvE5zyvLJ5Fvqw3PemBNBlKo0YcgJnHJ987C+kChqVFclORf8veo1IG7oxVGXs340fkKCnPaJ9GX1v+B7tHkwfjL20GdHC4J9cIRX8hg5YyX84vJ5PvocQw2vvnMe+VpeNGXxaenDL3JTpV5wVxWPTF5N8F6BF5HkhCj/T7pxw8OTVbmB9ngXELD9Gh0c8bKjfGf3WuPDou+w/dQyWG2wO+q3HOQq34o9iLgcVAluEO+zlrfB7/+I+39yT8fOD/U50PE/PvAQDyKbYouwcAAA==",
+	"templates/write.html":                                                   "H4sIAAAAAAAC/8xWX2/bthd916c41e/lNyCyUmMPQycL8JIUM1bYXewuyFNAiVcWA4pUScqOUfS7D5TsxIrbOA1SYH4yec89vH8OxZu8OZ+dLa4/XqB0lUyD5E0UBXGMM11vjFiWDsPTt79iURLO2EbSBuPGldrYAcZSooVYGLJkVsQHQRx77w8iJ2WJo1GcDFxJGNcsL2lnOcE/ZKzQCsPBKf7vAeHWFP7yu6fY6AYV20Bph8YSXCksCiEJdJdT7SAUcl3VUjCVE9bCle05WxYfCa63HDpzTCgw5LreQBf7QDC3Ddr/Sufqd3G8Xq8HrA14oM0ylh3Uxh8mZxfT+UU0HJxunT4pSdYX4HMjDHFkG7C6liJnmSRItoY2YEtDxOG0D3pthBNqeQKrC7dmhjwNF9YZkTWuV7NdiML2AFqBKYTjOSbzEH+M55P5iSe5miz+nH1a4Gp8eTmeLiYXc8wucTabnk8Wk9l0jtl7jKfX+GsyPT8BCVeSAd3VxmegDYSvZtdEzIl6IRS6C8nWlItC5JBMLRu2JCz1iowSaomaTCWs76oFU9zTSFEJx1y7dZDXIIiiNEi2wiuJ8TT48sVRVUvmCKHfCb9+DZK4syWZ5ps0SLhYIZfM2lFYsyVFuVa+v2TCNAiAfQqn6xvFVhkznigA9p3v/aJCNoKHaQD0EUavYfQ60kWRM7Vitr+KJBVu69Y/1wpO20M7o79XqFoVGmLwiQOPj8u1jGwVvT2F/3dno7fDEIKPQu94f85BiHuWQ76WBTvmYQ8LJOUwxZURjsAUptHfDePdNXuHJC6HfTSQCFU3ro1JfW4Yv/HQEG5T0yhs/6dJ3GIenRNzsUqDxxttVeLBQ5xthR6Kc9iNrHFOq+hY1rbaZd1V8SCPjqdNxF9I6iXSGcMdYeYUMqei2oiKmU2YdgV7LyQlcYc9TC343vIHenc0i73uwbfjaNNsk91S7naJOrpzIWrJciq15GRG4XyL+HYfe2S1IS5y5ugJuo/3mGcQ6mPBzZ4dm2QZySeYPrT2bxH9UPt+hh4Z5ze+md9XY5iOOYe/qz9Xf09/O7b6u6RKr54tQPOqCjSvLkHziho0Lxfhf/2byUmSo6MyPW9hL1Xq/rJbtM9o/D+f8S7hfcPAv87t/kOp4sHDM++njT1Df3jorN2Isf+UF1q7dgqxuRG1gzX5KIytn2ry+NbGeTsW3/iYBrd2v9vxLVuxzitEXjJjyY3CxhXRb77pnSU9Rtw+Ty9kjrvZ6t8BAEaLj7HlCwAA",
+}
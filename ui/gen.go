@@ -0,0 +1,90 @@
+//go:build ignore
+// +build ignore
+
+// gen.go generates assets.go with Cayley's UI (templates and static files) from the Cayley module used by codegraph.
+// Run it with "go generate ./ui" after updating Cayley.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const module = "github.com/cayleygraph/cayley"
+
+func main() {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}} {{.Version}}", module).Output()
+	if err != nil {
+		log.Fatalf("cannot find %s: %v", module, err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		log.Fatalf("unexpected output of go list: %q", out)
+	}
+	dir, version := fields[0], fields[1]
+
+	files := make(map[string]string)
+	for _, sub := range []string{"templates", "static"} {
+		err := filepath.Walk(filepath.Join(dir, sub), func(path string, fi os.FileInfo, err error) error {
+			if err != nil || fi.IsDir() {
+				return err
+			}
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			var buf bytes.Buffer
+			zw, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+			if _, err := zw.Write(data); err != nil {
+				return err
+			}
+			if err := zw.Close(); err != nil {
+				return err
+			}
+			name, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(name)] = base64.StdEncoding.EncodeToString(buf.Bytes())
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"go run gen.go\" from %s@%s; DO NOT EDIT.\n\n", module, version)
+	fmt.Fprintf(&buf, "package ui\n\n")
+	fmt.Fprintf(&buf, "// Version is the version of Cayley the assets are taken from.\n")
+	fmt.Fprintf(&buf, "const Version = %q\n\n", version)
+	fmt.Fprintf(&buf, "// assets maps file names to their gzipped and base64-encoded contents.\n")
+	fmt.Fprintf(&buf, "var assets = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", name, files[name])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("assets.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
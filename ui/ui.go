@@ -0,0 +1,100 @@
+// Package ui contains Cayley's web UI (templates and static files), so it can be served without a Cayley installation.
+// The assets are licensed under the Apache License 2.0 by The Cayley Authors.
+package ui
+
+//go:generate go run gen.go
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// file returns the contents of an embedded file, e.g. "templates/query.html".
+func file(name string) ([]byte, error) {
+	data, ok := assets[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	zdata, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zdata))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return ioutil.ReadAll(zr)
+}
+
+// Templates parses embedded templates of the UI: *.tmpl templates first, then *.html pages.
+func Templates() (*template.Template, error) {
+	var names []string
+	for name := range assets {
+		if strings.HasPrefix(name, "templates/") {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		// pages refer to templates, so templates go first
+		ti, tj := path.Ext(names[i]) == ".tmpl", path.Ext(names[j]) == ".tmpl"
+		if ti != tj {
+			return ti
+		}
+		return names[i] < names[j]
+	})
+	tmpl := template.New("ui")
+	for _, name := range names {
+		data, err := file(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tmpl.New(path.Base(name)).Parse(string(data)); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
+// Static returns a file system with embedded static files of the UI (the contents of the "static" directory).
+// Directories cannot be opened.
+func Static() http.FileSystem {
+	return staticFS{}
+}
+
+type staticFS struct{}
+
+func (staticFS) Open(name string) (http.File, error) {
+	name = path.Join("static", path.Clean("/"+name))
+	data, err := file(name)
+	if err != nil {
+		return nil, err
+	}
+	return &staticFile{Reader: bytes.NewReader(data), name: path.Base(name), size: int64(len(data))}, nil
+}
+
+type staticFile struct {
+	*bytes.Reader
+	name string
+	size int64
+}
+
+func (f *staticFile) Close() error                             { return nil }
+func (f *staticFile) Readdir(count int) ([]os.FileInfo, error) { return nil, os.ErrInvalid }
+func (f *staticFile) Stat() (os.FileInfo, error)               { return f, nil }
+
+func (f *staticFile) Name() string       { return f.name }
+func (f *staticFile) Size() int64        { return f.size }
+func (f *staticFile) Mode() os.FileMode  { return 0444 }
+func (f *staticFile) ModTime() time.Time { return time.Time{} }
+func (f *staticFile) IsDir() bool        { return false }
+func (f *staticFile) Sys() interface{}   { return nil }
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTemplates(t *testing.T) {
+	tmpl, err := Templates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, page := range []string{"query.html", "query_shape.html", "visualize.html", "write.html"} {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, page, nil); err != nil {
+			t.Errorf("%s: %v", page, err)
+		} else if !strings.Contains(buf.String(), "static/") {
+			t.Errorf("%s: no static files", page)
+		}
+	}
+}
+
+func TestStatic(t *testing.T) {
+	fs := Static()
+	f, err := fs.Open("/js/cayley_main.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi, err := f.Stat(); err != nil || fi.Size() != int64(len(data)) || len(data) == 0 {
+		t.Errorf("unexpected file: %d bytes, %v", len(data), err)
+	}
+
+	for _, name := range []string{"/", "/missing.js", "/../templates/query.html"} {
+		if _, err := fs.Open(name); !os.IsNotExist(err) {
+			t.Errorf("%s: expected a missing file, got %v", name, err)
+		}
+	}
+}